@@ -0,0 +1,56 @@
+package httperror
+
+import (
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// RequireAccept returns a middleware rejecting requests whose Accept header
+// admits none of the given media types with a 406 Not Acceptable, listing the
+// supported types in the "supported" detail. Media ranges with a quality of 0
+// are treated as refused; requests without an Accept header accept anything.
+// RequireAccept는 Accept 헤더가 주어진 미디어 타입 중 어느 것도 허용하지 않는 요청을
+// 지원되는 타입을 "supported" 상세 정보에 담은 406 Not Acceptable로 거부하는 미들웨어를 반환합니다.
+// 품질 값이 0인 미디어 범위는 거부로 간주하며, Accept 헤더가 없는 요청은 모든 타입을 허용합니다.
+func RequireAccept(types ...string) func(http.Handler) http.Handler {
+	supported := make([]string, 0, len(types))
+	for _, t := range types {
+		if mediaType, _, err := mime.ParseMediaType(t); err == nil {
+			supported = append(supported, mediaType)
+		}
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			accept := r.Header.Values("Accept")
+			if len(accept) == 0 || acceptsAny(strings.Join(accept, ","), supported) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			Respond(w, r, NotAcceptableError(WithDetail("supported", types)))
+		})
+	}
+}
+
+// acceptsAny reports whether the Accept header value admits one of the media types.
+func acceptsAny(accept string, mediaTypes []string) bool {
+	for _, part := range strings.Split(accept, ",") {
+		mediaRange, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		if q, ok := params["q"]; ok {
+			if v, err := strconv.ParseFloat(q, 64); err == nil && v <= 0 {
+				continue
+			}
+		}
+		for _, mt := range mediaTypes {
+			if matchMediaType([]string{mediaRange}, mt) {
+				return true
+			}
+		}
+	}
+	return false
+}
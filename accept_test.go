@@ -0,0 +1,55 @@
+package httperror
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+// TestRequireAccept tests that requests accepting no supported media type are rejected with a 406.
+func TestRequireAccept(t *testing.T) {
+	handler := RequireAccept("application/json", "text/csv; charset=utf-8")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	testCases := []struct {
+		name           string
+		accept         string
+		expectedStatus int
+	}{
+		{"no header", "", http.StatusNoContent},
+		{"exact", "application/json", http.StatusNoContent},
+		{"any", "*/*", http.StatusNoContent},
+		{"subtype wildcard", "text/*", http.StatusNoContent},
+		{"one of many", "application/xml, application/json;q=0.5", http.StatusNoContent},
+		{"refused with q=0", "application/json;q=0", http.StatusNotAcceptable},
+		{"unsupported", "application/xml", http.StatusNotAcceptable},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/", nil)
+			if tc.accept != "" {
+				req.Header.Set("Accept", tc.accept)
+			}
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			if rr.Code != tc.expectedStatus {
+				t.Fatalf("expected status %d, got %d", tc.expectedStatus, rr.Code)
+			}
+			if tc.expectedStatus != http.StatusNotAcceptable {
+				return
+			}
+			var body HttpError
+			if err := json.NewDecoder(rr.Body).Decode(&body); err != nil {
+				t.Fatalf("could not decode response body: %v", err)
+			}
+			if !reflect.DeepEqual(body.Details["supported"], []any{"application/json", "text/csv; charset=utf-8"}) {
+				t.Errorf("expected supported media types in details, got %v", body.Details["supported"])
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package httperror
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// RequestIDHeader is the header used to read and propagate request IDs.
+// RequestIDHeader는 요청 ID를 읽고 전달하는 데 사용되는 헤더입니다.
+const RequestIDHeader = "X-Request-ID"
+
+// requestIDKey is the context key of the request ID.
+type requestIDKey struct{}
+
+// WithRequestID returns a context carrying the request ID, which is attached to
+// ErrorEvents and access-log records so both can be correlated.
+// WithRequestID는 요청 ID를 담은 컨텍스트를 반환합니다. 이 ID는 ErrorEvent와 접근 로그에 첨부됩니다.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestID returns the request ID stored in ctx, or "" if there is none.
+// RequestID는 ctx에 저장된 요청 ID를 반환하며, 없으면 빈 문자열을 반환합니다.
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// AccessRecord is the combined access-log record emitted by AccessLog.
+// It shares the request ID and the HttpError with the ErrorEvent of the request.
+// AccessRecord는 AccessLog가 기록하는 통합 접근 로그 레코드입니다.
+type AccessRecord struct {
+	RequestID string
+	Method    string
+	Path      string
+	Status    int
+	Bytes     int64
+	Duration  time.Duration
+	// Error is the error rendered by Respond during the request, if any.
+	Error *HttpError
+}
+
+// LogValue implements slog.LogValuer.
+func (a AccessRecord) LogValue() slog.Value {
+	attrs := []slog.Attr{
+		slog.String("request_id", a.RequestID),
+		slog.String("method", a.Method),
+		slog.String("path", a.Path),
+		slog.Int("status", a.Status),
+		slog.Int64("bytes", a.Bytes),
+		slog.Duration("duration", a.Duration),
+	}
+	if a.Error != nil {
+		attrs = append(attrs, slog.String("error_code", a.Error.Code), slog.String("error", a.Error.Message))
+	}
+	return slog.GroupValue(attrs...)
+}
+
+// AccessLog is a middleware emitting a single access-log record per request
+// (status, bytes, duration and the rendered error, if any) through the configured
+// logger. It assigns a request ID, taken from the X-Request-ID header or generated,
+// stores it in the context and echoes it in the response, so access logs and error
+// hook events correlate. Records are logged at the level of the severity of the
+// rendered error (see SeverityOf), or at info level, warn for 4xx and error for
+// 5xx when there is none.
+// AccessLog는 요청마다 하나의 접근 로그 레코드(상태, 바이트 수, 소요 시간, 렌더링된 오류)를
+// 설정된 로거로 기록하는 미들웨어입니다. X-Request-ID 헤더에서 가져오거나 생성한 요청 ID를
+// 컨텍스트에 저장하고 응답에 포함하여 접근 로그와 오류 훅 이벤트를 연결할 수 있게 합니다.
+func AccessLog(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+
+		id := r.Header.Get(RequestIDHeader)
+		if id == "" {
+			id = newRequestID()
+		}
+		w.Header().Set(RequestIDHeader, id)
+
+		ctx := TrackErrors(WithRequestID(r.Context(), id))
+		sw := &statusWriter{ResponseWriter: w}
+		next.ServeHTTP(sw, r.WithContext(ctx))
+
+		status := sw.status
+		if status == 0 {
+			status = http.StatusOK
+		}
+		rec := AccessRecord{
+			RequestID: id,
+			Method:    r.Method,
+			Path:      r.URL.Path,
+			Status:    status,
+			Bytes:     sw.bytes,
+			Duration:  time.Since(start),
+			Error:     LastError(ctx),
+		}
+
+		level := slog.LevelInfo
+		switch {
+		case rec.Error != nil:
+			level = SeverityOf(rec.Error).Level()
+		case status >= 500:
+			level = slog.LevelError
+		case status >= 400:
+			level = slog.LevelWarn
+		}
+		logger().LogAttrs(ctx, level, "http request", slog.Any("access", rec))
+	})
+}
+
+// newRequestID generates a random 128-bit hex request ID.
+func newRequestID() string {
+	var b [16]byte
+	rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
+
+// statusWriter records the status code and the number of bytes written.
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+	bytes  int64
+}
+
+func (s *statusWriter) WriteHeader(status int) {
+	if s.status == 0 {
+		s.status = status
+	}
+	s.ResponseWriter.WriteHeader(status)
+}
+
+func (s *statusWriter) Write(p []byte) (int, error) {
+	if s.status == 0 {
+		s.status = http.StatusOK
+	}
+	n, err := s.ResponseWriter.Write(p)
+	s.bytes += int64(n)
+	return n, err
+}
+
+// Flush implements http.Flusher when the underlying writer supports it.
+func (s *statusWriter) Flush() {
+	if f, ok := s.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Unwrap returns the underlying ResponseWriter, for use by http.ResponseController.
+func (s *statusWriter) Unwrap() http.ResponseWriter {
+	return s.ResponseWriter
+}
@@ -0,0 +1,100 @@
+package httperror
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestAccessLog tests the combined access-log record and its correlation with hook events.
+func TestAccessLog(t *testing.T) {
+	SetErrorHandler(nil)
+	defer ResetHooks()
+
+	var logs bytes.Buffer
+	SetLogger(slog.New(slog.NewJSONHandler(&logs, nil)))
+	defer SetLogger(nil)
+
+	var event ErrorEvent
+	AddHook(func(ev ErrorEvent) { event = ev })
+
+	handler := AccessLog(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		Conflict(w, r, WithCode("email_taken"))
+	}))
+
+	rr := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/users", nil)
+	req.Header.Set(RequestIDHeader, "req-123")
+	handler.ServeHTTP(rr, req)
+
+	if rr.Header().Get(RequestIDHeader) != "req-123" {
+		t.Errorf("expected request ID to be echoed, got %q", rr.Header().Get(RequestIDHeader))
+	}
+	if event.RequestID != "req-123" {
+		t.Errorf("expected hook event to carry the request ID, got %q", event.RequestID)
+	}
+
+	var record struct {
+		Level  string `json:"level"`
+		Access struct {
+			RequestID string `json:"request_id"`
+			Method    string `json:"method"`
+			Path      string `json:"path"`
+			Status    int    `json:"status"`
+			Bytes     int64  `json:"bytes"`
+			ErrorCode string `json:"error_code"`
+		} `json:"access"`
+	}
+	if err := json.Unmarshal(logs.Bytes(), &record); err != nil {
+		t.Fatalf("could not decode log record %q: %v", logs.String(), err)
+	}
+	if record.Level != "WARN" || record.Access.RequestID != "req-123" || record.Access.Status != http.StatusConflict ||
+		record.Access.Method != "POST" || record.Access.Path != "/users" || record.Access.ErrorCode != "email_taken" {
+		t.Errorf("unexpected access record: %+v", record)
+	}
+	if record.Access.Bytes != int64(rr.Body.Len()) {
+		t.Errorf("expected %d bytes, got %d", rr.Body.Len(), record.Access.Bytes)
+	}
+}
+
+// TestAccessLogGeneratesRequestID tests that a request ID is generated when missing.
+func TestAccessLogGeneratesRequestID(t *testing.T) {
+	var logs bytes.Buffer
+	SetLogger(slog.New(slog.NewJSONHandler(&logs, nil)))
+	defer SetLogger(nil)
+
+	var seen string
+	handler := AccessLog(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = RequestID(r.Context())
+		w.Write([]byte("ok"))
+	}))
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest("GET", "/", nil))
+
+	if len(seen) != 32 || rr.Header().Get(RequestIDHeader) != seen {
+		t.Errorf("expected a generated 32-character request ID, got %q (header %q)", seen, rr.Header().Get(RequestIDHeader))
+	}
+	if !bytes.Contains(logs.Bytes(), []byte(`"level":"INFO"`)) {
+		t.Errorf("expected an info record, got %s", logs.String())
+	}
+}
+
+// TestAccessLogSeverity tests that records are logged at the severity of the rendered error.
+func TestAccessLogSeverity(t *testing.T) {
+	var logs bytes.Buffer
+	SetLogger(slog.New(slog.NewJSONHandler(&logs, nil)))
+	defer SetLogger(nil)
+
+	handler := AccessLog(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		NotFound(w, r, WithSeverity(SeverityInfo))
+	}))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/favicon.ico", nil))
+
+	if !bytes.Contains(logs.Bytes(), []byte(`"level":"INFO"`)) {
+		t.Errorf("expected an info record, got %s", logs.String())
+	}
+}
@@ -0,0 +1,110 @@
+package httperror
+
+import (
+	"net/http"
+	"strings"
+)
+
+// Errors holds several independent errors reported at once, e.g. one per
+// invalid input of a request. Responded as a whole, it renders a single
+// representative error (see HttpError) listing every member under the
+// "errors" key of the details; marshaled to JSON, it is the array of its members.
+// Errors는 한 번에 보고되는 여러 독립적인 오류(예: 요청의 잘못된 입력마다 하나)를 담습니다.
+// 응답 시에는 모든 구성 오류를 details의 "errors" 키에 나열하는 하나의 대표 오류로 렌더링되며(HttpError 참고),
+// JSON으로 직렬화하면 구성 오류의 배열이 됩니다.
+type Errors []*HttpError
+
+// Add appends err, resolved like Respond resolves errors, unless it is nil.
+// The members of an Errors are appended one by one.
+// Add는 err가 nil이 아니면 Respond와 같은 방식으로 변환하여 추가합니다. Errors의 구성 오류는 하나씩 추가됩니다.
+func (es *Errors) Add(err error) {
+	switch err := err.(type) {
+	case nil:
+	case Errors:
+		*es = append(*es, err...)
+	default:
+		*es = append(*es, toHttpError(err))
+	}
+}
+
+// Err returns es as an error, or nil if it holds no error, so it can be
+// returned by functions collecting errors.
+// Err는 es를 error로 반환하며, 오류가 없으면 nil을 반환하여 오류를 수집하는 함수에서 그대로 반환할 수 있게 합니다.
+func (es Errors) Err() error {
+	if len(es) == 0 {
+		return nil
+	}
+	return es
+}
+
+// Error returns the messages of the members, separated by "; ".
+// Error는 구성 오류의 메시지를 "; "로 구분하여 반환합니다.
+func (es Errors) Error() string {
+	messages := make([]string, len(es))
+	for i, e := range es {
+		messages[i] = e.Message
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Unwrap returns the members, so errors.Is and errors.As inspect each of them.
+// Unwrap은 구성 오류를 반환하여 errors.Is와 errors.As가 각각을 검사할 수 있게 합니다.
+func (es Errors) Unwrap() []error {
+	errs := make([]error, len(es))
+	for i, e := range es {
+		errs[i] = e
+	}
+	return errs
+}
+
+// Status returns the representative status of the members: their status if
+// they all share it, otherwise 500 if one of them is a server error, 400 if
+// they are all client errors, and 500 for an empty Errors.
+// Status는 구성 오류의 대표 상태 코드를 반환합니다. 모두 같은 상태 코드이면 그 코드이고,
+// 그렇지 않으면 서버 오류가 하나라도 있으면 500, 모두 클라이언트 오류이면 400이며, 비어 있으면 500입니다.
+func (es Errors) Status() int {
+	if len(es) == 0 {
+		return http.StatusInternalServerError
+	}
+	status := es[0].Status
+	mixed := false
+	for _, e := range es[1:] {
+		if e.Status != status {
+			mixed = true
+		}
+	}
+	if !mixed {
+		return status
+	}
+	for _, e := range es {
+		if e.Status >= 500 {
+			return http.StatusInternalServerError
+		}
+	}
+	return http.StatusBadRequest
+}
+
+// HttpError returns the representative error rendered for es: it has the
+// representative status, the message of the only member or the status text,
+// the headers of the members, and lists all members under the "errors" detail.
+// HttpError는 es에 대해 렌더링되는 대표 오류를 반환합니다. 대표 상태 코드, 구성 오류가 하나이면 그 메시지
+// (아니면 상태 텍스트), 구성 오류의 헤더를 가지며, 모든 구성 오류를 "errors" 상세 정보에 나열합니다.
+func (es Errors) HttpError() *HttpError {
+	e := New(es.Status(), "")
+	if len(es) == 1 {
+		e.Message = es[0].Message
+	}
+	for _, m := range es {
+		for key, values := range m.Header {
+			if e.Header == nil {
+				e.Header = make(http.Header)
+			}
+			if _, ok := e.Header[key]; !ok {
+				e.Header[key] = append([]string(nil), values...)
+			}
+		}
+	}
+	e.Details = map[string]any{"errors": []*HttpError(es)}
+	e.cause = es
+	return e
+}
@@ -0,0 +1,79 @@
+package httperror
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestErrorsStatus tests the representative status of aggregated errors.
+func TestErrorsStatus(t *testing.T) {
+	testCases := []struct {
+		name     string
+		errs     Errors
+		expected int
+	}{
+		{"empty", nil, http.StatusInternalServerError},
+		{"single", Errors{NotFoundError()}, http.StatusNotFound},
+		{"same status", Errors{UnprocessableEntityError("a"), UnprocessableEntityError("b")}, http.StatusUnprocessableEntity},
+		{"client errors", Errors{NotFoundError(), ConflictError()}, http.StatusBadRequest},
+		{"server error", Errors{NotFoundError(), BadGatewayError()}, http.StatusInternalServerError},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.errs.Status(); got != tc.expected {
+				t.Errorf("expected status %d, got %d", tc.expected, got)
+			}
+		})
+	}
+}
+
+// TestErrors tests collecting, inspecting and responding aggregated errors.
+func TestErrors(t *testing.T) {
+	var errs Errors
+	if errs.Err() != nil {
+		t.Fatal("expected no error for an empty Errors")
+	}
+	errs.Add(nil)
+	errs.Add(UnprocessableEntityError("name is required", WithDetail("field", "name")))
+	errs.Add(Errors{UnprocessableEntityError("age must be positive", WithDetail("field", "age"))})
+	errs.Add(UnauthorizedError(WithHeader("WWW-Authenticate", `Bearer realm="api"`)))
+
+	err := fmt.Errorf("creating user: %w", errs.Err())
+	if len(errs) != 3 || !errors.Is(err, ErrUnauthorized) {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+	if got := errs.Error(); got != "name is required; age must be positive; Unauthorized" {
+		t.Errorf("unexpected message %q", got)
+	}
+
+	rr := httptest.NewRecorder()
+	Respond(rr, httptest.NewRequest("POST", "/users", nil), err)
+	if rr.Code != http.StatusBadRequest {
+		t.Errorf("expected status 400, got %d", rr.Code)
+	}
+	if got := rr.Header().Get("WWW-Authenticate"); got != `Bearer realm="api"` {
+		t.Errorf("expected the members' headers, got %q", got)
+	}
+
+	var body struct {
+		Message string
+		Details struct{ Errors []HttpError }
+	}
+	if err := json.NewDecoder(rr.Body).Decode(&body); err != nil {
+		t.Fatalf("could not decode response body: %v", err)
+	}
+	if body.Message != "Bad Request" || len(body.Details.Errors) != 3 || body.Details.Errors[1].Details["field"] != "age" {
+		t.Errorf("unexpected body %+v", body)
+	}
+
+	data, _ := json.Marshal(errs)
+	var members []HttpError
+	if err := json.Unmarshal(data, &members); err != nil || len(members) != 3 || members[2].Status != http.StatusUnauthorized {
+		t.Errorf("expected Errors to marshal as an array, got %s", data)
+	}
+}
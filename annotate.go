@@ -0,0 +1,48 @@
+package httperror
+
+import "errors"
+
+// annotatedError records the layer an error passed through.
+type annotatedError struct {
+	err   error
+	layer string
+}
+
+func (a *annotatedError) Error() string {
+	return a.err.Error()
+}
+
+func (a *annotatedError) Unwrap() error {
+	return a.err
+}
+
+// Annotate records that err passed through the given layer (e.g. "auth",
+// "validation", "handler") and returns the annotated error. The error message
+// and the way the error is resolved are unchanged. When an error traverses
+// several layers, Layers lists them in order, so operators can see which
+// layer produced the error and which layers transformed it.
+// Annotate는 err가 지정된 계층(예: "auth", "validation", "handler")을 거쳤음을 기록하고
+// 주석이 추가된 오류를 반환합니다. 오류 메시지와 변환 방식은 변하지 않습니다.
+func Annotate(err error, layer string) error {
+	if err == nil {
+		return nil
+	}
+	return &annotatedError{err: err, layer: layer}
+}
+
+// Layers returns the layers recorded by Annotate along the error chain, from the
+// layer that produced the error to the outermost one.
+// Layers는 Annotate로 기록된 계층들을 오류를 생성한 계층부터 가장 바깥 계층까지 순서대로 반환합니다.
+func Layers(err error) []string {
+	var layers []string
+	for err != nil {
+		if a, ok := err.(*annotatedError); ok {
+			layers = append(layers, a.layer)
+		}
+		err = errors.Unwrap(err)
+	}
+	for i, j := 0, len(layers)-1; i < j; i, j = i+1, j-1 {
+		layers[i], layers[j] = layers[j], layers[i]
+	}
+	return layers
+}
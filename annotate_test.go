@@ -0,0 +1,71 @@
+package httperror
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+// TestAnnotate tests that layers are recorded in order without changing the error.
+func TestAnnotate(t *testing.T) {
+	produced := Annotate(ForbiddenError("token expired"), "auth")
+	transformed := Annotate(fmt.Errorf("check access: %w", produced), "validation")
+	err := Annotate(transformed, "handler")
+
+	if got := Layers(err); !reflect.DeepEqual(got, []string{"auth", "validation", "handler"}) {
+		t.Errorf("unexpected layers: %v", got)
+	}
+	if err.Error() != "check access: token expired" {
+		t.Errorf("unexpected message: %q", err.Error())
+	}
+	if !errors.Is(err, ErrForbidden) {
+		t.Error("expected the annotated error to match ErrForbidden")
+	}
+	if got := toHttpError(err).Status; got != http.StatusForbidden {
+		t.Errorf("expected annotated error to resolve to 403, got %d", got)
+	}
+	if Annotate(nil, "auth") != nil {
+		t.Error("expected nil for a nil error")
+	}
+	if Layers(errors.New("plain")) != nil {
+		t.Error("expected no layers for an unannotated error")
+	}
+}
+
+// TestAnnotateEventAndDebug tests that layers reach hook events and debug responses.
+func TestAnnotateEventAndDebug(t *testing.T) {
+	defer ResetHooks()
+	defer SetErrorHandler(nil)
+
+	var layers []string
+	AddHook(func(ev ErrorEvent) { layers = ev.Layers })
+
+	err := Annotate(Annotate(NotFoundError(), "repository"), "handler")
+
+	rs := NewResponder()
+	SetErrorHandler(rs.HandleError)
+
+	rr := httptest.NewRecorder()
+	Respond(rr, httptest.NewRequest("GET", "/", nil), err)
+	if !reflect.DeepEqual(layers, []string{"repository", "handler"}) {
+		t.Errorf("unexpected event layers: %v", layers)
+	}
+	var body HttpError
+	json.NewDecoder(rr.Body).Decode(&body)
+	if body.Details != nil {
+		t.Errorf("expected no debug details by default, got %v", body.Details)
+	}
+
+	rs.SetDebug(true)
+	rr = httptest.NewRecorder()
+	Respond(rr, httptest.NewRequest("GET", "/", nil), err)
+	body = HttpError{}
+	json.NewDecoder(rr.Body).Decode(&body)
+	if !reflect.DeepEqual(body.Details["layers"], []any{"repository", "handler"}) {
+		t.Errorf("expected layers in debug details, got %v", body.Details)
+	}
+}
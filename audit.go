@@ -0,0 +1,106 @@
+package httperror
+
+import (
+	"log/slog"
+	"net"
+	"net/http"
+	"slices"
+	"time"
+)
+
+// AuditEvent is a structured record of an authentication, authorization or
+// rate limiting failure, meant for security tooling such as a SIEM rather
+// than for the application logs.
+// AuditEvent는 인증, 인가, 속도 제한 실패에 대한 구조화된 기록으로, 애플리케이션 로그가 아닌 SIEM 같은 보안 도구를 위한 것입니다.
+type AuditEvent struct {
+	Time      time.Time
+	RequestID string
+	Status    int
+	Code      string
+	Message   string
+	Method    string
+	Path      string
+	// RemoteIP is the IP address of the peer, without its port.
+	RemoteIP string
+	// ForwardedFor is the raw X-Forwarded-For header, as sent by the client
+	// or its proxies; it is untrusted.
+	ForwardedFor string
+	UserAgent    string
+	// Credentials reports whether the request carried an Authorization header.
+	Credentials bool
+}
+
+// LogValue implements slog.LogValuer.
+func (a AuditEvent) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Time("time", a.Time),
+		slog.String("request_id", a.RequestID),
+		slog.Int("status", a.Status),
+		slog.String("code", a.Code),
+		slog.String("message", a.Message),
+		slog.String("method", a.Method),
+		slog.String("path", a.Path),
+		slog.String("remote_ip", a.RemoteIP),
+		slog.String("forwarded_for", a.ForwardedFor),
+		slog.String("user_agent", a.UserAgent),
+		slog.Bool("credentials", a.Credentials),
+	)
+}
+
+// AuditStatuses are the statuses audited by default: 401, 403 and 429.
+// AuditStatuses는 기본으로 감사되는 상태 코드(401, 403, 429)입니다.
+var AuditStatuses = []int{http.StatusUnauthorized, http.StatusForbidden, http.StatusTooManyRequests}
+
+// AuditHook returns a Hook passing an AuditEvent to sink for every error
+// response with one of the statuses, or AuditStatuses if none are given.
+// sink is called synchronously and should not block; see AuditChannel.
+// AuditHook은 주어진 상태 코드(없으면 AuditStatuses) 중 하나를 가진 모든 오류 응답에 대해 AuditEvent를 sink에 전달하는 Hook을 반환합니다.
+// sink는 동기적으로 호출되므로 블록되지 않아야 합니다. AuditChannel을 참고하세요.
+func AuditHook(sink func(AuditEvent), statuses ...int) Hook {
+	if len(statuses) == 0 {
+		statuses = AuditStatuses
+	}
+	statuses = slices.Clone(statuses)
+	return func(ev ErrorEvent) {
+		if !slices.Contains(statuses, ev.HttpError.Status) {
+			return
+		}
+		sink(newAuditEvent(ev))
+	}
+}
+
+// AuditChannel returns a sink for AuditHook sending events to ch without
+// blocking; events are dropped and logged when ch is full.
+// AuditChannel은 이벤트를 블록 없이 ch로 보내는 AuditHook용 sink를 반환합니다. ch가 가득 차면 이벤트는 버려지고 기록됩니다.
+func AuditChannel(ch chan<- AuditEvent) func(AuditEvent) {
+	return func(a AuditEvent) {
+		select {
+		case ch <- a:
+		default:
+			logger().Warn("httperror: audit channel full, dropping event", "status", a.Status, "request_id", a.RequestID)
+		}
+	}
+}
+
+// newAuditEvent builds the AuditEvent of ev.
+func newAuditEvent(ev ErrorEvent) AuditEvent {
+	a := AuditEvent{
+		Time:      ev.Time,
+		RequestID: ev.RequestID,
+		Status:    ev.HttpError.Status,
+		Code:      ev.HttpError.Code,
+		Message:   ev.HttpError.Message,
+	}
+	if r := ev.Request; r != nil {
+		a.Method = r.Method
+		a.Path = r.URL.Path
+		a.RemoteIP = r.RemoteAddr
+		if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+			a.RemoteIP = host
+		}
+		a.ForwardedFor = r.Header.Get("X-Forwarded-For")
+		a.UserAgent = r.UserAgent()
+		a.Credentials = r.Header.Get("Authorization") != ""
+	}
+	return a
+}
@@ -0,0 +1,79 @@
+package httperror
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// TestAuditHook tests that audited statuses are reported to the sink.
+func TestAuditHook(t *testing.T) {
+	testCases := []struct {
+		name     string
+		statuses []int
+		err      *HttpError
+		audited  bool
+	}{
+		{"unauthorized", nil, UnauthorizedError(WithCode("token_expired")), true},
+		{"forbidden", nil, ForbiddenError(), true},
+		{"rate limited", nil, TooManyRequestsError(), true},
+		{"not found", nil, NotFoundError(), false},
+		{"custom statuses", []int{http.StatusNotFound}, NotFoundError(), true},
+		{"custom statuses exclude defaults", []int{http.StatusNotFound}, ForbiddenError(), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var events []AuditEvent
+			AddHook(AuditHook(func(a AuditEvent) { events = append(events, a) }, tc.statuses...))
+			defer ResetHooks()
+
+			req := httptest.NewRequest("DELETE", "/admin/users/7", nil)
+			req.RemoteAddr = "203.0.113.7:51234"
+			req.Header.Set("X-Forwarded-For", "198.51.100.1")
+			req.Header.Set("User-Agent", "curl/8.0")
+			req.Header.Set("Authorization", "Bearer abc")
+			Respond(httptest.NewRecorder(), req.WithContext(WithRequestID(req.Context(), "req-1")), tc.err)
+
+			if !tc.audited {
+				if len(events) != 0 {
+					t.Fatalf("expected no audit event, got %+v", events)
+				}
+				return
+			}
+			if len(events) != 1 {
+				t.Fatalf("expected 1 audit event, got %d", len(events))
+			}
+			a := events[0]
+			if a.Status != tc.err.Status || a.Code != tc.err.Code || a.RequestID != "req-1" || a.Method != "DELETE" ||
+				a.Path != "/admin/users/7" || a.RemoteIP != "203.0.113.7" || a.ForwardedFor != "198.51.100.1" ||
+				a.UserAgent != "curl/8.0" || !a.Credentials || a.Time.IsZero() {
+				t.Errorf("unexpected audit event %+v", a)
+			}
+		})
+	}
+}
+
+// TestAuditChannel tests that events are sent without blocking.
+func TestAuditChannel(t *testing.T) {
+	var logs bytes.Buffer
+	SetLogger(slog.New(slog.NewTextHandler(&logs, nil)))
+	defer SetLogger(nil)
+
+	ch := make(chan AuditEvent, 1)
+	AddHook(AuditHook(AuditChannel(ch)))
+	defer ResetHooks()
+
+	Forbidden(httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil))
+	Forbidden(httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil))
+
+	if a := <-ch; a.Status != http.StatusForbidden {
+		t.Errorf("expected a 403 event, got %+v", a)
+	}
+	if !strings.Contains(logs.String(), "audit channel full") {
+		t.Errorf("expected the dropped event to be logged, got %q", logs.String())
+	}
+}
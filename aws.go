@@ -0,0 +1,57 @@
+package httperror
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// AWSEncoder encodes errors in the shapes AWS SDK clients expect: the
+// {"message": "..."} body of API Gateway or, with Type set, the
+// {"__type": "...", "message": "..."} body of the AWS JSON protocols, with the
+// details under "details", which AWS SDKs ignore. The
+// type is the error's Code, e.g. "ValidationException", or the name of the
+// status followed by "Exception", e.g. "NotFoundException". Select it per
+// Responder with SetEncoder for services fronted by or migrating from API Gateway.
+// AWSEncoder는 AWS SDK 클라이언트가 기대하는 형식으로 오류를 인코딩합니다. API Gateway의 {"message": "..."}
+// 본문이나, Type이 설정된 경우 AWS JSON 프로토콜의 {"__type": "...", "message": "..."} 본문을 사용하며,
+// 상세 정보는 AWS SDK가 무시하는 "details"에 담깁니다.
+// 타입은 오류의 Code(예: "ValidationException") 또는 상태 이름 뒤에 "Exception"을 붙인 값(예: "NotFoundException")입니다.
+// API Gateway 뒤에 있거나 API Gateway에서 이전하는 서비스에서 SetEncoder로 Responder별로 선택합니다.
+type AWSEncoder struct {
+	// Type adds the "__type" member of the AWS JSON protocols.
+	Type bool
+}
+
+// ContentType implements Encoder.
+func (enc AWSEncoder) ContentType() string {
+	if enc.Type {
+		return "application/x-amz-json-1.1"
+	}
+	return "application/json"
+}
+
+// Encode implements Encoder.
+func (enc AWSEncoder) Encode(w io.Writer, e *HttpError) error {
+	body := struct {
+		Type    string         `json:"__type,omitempty"`
+		Message string         `json:"message"`
+		Details map[string]any `json:"details,omitempty"`
+	}{
+		Message: e.Message,
+		Details: e.Details,
+	}
+	if enc.Type {
+		body.Type = AWSErrorType(e)
+	}
+	return json.NewEncoder(w).Encode(body)
+}
+
+// AWSErrorType returns the AWS error type of e: its Code, or the name of its
+// status followed by "Exception", e.g. "TooManyRequestsException".
+// AWSErrorType은 e의 AWS 오류 타입을 반환합니다. Code 또는 상태 이름 뒤에 "Exception"을 붙인 값입니다.
+func AWSErrorType(e *HttpError) string {
+	if e.Code != "" {
+		return e.Code
+	}
+	return statusName(e.Status) + "Exception"
+}
@@ -0,0 +1,42 @@
+package httperror
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestAWSEncoder tests the API Gateway and AWS JSON protocol error shapes.
+func TestAWSEncoder(t *testing.T) {
+	testCases := []struct {
+		name        string
+		enc         AWSEncoder
+		err         *HttpError
+		contentType string
+		expected    string
+	}{
+		{"api gateway", AWSEncoder{}, NotFoundError("user not found"), "application/json", `{"message":"user not found"}`},
+		{"status type", AWSEncoder{Type: true}, TooManyRequestsError(), "application/x-amz-json-1.1", `{"__type":"TooManyRequestsException","message":"Too Many Requests"}`},
+		{"code type", AWSEncoder{Type: true}, BadRequestError("name is required", WithCode("ValidationException")), "application/x-amz-json-1.1", `{"__type":"ValidationException","message":"name is required"}`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rs := NewResponder()
+			rs.SetEncoder(tc.enc)
+			rr := httptest.NewRecorder()
+			rs.HandleError(rr, httptest.NewRequest("GET", "/", nil), tc.err)
+
+			if rr.Code != tc.err.Status || rr.Header().Get("Content-Type") != tc.contentType {
+				t.Errorf("expected %d %s, got %d %s", tc.err.Status, tc.contentType, rr.Code, rr.Header().Get("Content-Type"))
+			}
+			if got := rr.Body.String(); got != tc.expected+"\n" {
+				t.Errorf("expected %s, got %s", tc.expected, got)
+			}
+		})
+	}
+
+	if got := AWSErrorType(New(http.StatusInternalServerError, "")); got != "InternalServerErrorException" {
+		t.Errorf("unexpected type %q", got)
+	}
+}
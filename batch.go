@@ -0,0 +1,123 @@
+package httperror
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// BatchResult is the outcome of a single item of a bulk request.
+// BatchResult는 일괄 요청의 개별 항목 결과입니다.
+type BatchResult struct {
+	// Index is the position of the item in the batch.
+	Index int `json:"index"`
+	// ID identifies the item, if the caller gave it one.
+	ID      string         `json:"id,omitempty"`
+	Status  int            `json:"status"`
+	Code    string         `json:"code,omitempty"`
+	Message string         `json:"message,omitempty"`
+	Details map[string]any `json:"details,omitempty"`
+}
+
+// BatchResponse collects the per-item results of a bulk endpoint and renders
+// them as a single 207 Multi-Status response, so partial failures can be
+// expressed. It is safe for concurrent use, e.g. when items are processed in
+// parallel; results are indexed in the order they are added.
+// BatchResponse는 일괄 처리 엔드포인트의 항목별 결과를 수집하여 하나의 207 Multi-Status 응답으로 렌더링하므로
+// 부분 실패를 표현할 수 있습니다. 동시에 사용해도 안전하며, 결과는 추가된 순서대로 인덱싱됩니다.
+type BatchResponse struct {
+	mu      sync.Mutex
+	results []BatchResult
+}
+
+// Add records the outcome of an item: a nil err is a 200 OK, anything else is
+// resolved like Respond does, with the registered mappers.
+// Add는 항목의 결과를 기록합니다. err가 nil이면 200 OK이며, 그 외의 오류는 Respond와 같이 변환됩니다.
+func (b *BatchResponse) Add(id string, err error) {
+	if err == nil {
+		b.AddStatus(id, http.StatusOK)
+		return
+	}
+	e := toHttpError(err)
+	b.add(BatchResult{ID: id, Status: e.Status, Code: e.Code, Message: e.Message, Details: e.Details})
+}
+
+// AddStatus records a successful item with the given status, e.g. 201 Created.
+// AddStatus는 주어진 상태 코드(예: 201 Created)로 성공한 항목을 기록합니다.
+func (b *BatchResponse) AddStatus(id string, status int) {
+	b.add(BatchResult{ID: id, Status: status})
+}
+
+func (b *BatchResponse) add(res BatchResult) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	res.Index = len(b.results)
+	b.results = append(b.results, res)
+}
+
+// Results returns a copy of the recorded results.
+// Results는 기록된 결과의 복사본을 반환합니다.
+func (b *BatchResponse) Results() []BatchResult {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]BatchResult(nil), b.results...)
+}
+
+// HasErrors reports whether any item failed with a 4xx or 5xx status.
+// HasErrors는 4xx 또는 5xx 상태로 실패한 항목이 있는지 보고합니다.
+func (b *BatchResponse) HasErrors() bool {
+	for _, res := range b.Results() {
+		if res.Status >= 400 {
+			return true
+		}
+	}
+	return false
+}
+
+// BatchEncoder serializes batch results into a 207 Multi-Status body.
+// BatchEncoder는 일괄 처리 결과를 207 Multi-Status 본문으로 직렬화합니다.
+type BatchEncoder interface {
+	// ContentType returns the Content-Type of the encoded body.
+	ContentType() string
+	// EncodeBatch writes the encoded results to w.
+	EncodeBatch(w io.Writer, results []BatchResult) error
+}
+
+// Write renders the results as a 207 Multi-Status JSON response of the form
+// {"status":207,"results":[{"index","id","status","message"}, ...]}.
+// Write는 결과를 {"status":207,"results":[...]} 형식의 207 Multi-Status JSON 응답으로 렌더링합니다.
+func (b *BatchResponse) Write(w http.ResponseWriter, r *http.Request) {
+	b.WriteWith(w, r, JSONEncoder{})
+}
+
+// WriteWith renders the results as a 207 Multi-Status response encoded by enc,
+// e.g. WebDAVEncoder for WebDAV clients. If enc fails, the failure is
+// responded instead, as a 500.
+// WriteWith는 결과를 enc로 인코딩한 207 Multi-Status 응답으로 렌더링합니다(예: WebDAV 클라이언트용 WebDAVEncoder).
+// enc가 실패하면 대신 그 오류를 500으로 응답합니다.
+func (b *BatchResponse) WriteWith(w http.ResponseWriter, r *http.Request, enc BatchEncoder) {
+	buf := bufferPool.Get().(*bytes.Buffer)
+	defer putBuffer(buf)
+	if err := enc.EncodeBatch(buf, b.Results()); err != nil {
+		Respond(w, r, fmt.Errorf("httperror: encoding batch response: %w", err))
+		return
+	}
+
+	w.Header().Set("Content-Type", enc.ContentType())
+	w.WriteHeader(http.StatusMultiStatus)
+	w.Write(buf.Bytes())
+}
+
+// EncodeBatch implements BatchEncoder.
+func (JSONEncoder) EncodeBatch(w io.Writer, results []BatchResult) error {
+	if results == nil {
+		results = []BatchResult{}
+	}
+	return json.NewEncoder(w).Encode(struct {
+		Status  int           `json:"status"`
+		Results []BatchResult `json:"results"`
+	}{http.StatusMultiStatus, results})
+}
@@ -0,0 +1,82 @@
+package httperror
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestBatchResponse tests rendering per-item results as a 207 Multi-Status.
+func TestBatchResponse(t *testing.T) {
+	RegisterSQLMappers()
+	defer ResetMappers()
+
+	var b BatchResponse
+	if b.HasErrors() {
+		t.Error("expected an empty batch to have no errors")
+	}
+	b.AddStatus("a", http.StatusCreated)
+	b.Add("b", nil)
+	b.Add("c", sql.ErrNoRows)
+	b.Add("d", UnprocessableEntityError("invalid email", WithCode("invalid_email"), WithDetail("field", "email")))
+	if !b.HasErrors() {
+		t.Error("expected the batch to have errors")
+	}
+
+	rr := httptest.NewRecorder()
+	b.Write(rr, httptest.NewRequest("POST", "/bulk", nil))
+	if rr.Code != http.StatusMultiStatus {
+		t.Errorf("expected 207, got %d", rr.Code)
+	}
+
+	var body struct {
+		Status  int           `json:"status"`
+		Results []BatchResult `json:"results"`
+	}
+	if err := json.NewDecoder(rr.Body).Decode(&body); err != nil {
+		t.Fatalf("could not decode response body: %v", err)
+	}
+	expected := []BatchResult{
+		{Index: 0, ID: "a", Status: http.StatusCreated},
+		{Index: 1, ID: "b", Status: http.StatusOK},
+		{Index: 2, ID: "c", Status: http.StatusNotFound, Message: "Not Found"},
+		{Index: 3, ID: "d", Status: http.StatusUnprocessableEntity, Code: "invalid_email", Message: "invalid email", Details: map[string]any{"field": "email"}},
+	}
+	if body.Status != http.StatusMultiStatus || !reflect.DeepEqual(body.Results, expected) {
+		t.Errorf("unexpected body: %+v", body)
+	}
+}
+
+// failingBatchEncoder is a BatchEncoder that writes part of a body, then fails.
+type failingBatchEncoder struct{}
+
+func (failingBatchEncoder) ContentType() string { return "application/x-failing" }
+
+func (failingBatchEncoder) EncodeBatch(w io.Writer, results []BatchResult) error {
+	io.WriteString(w, "partial")
+	return errors.New("encoder broke")
+}
+
+// TestBatchResponseEncodingFailure tests that an encoder failure is responded as a 500, not a truncated 207.
+func TestBatchResponseEncodingFailure(t *testing.T) {
+	var b BatchResponse
+	b.Add("a", nil)
+
+	rr := httptest.NewRecorder()
+	b.WriteWith(rr, httptest.NewRequest("POST", "/bulk", nil), failingBatchEncoder{})
+	if rr.Code != http.StatusInternalServerError {
+		t.Errorf("expected 500, got %d", rr.Code)
+	}
+	if ct := rr.Header().Get("Content-Type"); ct == "application/x-failing" {
+		t.Errorf("expected the error's Content-Type, got %q", ct)
+	}
+	if body := rr.Body.String(); strings.Contains(body, "partial") {
+		t.Errorf("expected no partial batch body, got %q", body)
+	}
+}
@@ -0,0 +1,305 @@
+package httperror
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+)
+
+// binaryVersion is the version of the binary encoding of HttpError.
+const binaryVersion = 1
+
+// MarshalBinary implements encoding.BinaryMarshaler, so errors can be passed
+// between internal services over queues or RPC, and encoding/gob, and
+// re-rendered at the edge with full fidelity: status, code, type, message,
+// severity, headers, links, details, message params and arguments, the
+// payload and the original status of remapped errors. Details, params,
+// arguments and the payload are encoded as JSON, except for the members of
+// joined errors. The cause is not encoded.
+// MarshalBinary는 encoding.BinaryMarshaler를 구현하여 내부 서비스 간에 큐, RPC, encoding/gob으로 오류를 전달하고
+// 엣지에서 상태 코드, 코드, 타입, 메시지, 심각도, 헤더, 링크, 상세 정보, 메시지 매개변수와 인자, 페이로드,
+// 재매핑된 오류의 원래 상태 코드를 그대로 다시 렌더링할 수 있게 합니다.
+// 결합된 구성 오류를 제외한 상세 정보, 매개변수, 인자, 페이로드는 JSON으로 인코딩되며, 원인은 인코딩되지 않습니다.
+func (e *HttpError) MarshalBinary() ([]byte, error) {
+	b := []byte{binaryVersion}
+	b = binary.AppendUvarint(b, uint64(e.Status))
+	b = binary.AppendUvarint(b, uint64(e.originalStatus))
+	b = appendBinaryString(b, e.Code)
+	b = appendBinaryString(b, e.Type)
+	b = appendBinaryString(b, e.Message)
+	b = appendBinaryString(b, string(e.Severity))
+
+	b = binary.AppendUvarint(b, uint64(len(e.Header)))
+	for _, key := range sortedHeaderKeys(e.Header) {
+		b = appendBinaryString(b, key)
+		b = binary.AppendUvarint(b, uint64(len(e.Header[key])))
+		for _, v := range e.Header[key] {
+			b = appendBinaryString(b, v)
+		}
+	}
+	b = binary.AppendUvarint(b, uint64(len(e.Links)))
+	for _, rel := range sortedKeys(e.Links) {
+		b = appendBinaryString(b, rel)
+		b = appendBinaryString(b, e.Links[rel])
+	}
+
+	b = binary.AppendUvarint(b, uint64(len(e.Details)))
+	for _, key := range sortedDetailKeys(e.Details) {
+		b = appendBinaryString(b, key)
+		var err error
+		if b, err = appendBinaryDetail(b, e.Details[key]); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, v := range []any{e.Params, e.args, e.Data} {
+		var data []byte
+		if !isNilValue(v) {
+			var err error
+			if data, err = appendJSONValue(nil, v, nil); err != nil {
+				return nil, fmt.Errorf("httperror: encoding error: %w", err)
+			}
+		}
+		b = appendBinaryString(b, string(data))
+	}
+	return b, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, decoding an error
+// encoded by MarshalBinary. Numbers in details and params are decoded as
+// json.Number, those in message arguments as int64 or float64, and the payload
+// is kept as raw JSON, read by DataOf.
+// UnmarshalBinary는 encoding.BinaryUnmarshaler를 구현하여 MarshalBinary로 인코딩된 오류를 디코딩합니다.
+// 상세 정보와 매개변수의 숫자는 json.Number로, 메시지 인자의 숫자는 int64나 float64로 디코딩되며,
+// 페이로드는 DataOf로 읽는 원시 JSON으로 보존됩니다.
+func (e *HttpError) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 || data[0] != binaryVersion {
+		return errors.New("httperror: unsupported binary encoding")
+	}
+	d := binaryDecoder{data: data[1:]}
+
+	var decoded HttpError
+	decoded.Status = int(d.uvarint())
+	decoded.originalStatus = int(d.uvarint())
+	decoded.Code = d.string()
+	decoded.Type = d.string()
+	decoded.Message = d.string()
+	decoded.Severity = Severity(d.string())
+
+	if n := d.count(); n > 0 {
+		decoded.Header = make(http.Header, n)
+		for range n {
+			key := d.string()
+			values := make([]string, d.count())
+			for i := range values {
+				values[i] = d.string()
+			}
+			decoded.Header[key] = values
+		}
+	}
+	if n := d.count(); n > 0 {
+		decoded.Links = make(map[string]string, n)
+		for range n {
+			rel := d.string()
+			decoded.Links[rel] = d.string()
+		}
+	}
+
+	if n := d.count(); n > 0 {
+		decoded.Details = make(map[string]any, n)
+		for range n {
+			key := d.string()
+			decoded.Details[key] = d.detail()
+		}
+	}
+	d.json(&decoded.Params)
+	d.json(&decoded.args)
+	for i, arg := range decoded.args {
+		decoded.args[i] = numberValue(arg)
+	}
+	if raw := d.string(); raw != "" && raw != "null" && d.err == nil {
+		decoded.Data = json.RawMessage(raw)
+	}
+
+	if d.err != nil {
+		return fmt.Errorf("httperror: decoding error: %w", d.err)
+	}
+	if len(d.data) > 0 {
+		return errors.New("httperror: decoding error: trailing data")
+	}
+	*e = decoded
+	return nil
+}
+
+// Kinds of the encoded detail values.
+const (
+	binaryJSON   = 0
+	binaryErrors = 1
+)
+
+// appendBinaryDetail appends a detail value to b: the members of joined
+// errors are encoded in binary, so they keep their fields, and other values
+// as JSON.
+func appendBinaryDetail(b []byte, v any) ([]byte, error) {
+	if members, ok := v.([]*HttpError); ok && members != nil {
+		b = append(b, binaryErrors)
+		b = binary.AppendUvarint(b, uint64(len(members)))
+		for _, member := range members {
+			if member == nil {
+				member = New(http.StatusInternalServerError, "")
+			}
+			data, err := member.MarshalBinary()
+			if err != nil {
+				return nil, err
+			}
+			b = appendBinaryString(b, string(data))
+		}
+		return b, nil
+	}
+	data, err := appendJSONValue(nil, v, nil)
+	if err != nil {
+		return nil, fmt.Errorf("httperror: encoding error: %w", err)
+	}
+	b = append(b, binaryJSON)
+	return appendBinaryString(b, string(data)), nil
+}
+
+// numberValue returns n as an int64 or a float64 if it is a json.Number, so
+// message arguments format like the originals.
+func numberValue(v any) any {
+	n, ok := v.(json.Number)
+	if !ok {
+		return v
+	}
+	if i, err := n.Int64(); err == nil {
+		return i
+	}
+	if f, err := n.Float64(); err == nil {
+		return f
+	}
+	return v
+}
+
+// appendBinaryString appends s to b, prefixed with its length.
+func appendBinaryString(b []byte, s string) []byte {
+	b = binary.AppendUvarint(b, uint64(len(s)))
+	return append(b, s...)
+}
+
+// sortedHeaderKeys returns the keys of h in ascending order.
+func sortedHeaderKeys(h http.Header) []string {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// isNilValue reports whether v, one of the JSON-encoded fields of
+// MarshalBinary, is unset.
+func isNilValue(v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case map[string]any:
+		return v == nil
+	case []any:
+		return v == nil
+	}
+	return false
+}
+
+// binaryDecoder reads the binary encoding of an HttpError, keeping the first error.
+type binaryDecoder struct {
+	data []byte
+	err  error
+}
+
+func (d *binaryDecoder) uvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(d.data)
+	if n <= 0 {
+		d.err = errors.New("malformed length")
+		return 0
+	}
+	d.data = d.data[n:]
+	return v
+}
+
+// count reads a number of items, each of which takes at least a byte.
+func (d *binaryDecoder) count() int {
+	n := d.uvarint()
+	if n > uint64(len(d.data)) {
+		d.err = errors.New("count exceeds data")
+		return 0
+	}
+	return int(n)
+}
+
+func (d *binaryDecoder) string() string {
+	n := d.uvarint()
+	if d.err != nil {
+		return ""
+	}
+	if n > uint64(len(d.data)) {
+		d.err = errors.New("string exceeds data")
+		return ""
+	}
+	s := string(d.data[:n])
+	d.data = d.data[n:]
+	return s
+}
+
+// detail reads a detail value encoded by appendBinaryDetail.
+func (d *binaryDecoder) detail() any {
+	if d.err == nil && len(d.data) == 0 {
+		d.err = errors.New("missing detail")
+	}
+	if d.err != nil {
+		return nil
+	}
+	kind := d.data[0]
+	d.data = d.data[1:]
+	switch kind {
+	case binaryJSON:
+		var v any
+		d.json(&v)
+		return v
+	case binaryErrors:
+		members := make([]*HttpError, d.count())
+		for i := range members {
+			data := d.string()
+			if d.err != nil {
+				return nil
+			}
+			members[i] = &HttpError{}
+			if err := members[i].UnmarshalBinary([]byte(data)); err != nil {
+				d.err = err
+				return nil
+			}
+		}
+		return members
+	}
+	d.err = fmt.Errorf("unknown detail kind %d", kind)
+	return nil
+}
+
+// json decodes a JSON value into v, leaving it untouched if it is empty.
+func (d *binaryDecoder) json(v any) {
+	raw := d.string()
+	if raw == "" || d.err != nil {
+		return
+	}
+	dec := json.NewDecoder(bytes.NewReader([]byte(raw)))
+	dec.UseNumber()
+	if err := dec.Decode(v); err != nil {
+		d.err = err
+	}
+}
@@ -0,0 +1,133 @@
+package httperror
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+// TestBinaryRoundTrip tests that decoded errors render like the originals.
+func TestBinaryRoundTrip(t *testing.T) {
+	type quota struct {
+		Limit, Used int
+	}
+
+	testCases := []struct {
+		name string
+		err  *HttpError
+	}{
+		{"minimal", NotFoundError()},
+		{"full", ConflictError(
+			WithMessage("Version conflict"),
+			WithCode("version_conflict"),
+			WithDetail("current_version", 12345678901234),
+			WithDetail("fields", []string{"name", "age"}),
+			WithDetail("errors", []*HttpError{BadRequestError(WithMessage("Invalid name"))}),
+			WithHeader("ETag", `"v3"`),
+			WithLink("documentation", "https://docs.example.com/errors/conflict"),
+			WithData(quota{Limit: 100, Used: 100}),
+			WithSeverity(SeverityCritical),
+		)},
+		{"params", NotFoundError(WithMessage("user {id} not found"), WithParam("id", 42))},
+		{"args", NotFoundError(WithMessage("user %d not found"), WithMessageArgs(42))},
+		{"remapped", Remap(ForbiddenError(), 404)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := tc.err.MarshalBinary()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var decoded HttpError
+			if err := decoded.UnmarshalBinary(data); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if decoded.Severity != tc.err.Severity {
+				t.Errorf("expected severity %q, got %q", tc.err.Severity, decoded.Severity)
+			}
+			if decoded.OriginalStatus() != tc.err.OriginalStatus() {
+				t.Errorf("expected original status %d, got %d", tc.err.OriginalStatus(), decoded.OriginalStatus())
+			}
+			expected, got := httptest.NewRecorder(), httptest.NewRecorder()
+			Respond(expected, httptest.NewRequest("GET", "/", nil), tc.err)
+			Respond(got, httptest.NewRequest("GET", "/", nil), &decoded)
+			if got.Code != expected.Code {
+				t.Errorf("expected status %d, got %d", expected.Code, got.Code)
+			}
+			if !reflect.DeepEqual(got.Header(), expected.Header()) {
+				t.Errorf("expected headers %v, got %v", expected.Header(), got.Header())
+			}
+			if got.Body.String() != expected.Body.String() {
+				t.Errorf("expected body %s, got %s", expected.Body, got.Body)
+			}
+
+			again, err := decoded.MarshalBinary()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !bytes.Equal(again, data) {
+				t.Errorf("expected a stable encoding")
+			}
+		})
+	}
+}
+
+// TestBinaryGob tests that errors travel through encoding/gob.
+func TestBinaryGob(t *testing.T) {
+	original := TooManyRequestsError(WithCode("quota_exceeded"), WithHeader("Retry-After", "30"), WithDetail("limit", 100))
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(original); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var decoded *HttpError
+	if err := gob.NewDecoder(&buf).Decode(&decoded); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if decoded.Status != 429 || decoded.Code != "quota_exceeded" || decoded.Header.Get("Retry-After") != "30" {
+		t.Errorf("unexpected error %+v", decoded)
+	}
+	if limit, _ := decoded.Get("limit"); limit == nil || limit.(interface{ String() string }).String() != "100" {
+		t.Errorf("expected limit 100, got %v", limit)
+	}
+	if !errors.Is(decoded, ErrTooManyRequests) {
+		t.Errorf("expected the decoded error to match ErrTooManyRequests")
+	}
+}
+
+// TestUnmarshalBinaryInvalid tests that malformed encodings are rejected.
+func TestUnmarshalBinaryInvalid(t *testing.T) {
+	valid, err := ConflictError(WithCode("conflict"), WithDetail("id", 1)).MarshalBinary()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	testCases := []struct {
+		name string
+		data []byte
+	}{
+		{"empty", nil},
+		{"unknown version", []byte{99}},
+		{"truncated", valid[:len(valid)-3]},
+		{"trailing data", append(append([]byte(nil), valid...), 0)},
+		{"huge count", []byte{binaryVersion, 0x90, 0x03, 0, 0, 0, 0, 0, 0xff, 0xff, 0x03}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := NotFoundError()
+			if err := e.UnmarshalBinary(tc.data); err == nil {
+				t.Fatal("expected an error")
+			}
+			if e.Status != 404 {
+				t.Errorf("expected the error to be left untouched, got %+v", e)
+			}
+		})
+	}
+}
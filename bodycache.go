@@ -0,0 +1,57 @@
+package httperror
+
+import (
+	"bytes"
+	"net/http"
+)
+
+// defaultBodies holds the bodies of the default error of every generated
+// status, encoded once at init by JSONEncoder and HTMLEncoder, so helpers
+// called without options, e.g. BadRequest(w, r), write them without encoding.
+var defaultBodies map[Encoder]map[int][]byte
+
+func init() {
+	defaultBodies = map[Encoder]map[int][]byte{
+		JSONEncoder{}: encodeDefaultBodies(JSONEncoder{}),
+		HTMLEncoder{}: encodeDefaultBodies(HTMLEncoder{}),
+	}
+}
+
+// encodeDefaultBodies encodes the default error of every generated standard
+// status with enc. Non-standard statuses are skipped, see isDefaultError.
+func encodeDefaultBodies(enc Encoder) map[int][]byte {
+	bodies := make(map[int][]byte, len(statusFamilies))
+	for _, f := range statusFamilies {
+		if http.StatusText(f.Status) == "" {
+			continue
+		}
+		var buf bytes.Buffer
+		if err := enc.Encode(&buf, New(f.Status, "")); err != nil {
+			continue
+		}
+		bodies[f.Status] = buf.Bytes()
+	}
+	return bodies
+}
+
+// cachedBody returns the precomputed body of e encoded by enc, if e is the
+// default error of its status and enc is a built-in encoder.
+func cachedBody(enc Encoder, e *HttpError) ([]byte, bool) {
+	if !isDefaultError(e) {
+		return nil, false
+	}
+	switch enc.(type) {
+	case JSONEncoder, HTMLEncoder:
+	default:
+		return nil, false
+	}
+	body, ok := defaultBodies[enc][e.Status]
+	return body, ok
+}
+
+// isDefaultError reports whether e carries nothing but its status and the
+// untranslated status text, so its body only depends on the status.
+func isDefaultError(e *HttpError) bool {
+	return e.Code == "" && e.Type == "" && len(e.Details) == 0 && len(e.Links) == 0 && e.Data == nil && len(e.Params) == 0 && len(e.args) == 0 &&
+		e.language == "" && e.Message == http.StatusText(e.Status)
+}
@@ -0,0 +1,52 @@
+package httperror
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+)
+
+// TestDefaultBodies tests that the cached bodies match the encoded ones.
+func TestDefaultBodies(t *testing.T) {
+	for _, enc := range []Encoder{JSONEncoder{}, HTMLEncoder{}} {
+		for _, f := range statusFamilies {
+			if http.StatusText(f.Status) == "" {
+				continue
+			}
+			var buf bytes.Buffer
+			enc.Encode(&buf, New(f.Status, ""))
+
+			body, ok := cachedBody(enc, New(f.Status, ""))
+			if !ok || !bytes.Equal(body, buf.Bytes()) {
+				t.Errorf("%T %d: expected cached body %q, got %q", enc, f.Status, buf.Bytes(), body)
+			}
+		}
+	}
+}
+
+// TestCachedBody tests which errors are served from the cache.
+func TestCachedBody(t *testing.T) {
+	testCases := []struct {
+		name   string
+		enc    Encoder
+		err    *HttpError
+		cached bool
+	}{
+		{"default error", JSONEncoder{}, NotFoundError(), true},
+		{"default error with header", HTMLEncoder{}, MethodNotAllowedError(WithAllow(http.MethodGet)), true},
+		{"custom message", JSONEncoder{}, NotFoundError("user not found"), false},
+		{"code", JSONEncoder{}, NotFoundError(WithCode("not_found")), false},
+		{"details", JSONEncoder{}, NotFoundError(WithDetail("id", 1)), false},
+		{"params", JSONEncoder{}, NotFoundError(WithParam("id", 1)), false},
+		{"non-standard status", JSONEncoder{}, New(StatusClientClosedRequest, ""), false},
+		{"other encoder", TwirpEncoder{}, NotFoundError(), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, ok := cachedBody(tc.enc, tc.err); ok != tc.cached {
+				t.Errorf("expected cached %v, got %v", tc.cached, ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package httperror
+
+import (
+	"errors"
+	"net/http"
+)
+
+// BodyLimit returns a middleware capping request bodies at limit bytes with
+// http.MaxBytesReader. Requests declaring a larger Content-Length are rejected
+// up front with a 413 Payload Too Large; otherwise reading past the limit fails
+// with an *http.MaxBytesError, which Respond and DefaultErrorHandler render as
+// a 413 carrying the limit in the "limit" detail.
+// BodyLimit은 http.MaxBytesReader로 요청 본문을 limit 바이트로 제한하는 미들웨어를 반환합니다.
+// 더 큰 Content-Length를 선언한 요청은 즉시 413으로 거부되며, 그 밖의 경우 제한을 넘겨 읽으면
+// *http.MaxBytesError가 발생하고, Respond와 DefaultErrorHandler는 이를 "limit" 상세 정보를 포함한 413으로 렌더링합니다.
+func BodyLimit(limit int64) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.ContentLength > limit {
+				Respond(w, r, &http.MaxBytesError{Limit: limit})
+				return
+			}
+			if r.Body != nil && r.Body != http.NoBody {
+				r.Body = http.MaxBytesReader(w, r.Body, limit)
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// mapBodyLimitError translates an *http.MaxBytesError into a 413 Content Too Large.
+func mapBodyLimitError(err error) (*HttpError, bool) {
+	var maxErr *http.MaxBytesError
+	if !errors.As(err, &maxErr) {
+		return nil, false
+	}
+	e := PayloadTooLargeError(WithDetail("limit", maxErr.Limit))
+	e.cause = err
+	return e, true
+}
@@ -0,0 +1,59 @@
+package httperror
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// TestBodyLimit tests that oversized bodies are rejected with a structured 413.
+func TestBodyLimit(t *testing.T) {
+	handler := BodyLimit(8)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			Respond(w, r, fmt.Errorf("reading body: %w", err))
+			return
+		}
+		w.Write(body)
+	}))
+
+	testCases := []struct {
+		name           string
+		body           string
+		unknownLength  bool
+		expectedStatus int
+	}{
+		{"within limit", "small", false, http.StatusOK},
+		{"declared too large", "this is too large", false, http.StatusRequestEntityTooLarge},
+		{"read past limit", "this is too large", true, http.StatusRequestEntityTooLarge},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", "/", strings.NewReader(tc.body))
+			if tc.unknownLength {
+				req.ContentLength = -1
+			}
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			if rr.Code != tc.expectedStatus {
+				t.Fatalf("expected status %d, got %d", tc.expectedStatus, rr.Code)
+			}
+			if tc.expectedStatus == http.StatusOK {
+				return
+			}
+			var body HttpError
+			if err := json.NewDecoder(rr.Body).Decode(&body); err != nil {
+				t.Fatalf("could not decode response body: %v", err)
+			}
+			if body.Details["limit"] != float64(8) {
+				t.Errorf("expected limit detail 8, got %v", body.Details["limit"])
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package httperror
+
+import (
+	"errors"
+	"net/http"
+	"time"
+)
+
+// Breaker is a circuit breaker guarding a dependency. Adapt the breaker of
+// your library to it to render its open state consistently.
+// Breaker는 의존성을 보호하는 서킷 브레이커입니다. 사용하는 라이브러리의 브레이커를 이 인터페이스에 맞추면
+// 열린 상태를 일관되게 렌더링할 수 있습니다.
+type Breaker interface {
+	// Name identifies the breaker, e.g. after the dependency it guards.
+	Name() string
+	// Open reports whether the breaker is open, rejecting calls, and how
+	// long until it lets a trial call through.
+	Open() (open bool, resetIn time.Duration)
+}
+
+// CircuitOpenError creates a 503 Service Unavailable HttpError for the open
+// breaker name, with the breaker in the details ("breaker") and a Retry-After
+// of resetIn, rounded up to the second and at least 1 second.
+// CircuitOpenError는 열린 브레이커 name에 대한 503 Service Unavailable HttpError를 생성합니다.
+// 브레이커는 상세 정보("breaker")에 담기며, Retry-After는 초 단위로 올림한 resetIn(최소 1초)입니다.
+func CircuitOpenError(name string, resetIn time.Duration, opts ...Option) *HttpError {
+	retryAfter := max(time.Second, (resetIn+time.Second-1)/time.Second*time.Second)
+	return newWithOptions(http.StatusServiceUnavailable, append([]Option{
+		WithHeader("Retry-After", seconds(retryAfter)),
+		WithDetail("breaker", name),
+	}, opts...))
+}
+
+// CircuitBreaker returns a middleware rejecting requests with
+// CircuitOpenError while b is open.
+// CircuitBreaker는 b가 열려 있는 동안 CircuitOpenError로 요청을 거부하는 미들웨어를 반환합니다.
+func CircuitBreaker(b Breaker) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if open, resetIn := b.Open(); open {
+				Respond(w, r, CircuitOpenError(b.Name(), resetIn))
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// BreakerMapper returns a Mapper translating errors matching openErr, the
+// error b returns for rejected calls (e.g. gobreaker.ErrOpenState), into
+// CircuitOpenError, for use with RegisterMapper.
+// BreakerMapper는 b가 거부한 호출에 대해 반환하는 오류 openErr(예: gobreaker.ErrOpenState)와 일치하는 오류를
+// CircuitOpenError로 변환하는 Mapper를 반환합니다. RegisterMapper와 함께 사용합니다.
+func BreakerMapper(b Breaker, openErr error) Mapper {
+	return func(err error) (*HttpError, bool) {
+		if !errors.Is(err, openErr) {
+			return nil, false
+		}
+		_, resetIn := b.Open()
+		e := CircuitOpenError(b.Name(), resetIn)
+		e.cause = err
+		return e, true
+	}
+}
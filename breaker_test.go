@@ -0,0 +1,74 @@
+package httperror
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// fakeBreaker is a Breaker with a fixed state.
+type fakeBreaker struct {
+	open    bool
+	resetIn time.Duration
+}
+
+func (fakeBreaker) Name() string                  { return "billing" }
+func (b fakeBreaker) Open() (bool, time.Duration) { return b.open, b.resetIn }
+
+// TestCircuitBreaker tests rejecting requests while the breaker is open.
+func TestCircuitBreaker(t *testing.T) {
+	testCases := []struct {
+		name               string
+		breaker            fakeBreaker
+		expectedStatus     int
+		expectedRetryAfter string
+	}{
+		{"closed", fakeBreaker{}, http.StatusOK, ""},
+		{"open", fakeBreaker{open: true, resetIn: 30 * time.Second}, http.StatusServiceUnavailable, "30"},
+		{"rounded up", fakeBreaker{open: true, resetIn: 2500 * time.Millisecond}, http.StatusServiceUnavailable, "3"},
+		{"at least a second", fakeBreaker{open: true}, http.StatusServiceUnavailable, "1"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := CircuitBreaker(tc.breaker)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, httptest.NewRequest("GET", "/", nil))
+
+			if rr.Code != tc.expectedStatus {
+				t.Errorf("expected status %d, got %d", tc.expectedStatus, rr.Code)
+			}
+			if got := rr.Header().Get("Retry-After"); got != tc.expectedRetryAfter {
+				t.Errorf("expected Retry-After %q, got %q", tc.expectedRetryAfter, got)
+			}
+			if tc.expectedStatus == http.StatusServiceUnavailable {
+				expected := `{"status":503,"message":"Service Unavailable","details":{"breaker":"billing"}}` + "\n"
+				if got := rr.Body.String(); got != expected {
+					t.Errorf("expected body %s, got %s", expected, got)
+				}
+			}
+		})
+	}
+}
+
+// TestBreakerMapper tests translating the open state error of a breaker.
+func TestBreakerMapper(t *testing.T) {
+	errOpen := errors.New("circuit breaker is open")
+	mapper := BreakerMapper(fakeBreaker{open: true, resetIn: 10 * time.Second}, errOpen)
+
+	err := fmt.Errorf("charging card: %w", errOpen)
+	e, ok := mapper(err)
+	if !ok {
+		t.Fatal("expected the open state error to be mapped")
+	}
+	if e.Status != http.StatusServiceUnavailable || e.Header.Get("Retry-After") != "10" || !errors.Is(e, errOpen) {
+		t.Errorf("unexpected error %+v", e)
+	}
+	if _, ok := mapper(errors.New("declined")); ok {
+		t.Error("expected other errors not to be mapped")
+	}
+}
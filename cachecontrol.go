@@ -0,0 +1,49 @@
+package httperror
+
+import (
+	"maps"
+	"net/http"
+)
+
+// DefaultCacheControl is the Cache-Control header of error responses, so
+// intermediaries do not cache transient failures.
+// DefaultCacheControl은 중간 캐시가 일시적인 실패를 캐시하지 않도록 오류 응답에 포함되는 Cache-Control 헤더입니다.
+const DefaultCacheControl = "no-store"
+
+// WithCacheControl sets the Cache-Control header of error responses, which is
+// DefaultCacheControl by default. An empty value leaves the header to the
+// handler.
+// WithCacheControl은 오류 응답의 Cache-Control 헤더를 설정하며, 기본값은 DefaultCacheControl입니다.
+// 빈 값은 헤더를 핸들러에 맡깁니다.
+func WithCacheControl(value string) ResponderOption {
+	return func(cfg *responderConfig) { cfg.cacheControl = &value }
+}
+
+// WithStatusCacheControl sets the Cache-Control header of the error responses
+// with the given statuses, e.g. 404 → "public, max-age=60" to let 404s be
+// cached briefly, overriding WithCacheControl. An empty value leaves the
+// header to the handler.
+// WithStatusCacheControl은 주어진 상태 코드의 오류 응답에 대한 Cache-Control 헤더를 설정하여
+// WithCacheControl보다 우선합니다(예: 404를 잠시 캐시하도록 404 → "public, max-age=60").
+// 빈 값은 헤더를 핸들러에 맡깁니다.
+func WithStatusCacheControl(values map[int]string) ResponderOption {
+	return func(cfg *responderConfig) { cfg.statusCacheControl = maps.Clone(values) }
+}
+
+// setCacheControl writes the Cache-Control header configured for e, unless e
+// carries its own.
+func (cfg *responderConfig) setCacheControl(h http.Header, e *HttpError) {
+	if e.Header.Get("Cache-Control") != "" {
+		return
+	}
+	value, ok := cfg.statusCacheControl[e.Status]
+	if !ok {
+		value = DefaultCacheControl
+		if cfg.cacheControl != nil {
+			value = *cfg.cacheControl
+		}
+	}
+	if value != "" {
+		h.Set("Cache-Control", value)
+	}
+}
@@ -0,0 +1,40 @@
+package httperror
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+// TestCacheControl tests the Cache-Control header of error responses.
+func TestCacheControl(t *testing.T) {
+	testCases := []struct {
+		name     string
+		opts     []ResponderOption
+		preset   string
+		err      *HttpError
+		expected string
+	}{
+		{"default", nil, "", InternalServerErrorError(), "no-store"},
+		{"overrides handler", nil, "public, max-age=3600", ServiceUnavailableError(), "no-store"},
+		{"per status", []ResponderOption{WithStatusCacheControl(map[int]string{404: "public, max-age=60"})}, "", NotFoundError(), "public, max-age=60"},
+		{"other status", []ResponderOption{WithStatusCacheControl(map[int]string{404: "public, max-age=60"})}, "", GoneError(), "no-store"},
+		{"custom default", []ResponderOption{WithCacheControl("no-cache")}, "", NotFoundError(), "no-cache"},
+		{"disabled", []ResponderOption{WithCacheControl("")}, "private", NotFoundError(), "private"},
+		{"disabled per status", []ResponderOption{WithStatusCacheControl(map[int]string{404: ""})}, "", NotFoundError(), ""},
+		{"error header", nil, "", NotFoundError(WithHeader("Cache-Control", "max-age=5")), "max-age=5"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			if tc.preset != "" {
+				rr.Header().Set("Cache-Control", tc.preset)
+			}
+			NewResponder(tc.opts...).HandleError(rr, httptest.NewRequest("GET", "/", nil), tc.err)
+
+			if got := rr.Header().Values("Cache-Control"); len(got) > 1 || rr.Header().Get("Cache-Control") != tc.expected {
+				t.Errorf("expected Cache-Control %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
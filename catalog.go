@@ -0,0 +1,36 @@
+package httperror
+
+// KoreanCatalog returns a Korean catalog of the default status messages,
+// e.g. "Not Found" → "찾을 수 없음".
+// KoreanCatalog는 기본 상태 메시지의 한국어 카탈로그를 반환합니다(예: "Not Found" → "찾을 수 없음").
+func KoreanCatalog() Catalog {
+	c := make(Catalog, len(koreanStatusTexts))
+	for status, text := range koreanStatusTexts {
+		c[StatusText(status)] = text
+	}
+	return c
+}
+
+// EnglishCatalog returns an English catalog of the default status messages.
+// The messages already are English; registering it lets clients preferring
+// English over other registered languages get English messages.
+// EnglishCatalog는 기본 상태 메시지의 영어 카탈로그를 반환합니다. 메시지는 이미 영어이지만, 이를 등록하면
+// 다른 등록된 언어보다 영어를 선호하는 클라이언트가 영어 메시지를 받게 됩니다.
+func EnglishCatalog() Catalog {
+	c := make(Catalog, len(koreanStatusTexts))
+	for status := range koreanStatusTexts {
+		c[StatusText(status)] = StatusText(status)
+	}
+	return c
+}
+
+// RegisterBuiltinCatalogs registers the built-in Korean ("ko") and English
+// ("en") catalogs, so clients requesting Korean receive localized default
+// messages out of the box. Catalogs registered afterwards for the same
+// languages are merged over them.
+// RegisterBuiltinCatalogs는 내장 한국어("ko")와 영어("en") 카탈로그를 등록하여 한국어를 요청하는 클라이언트가
+// 별도 설정 없이 현지화된 기본 메시지를 받게 합니다. 이후 같은 언어로 등록한 카탈로그는 그 위에 병합됩니다.
+func RegisterBuiltinCatalogs() {
+	RegisterCatalog("ko", KoreanCatalog())
+	RegisterCatalog("en", EnglishCatalog())
+}
@@ -0,0 +1,48 @@
+package httperror
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestBuiltinCatalogs tests the built-in Korean and English catalogs.
+func TestBuiltinCatalogs(t *testing.T) {
+	ko := KoreanCatalog()
+	for _, f := range statusFamilies {
+		if ko[StatusText(f.Status)] == "" {
+			t.Errorf("expected a Korean text for %d", f.Status)
+		}
+	}
+
+	RegisterBuiltinCatalogs()
+	defer ResetCatalogs()
+
+	testCases := []struct {
+		acceptLanguage string
+		expected       string
+	}{
+		{"ko-KR", "찾을 수 없음"},
+		{"en, ko;q=0.5", "Not Found"},
+		{"ja, ko;q=0.5", "찾을 수 없음"},
+	}
+	for _, tc := range testCases {
+		req := httptest.NewRequest("GET", "/", nil)
+		req.Header.Set("Accept-Language", tc.acceptLanguage)
+		rr := httptest.NewRecorder()
+		NotFound(rr, req)
+
+		var body HttpError
+		if err := json.NewDecoder(rr.Body).Decode(&body); err != nil {
+			t.Fatalf("could not decode response body: %v", err)
+		}
+		if body.Message != tc.expected {
+			t.Errorf("%s: expected %q, got %q", tc.acceptLanguage, tc.expected, body.Message)
+		}
+	}
+
+	if got, _ := Translate("ko", StatusText(http.StatusServiceUnavailable)); got != "서비스 사용 불가" {
+		t.Errorf("unexpected translation: %q", got)
+	}
+}
@@ -0,0 +1,108 @@
+package httperror
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// Challenge is an authentication challenge (RFC 9110, section 11.3), such as
+// Basic realm="admin", sent in WWW-Authenticate or Proxy-Authenticate.
+// Challenge는 WWW-Authenticate 또는 Proxy-Authenticate로 전송되는 인증 챌린지(RFC 9110, 11.3절)입니다
+// (예: Basic realm="admin").
+type Challenge struct {
+	// Scheme is the authentication scheme, e.g. "Basic" or "Bearer".
+	Scheme string
+	// Realm is the protection space, omitted if empty.
+	Realm string
+	// Params are the other auth parameters, e.g. "charset" or "error".
+	Params map[string]string
+}
+
+// String formats the challenge for an authentication header, with its
+// parameters in order after the realm.
+// String은 인증 헤더에 쓸 수 있도록 챌린지를 realm 다음에 정렬된 매개변수 순서로 형식화합니다.
+func (c Challenge) String() string {
+	var b strings.Builder
+	b.WriteString(c.Scheme)
+	sep := " "
+	param := func(k, v string) {
+		b.WriteString(sep)
+		b.WriteString(k)
+		b.WriteString(`="`)
+		b.WriteString(quoteEscaper.Replace(v))
+		b.WriteByte('"')
+		sep = ", "
+	}
+	if c.Realm != "" {
+		param("realm", c.Realm)
+	}
+	keys := make([]string, 0, len(c.Params))
+	for k := range c.Params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		param(k, c.Params[k])
+	}
+	return b.String()
+}
+
+// quoteEscaper escapes the characters of a quoted-string.
+var quoteEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// WithChallenge adds the challenges to the WWW-Authenticate header a 401
+// Unauthorized response must carry.
+// WithChallenge는 401 Unauthorized 응답에 포함되어야 하는 WWW-Authenticate 헤더에 챌린지를 추가합니다.
+func WithChallenge(challenges ...Challenge) Option {
+	return withChallenges("WWW-Authenticate", challenges)
+}
+
+// WithProxyChallenge adds the challenges to the Proxy-Authenticate header a
+// 407 Proxy Authentication Required response must carry.
+// WithProxyChallenge는 407 Proxy Authentication Required 응답에 포함되어야 하는 Proxy-Authenticate 헤더에 챌린지를 추가합니다.
+func WithProxyChallenge(challenges ...Challenge) Option {
+	return withChallenges("Proxy-Authenticate", challenges)
+}
+
+// withChallenges adds the challenges to the header key.
+func withChallenges(key string, challenges []Challenge) Option {
+	return optionFunc(func(e *HttpError) {
+		if e.Header == nil {
+			e.Header = make(http.Header)
+		}
+		for _, c := range challenges {
+			e.Header.Add(key, c.String())
+		}
+	})
+}
+
+// UnauthorizedChallenge responds with a 401 Unauthorized error carrying the
+// challenge in the WWW-Authenticate header, see WithChallenge.
+// UnauthorizedChallenge는 WWW-Authenticate 헤더에 챌린지를 담은 401 Unauthorized 오류로 응답합니다. WithChallenge를 참고하세요.
+func UnauthorizedChallenge(w http.ResponseWriter, r *http.Request, c Challenge, opts ...Option) {
+	Respond(w, r, UnauthorizedChallengeError(c, opts...))
+}
+
+// UnauthorizedChallengeError creates a 401 Unauthorized HttpError carrying the
+// challenge in the WWW-Authenticate header.
+// UnauthorizedChallengeError는 WWW-Authenticate 헤더에 챌린지를 담은 401 Unauthorized HttpError를 생성합니다.
+func UnauthorizedChallengeError(c Challenge, opts ...Option) *HttpError {
+	return newWithOptions(http.StatusUnauthorized, append([]Option{WithChallenge(c)}, opts...))
+}
+
+// ProxyAuthRequiredChallenge responds with a 407 Proxy Authentication
+// Required error carrying the challenge in the Proxy-Authenticate header, see
+// WithProxyChallenge.
+// ProxyAuthRequiredChallenge는 Proxy-Authenticate 헤더에 챌린지를 담은 407 Proxy Authentication Required 오류로 응답합니다.
+// WithProxyChallenge를 참고하세요.
+func ProxyAuthRequiredChallenge(w http.ResponseWriter, r *http.Request, c Challenge, opts ...Option) {
+	Respond(w, r, ProxyAuthRequiredChallengeError(c, opts...))
+}
+
+// ProxyAuthRequiredChallengeError creates a 407 Proxy Authentication Required
+// HttpError carrying the challenge in the Proxy-Authenticate header.
+// ProxyAuthRequiredChallengeError는 Proxy-Authenticate 헤더에 챌린지를 담은 407 Proxy Authentication Required HttpError를 생성합니다.
+func ProxyAuthRequiredChallengeError(c Challenge, opts ...Option) *HttpError {
+	return newWithOptions(http.StatusProxyAuthRequired, append([]Option{WithProxyChallenge(c)}, opts...))
+}
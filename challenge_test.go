@@ -0,0 +1,62 @@
+package httperror
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+// TestChallengeString tests formatting authentication challenges.
+func TestChallengeString(t *testing.T) {
+	testCases := []struct {
+		name      string
+		challenge Challenge
+		expected  string
+	}{
+		{"scheme only", Challenge{Scheme: "Negotiate"}, "Negotiate"},
+		{"realm", Challenge{Scheme: "Basic", Realm: "proxy"}, `Basic realm="proxy"`},
+		{"params", Challenge{Scheme: "Bearer", Realm: "api", Params: map[string]string{"scope": "read", "error": "invalid_token"}}, `Bearer realm="api", error="invalid_token", scope="read"`},
+		{"escaped", Challenge{Scheme: "Basic", Realm: `say "hi" \o/`}, `Basic realm="say \"hi\" \\o/"`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.challenge.String(); got != tc.expected {
+				t.Errorf("expected %s, got %s", tc.expected, got)
+			}
+		})
+	}
+}
+
+// TestAuthenticationChallenges tests that 401 and 407 responses carry their challenges.
+func TestAuthenticationChallenges(t *testing.T) {
+	testCases := []struct {
+		name           string
+		respond        func(w http.ResponseWriter, r *http.Request)
+		expectedStatus int
+		expectedHeader string
+		expectedValues []string
+	}{
+		{"proxy", func(w http.ResponseWriter, r *http.Request) {
+			ProxyAuthRequiredChallenge(w, r, Challenge{Scheme: "Basic", Realm: "corp-proxy"})
+		}, http.StatusProxyAuthRequired, "Proxy-Authenticate", []string{`Basic realm="corp-proxy"`}},
+		{"proxy with more challenges", func(w http.ResponseWriter, r *http.Request) {
+			ProxyAuthRequiredChallenge(w, r, Challenge{Scheme: "Negotiate"}, WithProxyChallenge(Challenge{Scheme: "Basic", Realm: "corp-proxy"}))
+		}, http.StatusProxyAuthRequired, "Proxy-Authenticate", []string{"Negotiate", `Basic realm="corp-proxy"`}},
+		{"origin", func(w http.ResponseWriter, r *http.Request) {
+			UnauthorizedChallenge(w, r, Challenge{Scheme: "Bearer", Realm: "api"})
+		}, http.StatusUnauthorized, "WWW-Authenticate", []string{`Bearer realm="api"`}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			tc.respond(rr, httptest.NewRequest("GET", "/", nil))
+			if rr.Code != tc.expectedStatus {
+				t.Errorf("expected status %d, got %d", tc.expectedStatus, rr.Code)
+			}
+			if got := rr.Header().Values(tc.expectedHeader); !reflect.DeepEqual(got, tc.expectedValues) {
+				t.Errorf("expected %s %q, got %q", tc.expectedHeader, tc.expectedValues, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package httperror
+
+import (
+	"net/http"
+	"time"
+)
+
+// AuthError is returned by CheckResponse for 401 Unauthorized and 403 Forbidden responses.
+// AuthError는 401 Unauthorized와 403 Forbidden 응답에 대해 CheckResponse가 반환합니다.
+type AuthError struct {
+	*HttpError
+}
+
+// Unwrap returns the underlying HttpError.
+func (e *AuthError) Unwrap() error { return e.HttpError }
+
+// RateLimitError is returned by CheckResponse for 429 Too Many Requests responses.
+// RateLimitError는 429 Too Many Requests 응답에 대해 CheckResponse가 반환합니다.
+type RateLimitError struct {
+	*HttpError
+	// RetryAfter is the delay requested by the Retry-After header, or 0 if there was none.
+	RetryAfter time.Duration
+}
+
+// Unwrap returns the underlying HttpError.
+func (e *RateLimitError) Unwrap() error { return e.HttpError }
+
+// ValidationError is returned by CheckResponse for 400 Bad Request and 422
+// Unprocessable Entity responses. The invalid fields are usually described in Details.
+// ValidationError는 400 Bad Request와 422 Unprocessable Entity 응답에 대해 CheckResponse가 반환합니다.
+type ValidationError struct {
+	*HttpError
+}
+
+// Unwrap returns the underlying HttpError.
+func (e *ValidationError) Unwrap() error { return e.HttpError }
+
+// ServerError is returned by CheckResponse for 5xx responses.
+// ServerError는 5xx 응답에 대해 CheckResponse가 반환합니다.
+type ServerError struct {
+	*HttpError
+}
+
+// Unwrap returns the underlying HttpError.
+func (e *ServerError) Unwrap() error { return e.HttpError }
+
+// CheckResponse returns nil for responses below 400 and otherwise an error
+// describing the failure, for SDKs built on this package. The error body is
+// decoded with ParseResponse; auth failures, rate limiting, validation failures
+// and server errors are returned as *AuthError, *RateLimitError,
+// *ValidationError and *ServerError, other statuses as *HttpError. All of them
+// unwrap to the *HttpError, so errors.As(err, &httpErr) always works.
+// The body is read but not closed.
+// CheckResponse는 400 미만의 응답에 대해 nil을, 그 외에는 실패를 설명하는 오류를 반환합니다.
+// 인증 실패, 요청 제한, 검증 실패, 서버 오류는 각각 *AuthError, *RateLimitError, *ValidationError,
+// *ServerError로, 그 밖의 상태는 *HttpError로 반환되며 모두 *HttpError로 언래핑됩니다.
+func CheckResponse(resp *http.Response) error {
+	e, err := ParseResponse(resp)
+	if e == nil {
+		return nil
+	}
+	if err != nil {
+		e.cause = err
+	}
+
+	switch {
+	case e.Status == http.StatusUnauthorized, e.Status == http.StatusForbidden:
+		return &AuthError{e}
+	case e.Status == http.StatusTooManyRequests:
+		d, _ := RetryAfter(e)
+		return &RateLimitError{HttpError: e, RetryAfter: d}
+	case e.Status == http.StatusBadRequest, e.Status == http.StatusUnprocessableEntity:
+		return &ValidationError{e}
+	case e.Status >= 500:
+		return &ServerError{e}
+	}
+	return e
+}
@@ -0,0 +1,54 @@
+package httperror
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// TestCheckResponse tests the typed errors returned for each family of statuses.
+func TestCheckResponse(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      *HttpError
+		expected any
+	}{
+		{"unauthorized", UnauthorizedError(), &AuthError{}},
+		{"forbidden", ForbiddenError(), &AuthError{}},
+		{"rate limited", TooManyRequestsError(WithHeader("Retry-After", "30")), &RateLimitError{}},
+		{"bad request", BadRequestError(), &ValidationError{}},
+		{"unprocessable", UnprocessableEntityError(WithDetail("field", "email")), &ValidationError{}},
+		{"server", BadGatewayError(), &ServerError{}},
+		{"other", NotFoundError(), &HttpError{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			Respond(rr, httptest.NewRequest("GET", "/", nil), tc.err)
+			err := CheckResponse(rr.Result())
+
+			if reflect.TypeOf(err) != reflect.TypeOf(tc.expected) {
+				t.Fatalf("expected %T, got %T", tc.expected, err)
+			}
+			var httpErr *HttpError
+			if !errors.As(err, &httpErr) || httpErr.Status != tc.err.Status || httpErr.Message != tc.err.Message {
+				t.Errorf("expected the error to unwrap to %+v, got %+v", tc.err, httpErr)
+			}
+		})
+	}
+
+	rr := httptest.NewRecorder()
+	Respond(rr, httptest.NewRequest("GET", "/", nil), TooManyRequestsError(WithHeader("Retry-After", "30")))
+	var rateErr *RateLimitError
+	if err := CheckResponse(rr.Result()); !errors.As(err, &rateErr) || rateErr.RetryAfter != 30*time.Second {
+		t.Errorf("expected a RateLimitError with RetryAfter 30s, got %v", err)
+	}
+
+	if err := CheckResponse(&http.Response{StatusCode: http.StatusOK}); err != nil {
+		t.Errorf("expected nil for a success, got %v", err)
+	}
+}
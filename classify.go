@@ -0,0 +1,63 @@
+package httperror
+
+import (
+	"errors"
+	"net/http"
+)
+
+// FromError returns the HttpError in err's chain, unwrapping through wrapped
+// and joined errors, and whether there is one.
+// FromError는 감싸거나 결합된 오류를 풀어 err 체인에 있는 HttpError와 그 존재 여부를 반환합니다.
+func FromError(err error) (*HttpError, bool) {
+	var e *HttpError
+	if !errors.As(err, &e) || e == nil {
+		return nil, false
+	}
+	return e, true
+}
+
+// Resolve returns the HttpError err is rendered as by Respond: the HttpError
+// in its chain, or the translation of the registered mappers and built-in
+// mappings, e.g. 504 for context.DeadlineExceeded, or else a 500 error
+// exposing nothing of err. The result may be shared, so modify a Clone of it.
+// A nil err gives nil.
+// Resolve는 Respond가 err를 렌더링할 때 사용하는 HttpError를 반환합니다. 체인에 있는 HttpError, 등록된 매퍼와
+// 내장 매핑의 변환 결과(예: context.DeadlineExceeded는 504), 또는 err의 내용을 노출하지 않는 500 오류입니다.
+// 결과는 공유될 수 있으므로 Clone으로 복제하여 수정하세요. err가 nil이면 nil을 반환합니다.
+func Resolve(err error) *HttpError {
+	if err == nil {
+		return nil
+	}
+	return toHttpError(err)
+}
+
+// StatusCode returns the status of the HttpError in err's chain, or 500 if
+// there is none. A nil error results in 200.
+// StatusCode는 err 체인에 있는 HttpError의 상태 코드를 반환하며, 없으면 500을 반환합니다. nil 오류이면 200을 반환합니다.
+func StatusCode(err error) int {
+	if err == nil {
+		return http.StatusOK
+	}
+	if e, ok := FromError(err); ok {
+		return e.Status
+	}
+	return http.StatusInternalServerError
+}
+
+// IsClientError reports whether err wraps an HttpError with a 4xx status, so
+// retry and alerting logic can tell caller mistakes apart without extracting
+// the status. It returns false for nil and for errors without an HttpError.
+// IsClientError는 err가 4xx 상태 코드의 HttpError를 감싸고 있는지 보고하여, 재시도나 알림 로직이 상태 코드를
+// 직접 꺼내지 않고도 호출자의 실수를 구분할 수 있게 합니다. nil이나 HttpError가 없는 오류에는 false를 반환합니다.
+func IsClientError(err error) bool {
+	e, ok := FromError(err)
+	return ok && e.Status >= 400 && e.Status < 500
+}
+
+// IsServerError reports whether err wraps an HttpError with a 5xx status. It
+// returns false for nil and for errors without an HttpError.
+// IsServerError는 err가 5xx 상태 코드의 HttpError를 감싸고 있는지 보고합니다. nil이나 HttpError가 없는 오류에는 false를 반환합니다.
+func IsServerError(err error) bool {
+	e, ok := FromError(err)
+	return ok && e.Status >= 500 && e.Status < 600
+}
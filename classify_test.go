@@ -0,0 +1,97 @@
+package httperror
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// TestFromError tests extracting the HttpError and status of errors.
+func TestFromError(t *testing.T) {
+	notFound := NotFoundError()
+
+	testCases := []struct {
+		name           string
+		err            error
+		expected       *HttpError
+		expectedStatus int
+	}{
+		{"nil", nil, nil, 200},
+		{"plain error", errors.New("boom"), nil, 500},
+		{"direct", notFound, notFound, 404},
+		{"wrapped", fmt.Errorf("loading user: %w", notFound), notFound, 404},
+		{"joined", errors.Join(errors.New("boom"), notFound), notFound, 404},
+		{"nil HttpError", (*HttpError)(nil), nil, 500},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := FromError(tc.err)
+			if got != tc.expected || ok != (tc.expected != nil) {
+				t.Errorf("expected %v, %v, got %v, %v", tc.expected, tc.expected != nil, got, ok)
+			}
+			if status := StatusCode(tc.err); status != tc.expectedStatus {
+				t.Errorf("expected status %d, got %d", tc.expectedStatus, status)
+			}
+		})
+	}
+}
+
+// TestResolve tests resolving errors like Respond.
+func TestResolve(t *testing.T) {
+	notFound := NotFoundError()
+
+	testCases := []struct {
+		name            string
+		err             error
+		expectedStatus  int
+		expectedMessage string
+	}{
+		{"wrapped", fmt.Errorf("loading user: %w", notFound), 404, "Not Found"},
+		{"deadline", fmt.Errorf("calling billing: %w", context.DeadlineExceeded), 504, "Gateway Timeout"},
+		{"plain error", errors.New("dial tcp 10.0.0.7:5432: refused"), 500, "Internal Server Error"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := Resolve(tc.err)
+			if got.Status != tc.expectedStatus || got.Message != tc.expectedMessage {
+				t.Errorf("expected %d %q, got %d %q", tc.expectedStatus, tc.expectedMessage, got.Status, got.Message)
+			}
+		})
+	}
+
+	if Resolve(nil) != nil {
+		t.Error("expected nil for a nil error")
+	}
+}
+
+// TestIsClientServerError tests classifying errors by status class.
+func TestIsClientServerError(t *testing.T) {
+	testCases := []struct {
+		name           string
+		err            error
+		expectedClient bool
+		expectedServer bool
+	}{
+		{"nil", nil, false, false},
+		{"plain error", errors.New("boom"), false, false},
+		{"404", NotFoundError(), true, false},
+		{"429 wrapped", fmt.Errorf("calling billing: %w", TooManyRequestsError()), true, false},
+		{"500", InternalServerErrorError(), false, true},
+		{"503 wrapped twice", fmt.Errorf("a: %w", fmt.Errorf("b: %w", ServiceUnavailableError())), false, true},
+		{"remapped", Remap(InternalServerErrorError(), 404), true, false},
+		{"redirect", New(302, ""), false, false},
+		{"rate limit", &RateLimitError{HttpError: TooManyRequestsError()}, true, false},
+		{"nil HttpError", (*HttpError)(nil), false, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := IsClientError(tc.err); got != tc.expectedClient {
+				t.Errorf("IsClientError: expected %v, got %v", tc.expectedClient, got)
+			}
+			if got := IsServerError(tc.err); got != tc.expectedServer {
+				t.Errorf("IsServerError: expected %v, got %v", tc.expectedServer, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,12 @@
+package httperror
+
+// StatusClientClosedRequest is the non-standard 499 status, introduced by nginx,
+// recorded when the client closed the connection before the response was sent.
+// Its helpers, such as ClientClosedRequest, are generated with the standard
+// ones; they record the status for access logs and hooks, but write no body
+// since the client is gone. Use SetCanceledStatus(StatusClientClosedRequest)
+// to map context.Canceled to it.
+// StatusClientClosedRequest는 nginx가 도입한 비표준 499 상태 코드로, 응답 전에 클라이언트가 연결을 종료한 경우 기록됩니다.
+// ClientClosedRequest 같은 헬퍼는 표준 상태 코드와 함께 생성되며, 접근 로그와 훅을 위해 상태 코드를 기록하지만
+// 클라이언트가 떠났으므로 본문은 작성하지 않습니다.
+const StatusClientClosedRequest = 499
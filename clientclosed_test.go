@@ -0,0 +1,42 @@
+package httperror
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestClientClosedRequest tests that 499 responses are recorded without a body.
+func TestClientClosedRequest(t *testing.T) {
+	var got ErrorEvent
+	AddHook(func(ev ErrorEvent) { got = ev })
+	defer ResetHooks()
+
+	rr := httptest.NewRecorder()
+	ClientClosedRequest(rr, httptest.NewRequest("GET", "/", nil))
+	if rr.Code != StatusClientClosedRequest || rr.Body.Len() != 0 {
+		t.Errorf("expected 499 without body, got %d %q", rr.Code, rr.Body.String())
+	}
+	if got.HttpError.Message != "Client Closed Request" || got.Response.Status != StatusClientClosedRequest {
+		t.Errorf("unexpected event: %+v", got)
+	}
+	if ErrClientClosedRequest.Message != "Client Closed Request" {
+		t.Errorf("expected the sentinel to carry the status text, got %q", ErrClientClosedRequest.Message)
+	}
+	if !errors.Is(New(StatusClientClosedRequest, "gone"), ErrClientClosedRequest) {
+		t.Error("expected errors.Is to match ErrClientClosedRequest")
+	}
+}
+
+// TestCanceledAsClientClosedRequest tests mapping context.Canceled to 499.
+func TestCanceledAsClientClosedRequest(t *testing.T) {
+	SetCanceledStatus(StatusClientClosedRequest)
+	defer SetCanceledStatus(0)
+
+	rr := httptest.NewRecorder()
+	Respond(rr, httptest.NewRequest("GET", "/", nil), context.Canceled)
+	if rr.Code != StatusClientClosedRequest || rr.Body.Len() != 0 {
+		t.Errorf("expected 499 without body, got %d %q", rr.Code, rr.Body.String())
+	}
+}
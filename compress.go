@@ -0,0 +1,80 @@
+package httperror
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// WithCompression gzips error bodies of at least minSize bytes, such as
+// validation error arrays and HTML pages, for requests accepting it. Smaller
+// bodies are not worth the overhead; a minSize of 0 or less disables
+// compression. Responses already encoded by an upstream compressing writer,
+// which sets Content-Encoding, are left to it.
+// WithCompression은 검증 오류 배열이나 HTML 페이지처럼 minSize 바이트 이상인 오류 본문을 요청이 허용하면
+// gzip으로 압축합니다. 더 작은 본문은 압축할 가치가 없으며, minSize가 0 이하이면 압축하지 않습니다.
+// Content-Encoding을 설정하는 상위 압축 writer가 이미 인코딩하는 응답은 그 writer에 맡깁니다.
+func WithCompression(minSize int) ResponderOption {
+	return func(cfg *responderConfig) { cfg.compressMin = max(minSize, 0) }
+}
+
+// gzipPool holds the gzip writers error bodies are compressed with.
+var gzipPool = sync.Pool{
+	New: func() any { return gzip.NewWriter(io.Discard) },
+}
+
+// compress returns body gzipped into buf, setting the headers of h, if the
+// configuration and the request allow it.
+func (cfg *responderConfig) compress(h http.Header, r *http.Request, body []byte, buf *bytes.Buffer) []byte {
+	if cfg.compressMin == 0 || h.Get("Content-Encoding") != "" {
+		return body
+	}
+	addVary(h, "Accept-Encoding")
+	if len(body) < cfg.compressMin || !acceptsGzip(r) {
+		return body
+	}
+
+	zw := gzipPool.Get().(*gzip.Writer)
+	defer gzipPool.Put(zw)
+	zw.Reset(buf)
+	if _, err := zw.Write(body); err != nil {
+		return body
+	}
+	if err := zw.Close(); err != nil {
+		return body
+	}
+	h.Set("Content-Encoding", "gzip")
+	h.Del("Content-Length")
+	return buf.Bytes()
+}
+
+// acceptsGzip reports whether the Accept-Encoding header of r allows gzip.
+func acceptsGzip(r *http.Request) bool {
+	if r == nil {
+		return false
+	}
+	accepted := false
+	for _, part := range strings.Split(strings.Join(r.Header.Values("Accept-Encoding"), ","), ",") {
+		coding, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		coding = strings.ToLower(strings.TrimSpace(coding))
+		if coding != "gzip" && coding != "x-gzip" && coding != "*" {
+			continue
+		}
+		q := 1.0
+		if name, value, ok := strings.Cut(strings.TrimSpace(params), "="); ok && strings.EqualFold(strings.TrimSpace(name), "q") {
+			if v, err := strconv.ParseFloat(strings.TrimSpace(value), 64); err == nil {
+				q = v
+			}
+		}
+		if coding != "*" {
+			// An explicit gzip coding overrides the wildcard.
+			return q > 0
+		}
+		accepted = q > 0
+	}
+	return accepted
+}
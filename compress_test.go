@@ -0,0 +1,67 @@
+package httperror
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// TestWithCompression tests gzipping large error bodies.
+func TestWithCompression(t *testing.T) {
+	large := BadRequestError(WithMessage(strings.Repeat("invalid field; ", 100)))
+
+	testCases := []struct {
+		name             string
+		minSize          int
+		acceptEncoding   string
+		contentEncoding  string
+		err              *HttpError
+		expectedEncoding string
+		expectedVary     string
+	}{
+		{"gzipped", 256, "gzip, deflate", "", large, "gzip", "Accept-Encoding"},
+		{"wildcard", 256, "br;q=1, *;q=0.5", "", large, "gzip", "Accept-Encoding"},
+		{"refused", 256, "gzip;q=0, *", "", large, "", "Accept-Encoding"},
+		{"not accepted", 256, "br", "", large, "", "Accept-Encoding"},
+		{"below threshold", 256, "gzip", "", NotFoundError(), "", "Accept-Encoding"},
+		{"upstream compression", 256, "gzip", "br", large, "br", ""},
+		{"disabled", 0, "gzip", "", large, "", ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/", nil)
+			req.Header.Set("Accept-Encoding", tc.acceptEncoding)
+			rr := httptest.NewRecorder()
+			if tc.contentEncoding != "" {
+				rr.Header().Set("Content-Encoding", tc.contentEncoding)
+			}
+			NewResponder(WithCompression(tc.minSize), WithEncoders(JSONEncoder{})).HandleError(rr, req, tc.err)
+
+			if got := rr.Header().Get("Content-Encoding"); got != tc.expectedEncoding {
+				t.Errorf("expected Content-Encoding %q, got %q", tc.expectedEncoding, got)
+			}
+			if got := rr.Header().Get("Vary"); got != tc.expectedVary {
+				t.Errorf("expected Vary %q, got %q", tc.expectedVary, got)
+			}
+
+			body := rr.Body.String()
+			if tc.expectedEncoding == "gzip" {
+				zr, err := gzip.NewReader(rr.Body)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				data, err := io.ReadAll(zr)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				body = string(data)
+			}
+			if !strings.Contains(body, tc.err.Message) {
+				t.Errorf("expected the body to contain the message, got %q", body)
+			}
+		})
+	}
+}
@@ -0,0 +1,269 @@
+package httperror
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// Modes of a Config.
+const (
+	ModeDebug   = "debug"
+	ModeRelease = "release"
+)
+
+// Config describes how errors are presented, so it can be tuned per
+// environment from a file read by LoadConfig instead of in code.
+// Config는 오류 표현 방식을 설명하며, 코드 대신 LoadConfig로 읽은 파일로 환경별로 조정할 수 있게 합니다.
+type Config struct {
+	// Mode is ModeDebug, which enables debug responses, or ModeRelease (the default).
+	Mode string `json:"mode" yaml:"mode"`
+	// Messages replaces the default messages of statuses, see WithDefaultMessages.
+	Messages map[int]string `json:"messages" yaml:"messages"`
+	// Envelope wraps JSON responses in an object under this key, see WithEnvelope.
+	Envelope string `json:"envelope" yaml:"envelope"`
+	// FieldNames renames the members of JSON responses, see WithFieldNames.
+	FieldNames map[string]string `json:"field_names" yaml:"field_names"`
+	// KeyCase is the case of detail keys in JSON responses, "snake" (the
+	// default) or "camel", see WithKeyCase.
+	KeyCase string `json:"key_case" yaml:"key_case"`
+	// Formats fixes the format of responses, "json" or "html", by path
+	// prefix, see WithPathEncoders.
+	Formats map[string]string `json:"formats" yaml:"formats"`
+	// RouteMessages are default messages per status by route pattern, see
+	// RegisterRouteMessages.
+	RouteMessages map[string]map[int]string `json:"route_messages" yaml:"route_messages"`
+	// StatusDocs and CodeDocs are documentation URLs, see RegisterStatusDoc and RegisterCodeDoc.
+	StatusDocs map[int]string    `json:"status_docs" yaml:"status_docs"`
+	CodeDocs   map[string]string `json:"code_docs" yaml:"code_docs"`
+	// Language is the language used when none is negotiated, see WithLanguage.
+	Language string `json:"language" yaml:"language"`
+	// ExposeSeverity renders the severity of errors, see WithSeverityDetail.
+	ExposeSeverity bool `json:"expose_severity" yaml:"expose_severity"`
+	// HideTraceIDs leaves the trace and span IDs out of responses, see WithTraceIDs.
+	HideTraceIDs bool `json:"hide_trace_ids" yaml:"hide_trace_ids"`
+	// EchoIdempotencyKey reflects the Idempotency-Key of requests in
+	// responses, see WithIdempotencyKeyEcho.
+	EchoIdempotencyKey bool `json:"echo_idempotency_key" yaml:"echo_idempotency_key"`
+	// CORS is the policy of the CORS headers of error responses, see WithCORS.
+	CORS *CORSPolicy `json:"cors" yaml:"cors"`
+	// CacheControl sets the Cache-Control header of errors by status, see
+	// WithStatusCacheControl.
+	CacheControl map[int]string `json:"cache_control" yaml:"cache_control"`
+	// CompressMinSize is the size from which error bodies are gzipped, see
+	// WithCompression; 0 disables compression.
+	CompressMinSize int `json:"compress_min_size" yaml:"compress_min_size"`
+	// Redact lists regular expressions whose matches are scrubbed from
+	// responses, see WithRedactors and RedactPatterns.
+	Redact []string `json:"redact" yaml:"redact"`
+	// Catalogs are message catalogs by language, see RegisterCatalog.
+	Catalogs map[string]Catalog `json:"catalogs" yaml:"catalogs"`
+}
+
+// ConfigDecoder decodes a configuration file into v.
+// ConfigDecoder는 설정 파일을 v로 디코딩합니다.
+type ConfigDecoder func(data []byte, v any) error
+
+// configDecoders holds an immutable snapshot of the decoders by file
+// extension, replaced as a whole under configDecodersMu.
+var (
+	configDecodersMu sync.Mutex
+	configDecoders   atomic.Pointer[map[string]ConfigDecoder]
+)
+
+// RegisterConfigDecoder registers the decoder of configuration files with the
+// given extension, e.g. ".yaml". JSON is supported out of the box; importing
+// the httperroryaml module registers YAML.
+// RegisterConfigDecoder는 주어진 확장자(예: ".yaml")의 설정 파일 디코더를 등록합니다.
+// JSON은 기본으로 지원되며, httperroryaml 모듈을 import하면 YAML이 등록됩니다.
+func RegisterConfigDecoder(ext string, decode ConfigDecoder) {
+	configDecodersMu.Lock()
+	defer configDecodersMu.Unlock()
+	next := map[string]ConfigDecoder{}
+	if m := configDecoders.Load(); m != nil {
+		for k, v := range *m {
+			next[k] = v
+		}
+	}
+	next[strings.ToLower(ext)] = decode
+	configDecoders.Store(&next)
+}
+
+// configDecoder returns the decoder of files with the extension of path.
+func configDecoder(path string) (ConfigDecoder, bool) {
+	ext := strings.ToLower(filepath.Ext(path))
+	if m := configDecoders.Load(); m != nil {
+		if decode, ok := (*m)[ext]; ok {
+			return decode, true
+		}
+	}
+	if ext == ".json" {
+		return json.Unmarshal, true
+	}
+	return nil, false
+}
+
+// LoadConfig reads the configuration file at path, decoded according to its
+// extension (see RegisterConfigDecoder), and applies it to the DefaultResponder
+// and the global registries. Use ReadConfig and Config.Apply to configure
+// another Responder.
+// LoadConfig는 path의 설정 파일을 확장자에 따라 디코딩하여(RegisterConfigDecoder 참고)
+// DefaultResponder와 전역 레지스트리에 적용합니다. 다른 Responder를 설정하려면 ReadConfig와 Config.Apply를 사용하세요.
+func LoadConfig(path string) error {
+	cfg, err := ReadConfig(path)
+	if err != nil {
+		return err
+	}
+	cfg.Apply(defaultResponder)
+	return nil
+}
+
+// ReadConfig reads and validates the configuration file at path.
+// ReadConfig는 path의 설정 파일을 읽고 검증합니다.
+func ReadConfig(path string) (*Config, error) {
+	decode, ok := configDecoder(path)
+	if !ok {
+		return nil, fmt.Errorf("httperror: unsupported configuration format %q", filepath.Ext(path))
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("httperror: reading configuration: %w", err)
+	}
+	var cfg Config
+	if err := decode(data, &cfg); err != nil {
+		return nil, fmt.Errorf("httperror: decoding configuration %s: %w", path, err)
+	}
+	if err := cfg.validate(); err != nil {
+		return nil, fmt.Errorf("httperror: invalid configuration %s: %w", path, err)
+	}
+	return &cfg, nil
+}
+
+// validate checks the values of the configuration.
+func (c *Config) validate() error {
+	switch c.Mode {
+	case "", ModeDebug, ModeRelease:
+	default:
+		return fmt.Errorf("unknown mode %q", c.Mode)
+	}
+	for status := range c.Messages {
+		if status < 100 || status > 999 {
+			return fmt.Errorf("invalid status %d in messages", status)
+		}
+	}
+	if _, err := resolveJSONNames(c.FieldNames); err != nil {
+		return fmt.Errorf("invalid field_names: %w", err)
+	}
+	switch c.KeyCase {
+	case "", "snake", "camel":
+	default:
+		return fmt.Errorf("unknown key_case %q", c.KeyCase)
+	}
+	for prefix, format := range c.Formats {
+		if _, err := formatEncoder(format); err != nil {
+			return fmt.Errorf("invalid formats for %q: %w", prefix, err)
+		}
+	}
+	for pattern, messages := range c.RouteMessages {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid route pattern %q: %w", pattern, err)
+		}
+		for status := range messages {
+			if status < 100 || status > 999 {
+				return fmt.Errorf("invalid status %d in route_messages", status)
+			}
+		}
+	}
+	for status := range c.CacheControl {
+		if status < 100 || status > 999 {
+			return fmt.Errorf("invalid status %d in cache_control", status)
+		}
+	}
+	if c.CompressMinSize < 0 {
+		return fmt.Errorf("negative compress_min_size %d", c.CompressMinSize)
+	}
+	for status := range c.StatusDocs {
+		if status < 100 || status > 999 {
+			return fmt.Errorf("invalid status %d in status_docs", status)
+		}
+	}
+	for _, pattern := range c.Redact {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("invalid redact pattern: %w", err)
+		}
+	}
+	return nil
+}
+
+// Options returns the Responder options described by the configuration.
+// Options는 설정이 나타내는 Responder 옵션을 반환합니다.
+func (c *Config) Options() []ResponderOption {
+	var redactors []Redactor
+	if len(c.Redact) > 0 {
+		patterns := make([]*regexp.Regexp, 0, len(c.Redact))
+		for _, pattern := range c.Redact {
+			if re, err := regexp.Compile(pattern); err == nil {
+				patterns = append(patterns, re)
+			}
+		}
+		redactors = append(redactors, RedactPatterns(patterns...))
+	}
+	fieldNames := c.FieldNames
+	if _, err := resolveJSONNames(fieldNames); err != nil {
+		fieldNames = nil
+	}
+	var pathEncoders map[string]Encoder
+	for prefix, format := range c.Formats {
+		if enc, err := formatEncoder(format); err == nil {
+			if pathEncoders == nil {
+				pathEncoders = make(map[string]Encoder, len(c.Formats))
+			}
+			pathEncoders[prefix] = enc
+		}
+	}
+	keyCase := SnakeCase
+	if c.KeyCase == "camel" {
+		keyCase = CamelCase
+	}
+	return []ResponderOption{
+		WithDebug(c.Mode == ModeDebug),
+		WithDefaultMessages(c.Messages),
+		WithEnvelope(c.Envelope),
+		WithFieldNames(fieldNames),
+		WithKeyCase(keyCase),
+		WithPathEncoders(pathEncoders),
+		WithLanguage(c.Language),
+		WithSeverityDetail(c.ExposeSeverity),
+		WithRedactors(redactors...),
+		WithTraceIDs(!c.HideTraceIDs),
+		WithIdempotencyKeyEcho(c.EchoIdempotencyKey),
+		WithCORS(c.CORS),
+		WithStatusCacheControl(c.CacheControl),
+		WithCompression(c.CompressMinSize),
+	}
+}
+
+// Apply configures rs with the configuration and registers its route
+// messages, documentation URLs and catalogs.
+// Apply는 설정으로 rs를 구성하고 경로 메시지, 문서 URL, 카탈로그를 등록합니다.
+func (c *Config) Apply(rs *Responder) {
+	rs.Configure(c.Options()...)
+	for pattern, messages := range c.RouteMessages {
+		RegisterRouteMessages(pattern, messages)
+	}
+	for status, url := range c.StatusDocs {
+		RegisterStatusDoc(status, url)
+	}
+	for code, url := range c.CodeDocs {
+		RegisterCodeDoc(code, url)
+	}
+	for lang, catalog := range c.Catalogs {
+		RegisterCatalog(lang, catalog)
+	}
+}
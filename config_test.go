@@ -0,0 +1,122 @@
+package httperror
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeConfig writes a configuration file named name and returns its path.
+func writeConfig(t *testing.T, name, data string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// TestReadConfig tests reading and validating configuration files.
+func TestReadConfig(t *testing.T) {
+	testCases := []struct {
+		name        string
+		file        string
+		data        string
+		expectedErr string
+	}{
+		{"json", "errors.json", `{"mode":"debug","messages":{"404":"No such page"}}`, ""},
+		{"cors", "errors.json", `{"cors":{"allow_origins":["https://app.example.com"],"allow_credentials":true}}`, ""},
+		{"upper case extension", "errors.JSON", `{}`, ""},
+		{"unknown format", "errors.toml", ``, "unsupported configuration format"},
+		{"malformed", "errors.json", `{"mode":`, "decoding configuration"},
+		{"unknown mode", "errors.json", `{"mode":"verbose"}`, `unknown mode "verbose"`},
+		{"invalid redact pattern", "errors.json", `{"redact":["("]}`, "invalid redact pattern"},
+		{"unknown field name", "errors.json", `{"field_names":{"title":"detail"}}`, `unknown field "title"`},
+		{"duplicate field name", "errors.json", `{"field_names":{"message":"code"}}`, `duplicate field name "code"`},
+		{"unknown key case", "errors.json", `{"key_case":"kebab"}`, `unknown key_case "kebab"`},
+		{"unknown format", "errors.json", `{"formats":{"/api/":"xml"}}`, `unknown format "xml"`},
+		{"invalid route pattern", "errors.json", `{"route_messages":{"/users/[":{"404":"user not found"}}}`, "invalid route pattern"},
+		{"invalid route status", "errors.json", `{"route_messages":{"/users/*":{"42":"user not found"}}}`, "invalid status 42 in route_messages"},
+		{"invalid cache control status", "errors.json", `{"cache_control":{"42":"no-cache"}}`, "invalid status 42 in cache_control"},
+		{"negative compress min size", "errors.json", `{"compress_min_size":-1}`, "negative compress_min_size -1"},
+		{"invalid status", "errors.json", `{"status_docs":{"42":"https://example.com"}}`, "invalid status 42"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ReadConfig(writeConfig(t, tc.file, tc.data))
+			if tc.expectedErr == "" && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tc.expectedErr != "" && (err == nil || !strings.Contains(err.Error(), tc.expectedErr)) {
+				t.Fatalf("expected error containing %q, got %v", tc.expectedErr, err)
+			}
+		})
+	}
+
+	if _, err := ReadConfig(filepath.Join(t.TempDir(), "missing.json")); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("expected a not exist error, got %v", err)
+	}
+}
+
+// TestLoadConfig tests applying a configuration file to the DefaultResponder.
+func TestLoadConfig(t *testing.T) {
+	defer defaultResponder.Configure(WithDebug(false), WithDefaultMessages(nil), WithEnvelope(""), WithLanguage(""), WithRedactors())
+	defer ResetDocs()
+	defer ResetCatalogs()
+	defer ResetRouteMessages()
+
+	path := writeConfig(t, "errors.json", `{
+		"mode": "release",
+		"envelope": "error",
+		"messages": {"404": "No such page", "500": "Something broke"},
+		"route_messages": {"/users/*": {"404": "No such user"}},
+		"code_docs": {"user_not_found": "https://docs.example.com/errors/user-not-found"},
+		"language": "ko",
+		"redact": ["user \\d+"],
+		"catalogs": {"ko": {"Something broke": "문제가 발생했습니다"}}
+	}`)
+	if err := LoadConfig(path); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	testCases := []struct {
+		name           string
+		acceptLanguage string
+		err            *HttpError
+		expected       HttpError
+	}{
+		{"default message", "en", NotFoundError(), HttpError{Status: 404, Message: "No such page"}},
+		{"explicit message", "en", NotFoundError("user 7 not found", WithCode("user_not_found")), HttpError{Status: 404, Code: "user_not_found", Type: "https://docs.example.com/errors/user-not-found", Message: "[REDACTED] not found"}},
+		{"default language", "", InternalServerErrorError(), HttpError{Status: 500, Message: "문제가 발생했습니다"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/", nil)
+			if tc.acceptLanguage != "" {
+				req.Header.Set("Accept-Language", tc.acceptLanguage)
+			}
+			rr := httptest.NewRecorder()
+			Respond(rr, req, tc.err)
+
+			var body struct{ Error HttpError }
+			if err := json.NewDecoder(rr.Body).Decode(&body); err != nil {
+				t.Fatalf("could not decode response body: %v", err)
+			}
+			got := body.Error
+			if got.Status != tc.expected.Status || got.Code != tc.expected.Code || got.Type != tc.expected.Type || got.Message != tc.expected.Message {
+				t.Errorf("expected %+v, got %+v", tc.expected, got)
+			}
+		})
+	}
+
+	if message, _ := routeMessage(httptest.NewRequest("GET", "/users/7", nil), 404); message != "No such user" {
+		t.Errorf("expected the route message to be registered, got %q", message)
+	}
+}
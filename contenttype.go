@@ -0,0 +1,51 @@
+package httperror
+
+import (
+	"mime"
+	"net/http"
+	"strings"
+)
+
+// RequireContentType returns a middleware rejecting requests with a body whose
+// Content-Type matches none of the given media types with a 415 Unsupported
+// Media Type, listing the accepted types in the "accepted" detail. Media type
+// parameters such as charset are ignored, and a type may be given as a
+// wildcard like "text/*". Requests without a body are passed through.
+// RequireContentType은 본문이 있는 요청의 Content-Type이 주어진 미디어 타입과 일치하지 않으면
+// 허용되는 타입을 "accepted" 상세 정보에 담은 415 Unsupported Media Type으로 거부하는 미들웨어를 반환합니다.
+// charset 같은 매개변수는 무시되며 "text/*" 같은 와일드카드를 사용할 수 있습니다. 본문이 없는 요청은 통과됩니다.
+func RequireContentType(types ...string) func(http.Handler) http.Handler {
+	accepted := make([]string, len(types))
+	for i, t := range types {
+		accepted[i] = strings.ToLower(strings.TrimSpace(t))
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.ContentLength == 0 || r.Body == nil || r.Body == http.NoBody {
+				next.ServeHTTP(w, r)
+				return
+			}
+			mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+			if err != nil || !matchMediaType(accepted, mediaType) {
+				Respond(w, r, UnsupportedMediaTypeError(WithDetail("accepted", types)))
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// matchMediaType reports whether mediaType matches one of patterns, which may
+// use "*" as subtype or as the whole type.
+func matchMediaType(patterns []string, mediaType string) bool {
+	for _, p := range patterns {
+		switch {
+		case p == mediaType, p == "*/*":
+			return true
+		case strings.HasSuffix(p, "/*") && strings.HasPrefix(mediaType, p[:len(p)-1]):
+			return true
+		}
+	}
+	return false
+}
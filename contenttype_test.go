@@ -0,0 +1,59 @@
+package httperror
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestRequireContentType tests that unsupported request media types are rejected with a 415.
+func TestRequireContentType(t *testing.T) {
+	handler := RequireContentType("application/json", "text/*")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	testCases := []struct {
+		name           string
+		contentType    string
+		body           string
+		expectedStatus int
+	}{
+		{"exact match", "application/json", "{}", http.StatusNoContent},
+		{"parameters ignored", "Application/JSON; charset=utf-8", "{}", http.StatusNoContent},
+		{"wildcard", "text/csv", "a,b", http.StatusNoContent},
+		{"no body", "", "", http.StatusNoContent},
+		{"unsupported", "application/xml", "<a/>", http.StatusUnsupportedMediaType},
+		{"missing", "", "{}", http.StatusUnsupportedMediaType},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", "/", strings.NewReader(tc.body))
+			if tc.body == "" {
+				req = httptest.NewRequest("POST", "/", nil)
+			}
+			if tc.contentType != "" {
+				req.Header.Set("Content-Type", tc.contentType)
+			}
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			if rr.Code != tc.expectedStatus {
+				t.Fatalf("expected status %d, got %d", tc.expectedStatus, rr.Code)
+			}
+			if tc.expectedStatus != http.StatusUnsupportedMediaType {
+				return
+			}
+			var body HttpError
+			if err := json.NewDecoder(rr.Body).Decode(&body); err != nil {
+				t.Fatalf("could not decode response body: %v", err)
+			}
+			if !reflect.DeepEqual(body.Details["accepted"], []any{"application/json", "text/*"}) {
+				t.Errorf("expected accepted media types in details, got %v", body.Details["accepted"])
+			}
+		})
+	}
+}
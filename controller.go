@@ -0,0 +1,48 @@
+package httperror
+
+import (
+	"errors"
+	"net/http"
+	"time"
+)
+
+// SetFlush makes the Responder flush the error body right after writing it,
+// so clients on streaming or long-lived connections receive it promptly.
+// SetFlush는 오류 본문을 작성한 직후 플러시하여 스트리밍 또는 장시간 연결된 클라이언트가 즉시 받을 수 있게 합니다.
+func (rs *Responder) SetFlush(flush bool) {
+	rs.update(func(cfg *responderConfig) { cfg.flush = flush })
+}
+
+// SetWriteTimeout sets a write deadline of d before the error response is
+// written, so a slow or stalled client cannot hold the handler indefinitely.
+// A zero duration disables the deadline.
+// SetWriteTimeout은 오류 응답을 작성하기 전에 d 만큼의 쓰기 기한을 설정하여
+// 느리거나 멈춘 클라이언트가 핸들러를 무기한 붙잡지 못하게 합니다. 0이면 기한을 설정하지 않습니다.
+func (rs *Responder) SetWriteTimeout(d time.Duration) {
+	rs.update(func(cfg *responderConfig) { cfg.writeTimeout = d })
+}
+
+// beginWrite applies the write deadline, if configured, before the response is written.
+func (cfg *responderConfig) beginWrite(w http.ResponseWriter) {
+	d := cfg.writeTimeout
+	if d <= 0 {
+		return
+	}
+
+	rc := http.NewResponseController(w)
+	if err := rc.SetWriteDeadline(time.Now().Add(d)); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		cfg.log().Warn("httperror: could not set write deadline", "error", err)
+	}
+}
+
+// endWrite flushes the response, if configured, after it has been written.
+func (cfg *responderConfig) endWrite(w http.ResponseWriter) {
+	if !cfg.flush {
+		return
+	}
+
+	rc := http.NewResponseController(w)
+	if err := rc.Flush(); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		cfg.log().Warn("httperror: could not flush error response", "error", err)
+	}
+}
@@ -0,0 +1,71 @@
+package httperror
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// deadlineRecorder records write deadlines set through http.ResponseController.
+type deadlineRecorder struct {
+	*httptest.ResponseRecorder
+	deadline time.Time
+}
+
+func (d *deadlineRecorder) SetWriteDeadline(t time.Time) error {
+	d.deadline = t
+	return nil
+}
+
+// TestResponderFlush tests that the error body is flushed when configured.
+func TestResponderFlush(t *testing.T) {
+	rs := NewResponder()
+	req := httptest.NewRequest("GET", "/", nil)
+
+	rr := httptest.NewRecorder()
+	rs.HandleError(rr, req, NotFoundError())
+	if rr.Flushed {
+		t.Error("expected no flush by default")
+	}
+
+	rs.SetFlush(true)
+	rr = httptest.NewRecorder()
+	rs.HandleError(rr, req, NotFoundError())
+	if !rr.Flushed {
+		t.Error("expected the response to be flushed")
+	}
+
+	// Flushing works through the guarded writer used by Respond.
+	SetErrorHandler(rs.HandleError)
+	defer SetErrorHandler(nil)
+	rr = httptest.NewRecorder()
+	Respond(rr, req, NotFoundError())
+	if !rr.Flushed {
+		t.Error("expected the response to be flushed through Respond")
+	}
+}
+
+// TestResponderWriteTimeout tests that a write deadline is set before writing.
+func TestResponderWriteTimeout(t *testing.T) {
+	rs := NewResponder()
+	rs.SetWriteTimeout(5 * time.Second)
+
+	dr := &deadlineRecorder{ResponseRecorder: httptest.NewRecorder()}
+	before := time.Now()
+	rs.HandleError(dr, httptest.NewRequest("GET", "/", nil), ServiceUnavailableError())
+
+	if dr.deadline.Before(before.Add(5*time.Second)) || dr.deadline.After(time.Now().Add(5*time.Second)) {
+		t.Errorf("expected a deadline about 5s from now, got %v", dr.deadline)
+	}
+	if dr.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected status %d, got %d", http.StatusServiceUnavailable, dr.Code)
+	}
+
+	// Writers without deadline support are tolerated.
+	rr := httptest.NewRecorder()
+	rs.HandleError(rr, httptest.NewRequest("GET", "/", nil), ServiceUnavailableError())
+	if rr.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected status %d, got %d", http.StatusServiceUnavailable, rr.Code)
+	}
+}
@@ -0,0 +1,87 @@
+package httperror
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// CORSPolicy describes the Access-Control-Allow-* headers emitted on error
+// responses, so browsers let scripts read error bodies even when the error is
+// raised before the CORS middleware runs, e.g. by a body limit or a panic.
+// CORSPolicy는 오류 응답에 포함되는 Access-Control-Allow-* 헤더를 정의하여, 본문 크기 제한이나 패닉처럼
+// CORS 미들웨어가 실행되기 전에 발생한 오류라도 브라우저의 스크립트가 오류 본문을 읽을 수 있게 합니다.
+type CORSPolicy struct {
+	// AllowOrigins lists the allowed origins, e.g. "https://app.example.com";
+	// "*" allows any origin.
+	AllowOrigins []string `json:"allow_origins" yaml:"allow_origins"`
+	// AllowCredentials lets credentialed requests read the response.
+	AllowCredentials bool `json:"allow_credentials" yaml:"allow_credentials"`
+	// ExposeHeaders lists the response headers scripts may read, e.g. "Retry-After".
+	ExposeHeaders []string `json:"expose_headers" yaml:"expose_headers"`
+	// AllowMethods and AllowHeaders are sent on errors answering preflight requests.
+	AllowMethods []string `json:"allow_methods" yaml:"allow_methods"`
+	AllowHeaders []string `json:"allow_headers" yaml:"allow_headers"`
+}
+
+// WithCORS emits the CORS headers of policy on error responses to requests
+// from allowed origins, unless the response already carries an
+// Access-Control-Allow-Origin header. A nil policy disables them.
+// WithCORS는 허용된 출처의 요청에 대한 오류 응답에 policy의 CORS 헤더를 포함합니다.
+// 응답에 이미 Access-Control-Allow-Origin 헤더가 있으면 포함하지 않으며, nil policy는 비활성화합니다.
+func WithCORS(policy *CORSPolicy) ResponderOption {
+	var p *CORSPolicy
+	if policy != nil {
+		c := *policy
+		c.AllowOrigins = slices.Clone(policy.AllowOrigins)
+		c.ExposeHeaders = slices.Clone(policy.ExposeHeaders)
+		c.AllowMethods = slices.Clone(policy.AllowMethods)
+		c.AllowHeaders = slices.Clone(policy.AllowHeaders)
+		p = &c
+	}
+	return func(cfg *responderConfig) { cfg.cors = p }
+}
+
+// allowsOrigin reports whether the policy allows origin.
+func (p *CORSPolicy) allowsOrigin(origin string) bool {
+	for _, allowed := range p.AllowOrigins {
+		if allowed == "*" || strings.EqualFold(allowed, origin) {
+			return true
+		}
+	}
+	return false
+}
+
+// setCORS writes the CORS headers configured for the request, if any.
+func (cfg *responderConfig) setCORS(h http.Header, r *http.Request) {
+	p := cfg.cors
+	if p == nil || r == nil || h.Get("Access-Control-Allow-Origin") != "" {
+		return
+	}
+	origin := r.Header.Get("Origin")
+	if origin == "" || !p.allowsOrigin(origin) {
+		return
+	}
+
+	if slices.Contains(p.AllowOrigins, "*") && !p.AllowCredentials {
+		h.Set("Access-Control-Allow-Origin", "*")
+	} else {
+		// The response depends on the origin, so caches must not share it.
+		h.Set("Access-Control-Allow-Origin", origin)
+		addVary(h, "Origin")
+	}
+	if p.AllowCredentials {
+		h.Set("Access-Control-Allow-Credentials", "true")
+	}
+	if len(p.ExposeHeaders) > 0 {
+		h.Set("Access-Control-Expose-Headers", strings.Join(p.ExposeHeaders, ", "))
+	}
+	if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+		if len(p.AllowMethods) > 0 {
+			h.Set("Access-Control-Allow-Methods", strings.Join(p.AllowMethods, ", "))
+		}
+		if len(p.AllowHeaders) > 0 {
+			h.Set("Access-Control-Allow-Headers", strings.Join(p.AllowHeaders, ", "))
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package httperror
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+// TestWithCORS tests emitting CORS headers on error responses.
+func TestWithCORS(t *testing.T) {
+	policy := &CORSPolicy{
+		AllowOrigins:     []string{"https://app.example.com"},
+		AllowCredentials: true,
+		ExposeHeaders:    []string{"Retry-After", "X-Request-ID"},
+		AllowMethods:     []string{"GET", "POST"},
+		AllowHeaders:     []string{"Content-Type"},
+	}
+
+	testCases := []struct {
+		name     string
+		policy   *CORSPolicy
+		method   string
+		header   http.Header
+		preset   http.Header
+		expected http.Header
+	}{
+		{"allowed origin", policy, "GET", http.Header{"Origin": {"https://app.example.com"}}, nil, http.Header{
+			"Access-Control-Allow-Origin":      {"https://app.example.com"},
+			"Access-Control-Allow-Credentials": {"true"},
+			"Access-Control-Expose-Headers":    {"Retry-After, X-Request-ID"},
+			"Vary":                             {"Origin"},
+		}},
+		{"preflight", policy, "OPTIONS", http.Header{"Origin": {"https://app.example.com"}, "Access-Control-Request-Method": {"POST"}}, nil, http.Header{
+			"Access-Control-Allow-Origin":      {"https://app.example.com"},
+			"Access-Control-Allow-Credentials": {"true"},
+			"Access-Control-Expose-Headers":    {"Retry-After, X-Request-ID"},
+			"Access-Control-Allow-Methods":     {"GET, POST"},
+			"Access-Control-Allow-Headers":     {"Content-Type"},
+			"Vary":                             {"Origin"},
+		}},
+		{"any origin", &CORSPolicy{AllowOrigins: []string{"*"}}, "GET", http.Header{"Origin": {"https://other.example.com"}}, nil, http.Header{
+			"Access-Control-Allow-Origin": {"*"},
+		}},
+		{"any origin with credentials", &CORSPolicy{AllowOrigins: []string{"*"}, AllowCredentials: true}, "GET", http.Header{"Origin": {"https://other.example.com"}}, nil, http.Header{
+			"Access-Control-Allow-Origin":      {"https://other.example.com"},
+			"Access-Control-Allow-Credentials": {"true"},
+			"Vary":                             {"Origin"},
+		}},
+		{"existing vary", policy, "GET", http.Header{"Origin": {"https://app.example.com"}}, http.Header{"Vary": {"Accept-Encoding, origin"}}, http.Header{
+			"Access-Control-Allow-Origin":      {"https://app.example.com"},
+			"Access-Control-Allow-Credentials": {"true"},
+			"Access-Control-Expose-Headers":    {"Retry-After, X-Request-ID"},
+			"Vary":                             {"Accept-Encoding, origin"},
+		}},
+		{"disallowed origin", policy, "GET", http.Header{"Origin": {"https://evil.example.com"}}, nil, http.Header{}},
+		{"same origin", policy, "GET", nil, nil, http.Header{}},
+		{"set by middleware", policy, "GET", http.Header{"Origin": {"https://app.example.com"}}, http.Header{"Access-Control-Allow-Origin": {"*"}}, http.Header{
+			"Access-Control-Allow-Origin": {"*"},
+		}},
+		{"disabled", nil, "GET", http.Header{"Origin": {"https://app.example.com"}}, nil, http.Header{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(tc.method, "/", nil)
+			for key, values := range tc.header {
+				req.Header[key] = values
+			}
+			rr := httptest.NewRecorder()
+			for key, values := range tc.preset {
+				rr.Header()[key] = values
+			}
+			NewResponder(WithCORS(tc.policy), WithCacheControl(""), WithEncoders(JSONEncoder{})).HandleError(rr, req, TooManyRequestsError())
+
+			got := rr.Header().Clone()
+			got.Del("Content-Type")
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected headers %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package httperror
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+)
+
+// canceledStatus is the status used for context.Canceled errors.
+var canceledStatus atomic.Int64
+
+func init() {
+	canceledStatus.Store(http.StatusRequestTimeout)
+}
+
+// SetCanceledStatus sets the status used when a context.Canceled error reaches
+// Respond or DefaultErrorHandler, typically 499 (client closed request) or
+// 408 Request Timeout. The default is 408. A status of 0 restores the default.
+// SetCanceledStatus는 context.Canceled 오류에 사용할 상태 코드를 설정합니다.
+// 일반적으로 499(클라이언트 요청 종료) 또는 408을 사용하며, 기본값은 408입니다. 0을 전달하면 기본값으로 복원됩니다.
+func SetCanceledStatus(status int) {
+	if status == 0 {
+		status = http.StatusRequestTimeout
+	}
+	canceledStatus.Store(int64(status))
+}
+
+// mapContextError translates context errors: context.DeadlineExceeded becomes
+// 504 Gateway Timeout and context.Canceled the configured canceled status.
+func mapContextError(err error) (*HttpError, bool) {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return GatewayTimeoutError(), true
+	case errors.Is(err, context.Canceled):
+		status := int(canceledStatus.Load())
+		return New(status, StatusText(status)), true
+	}
+	return nil, false
+}
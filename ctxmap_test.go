@@ -0,0 +1,49 @@
+package httperror
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestContextErrors tests the mapping of context cancellation and deadline errors.
+func TestContextErrors(t *testing.T) {
+	SetErrorHandler(nil)
+	defer SetCanceledStatus(0)
+
+	testCases := []struct {
+		name           string
+		canceledStatus int
+		err            error
+		expectedStatus int
+	}{
+		{"deadline exceeded", 0, context.DeadlineExceeded, http.StatusGatewayTimeout},
+		{"wrapped deadline exceeded", 0, fmt.Errorf("query: %w", context.DeadlineExceeded), http.StatusGatewayTimeout},
+		{"canceled default", 0, context.Canceled, http.StatusRequestTimeout},
+		{"canceled configured", 499, fmt.Errorf("query: %w", context.Canceled), 499},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			SetCanceledStatus(tc.canceledStatus)
+
+			rr := httptest.NewRecorder()
+			DefaultErrorHandler(rr, httptest.NewRequest("GET", "/", nil), tc.err)
+			if rr.Code != tc.expectedStatus {
+				t.Errorf("expected status %d, got %d", tc.expectedStatus, rr.Code)
+			}
+		})
+	}
+
+	t.Run("mappers take precedence", func(t *testing.T) {
+		defer ResetMappers()
+		RegisterMapper(func(err error) (*HttpError, bool) {
+			return ServiceUnavailableError(), err == context.DeadlineExceeded
+		})
+		if got := toHttpError(context.DeadlineExceeded).Status; got != http.StatusServiceUnavailable {
+			t.Errorf("expected status %d, got %d", http.StatusServiceUnavailable, got)
+		}
+	})
+}
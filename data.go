@@ -0,0 +1,49 @@
+package httperror
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// WithData attaches a typed payload to the error, rendered under "data" in
+// JSON, e.g. WithData(Quota{Limit: 100, Used: 100}). Read it back with DataOf,
+// on the server or from an error decoded by ParseResponse.
+// WithData는 JSON에서 "data" 아래에 렌더링되는 타입이 있는 페이로드를 오류에 첨부합니다
+// (예: WithData(Quota{Limit: 100, Used: 100})). 서버에서나 ParseResponse로 디코딩된 오류에서 DataOf로 읽습니다.
+func WithData[T any](data T) Option {
+	return optionFunc(func(e *HttpError) {
+		e.Data = data
+	})
+}
+
+// DataOf returns the payload of the HttpError in err's chain as a T. A
+// payload of another type, such as the raw JSON kept by ParseResponse or the
+// map produced by decoding an HttpError with encoding/json, is converted
+// through JSON. It returns false if there is no payload or it cannot be
+// converted to a T.
+// DataOf는 err 체인에 있는 HttpError의 페이로드를 T로 반환합니다. ParseResponse가 보존한 원시 JSON이나
+// encoding/json으로 HttpError를 디코딩하여 생긴 맵처럼 다른 타입의 페이로드는 JSON을 통해 변환됩니다.
+// 페이로드가 없거나 T로 변환할 수 없으면 false를 반환합니다.
+func DataOf[T any](err error) (T, bool) {
+	var zero T
+	var e *HttpError
+	if !errors.As(err, &e) || e.Data == nil {
+		return zero, false
+	}
+	if data, ok := e.Data.(T); ok {
+		return data, true
+	}
+
+	raw, ok := e.Data.(json.RawMessage)
+	if !ok {
+		var marshalErr error
+		if raw, marshalErr = json.Marshal(e.Data); marshalErr != nil {
+			return zero, false
+		}
+	}
+	var data T
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return zero, false
+	}
+	return data, true
+}
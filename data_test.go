@@ -0,0 +1,69 @@
+package httperror
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// quota is the payload used by the tests.
+type quota struct {
+	Limit int    `json:"limit"`
+	Used  int    `json:"used"`
+	Reset string `json:"reset"`
+}
+
+// TestData tests attaching and extracting typed payloads.
+func TestData(t *testing.T) {
+	q := quota{Limit: 100, Used: 100, Reset: "2026-01-01T00:00:00Z"}
+
+	testCases := []struct {
+		name     string
+		err      error
+		expected quota
+		ok       bool
+	}{
+		{"set", TooManyRequestsError(WithData(q)), q, true},
+		{"wrapped", fmt.Errorf("charging: %w", TooManyRequestsError(WithData(q))), q, true},
+		{"pointer", TooManyRequestsError(WithData(&q)), q, true},
+		{"decoded map", TooManyRequestsError(WithData(map[string]any{"limit": 100, "used": 100, "reset": q.Reset})), q, true},
+		{"raw json", TooManyRequestsError(WithData(json.RawMessage(`{"limit":100,"used":100,"reset":"2026-01-01T00:00:00Z"}`))), q, true},
+		{"mismatched", TooManyRequestsError(WithData("over quota")), quota{}, false},
+		{"missing", TooManyRequestsError(), quota{}, false},
+		{"plain error", fmt.Errorf("boom"), quota{}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := DataOf[quota](tc.err)
+			if ok != tc.ok || got != tc.expected {
+				t.Errorf("expected %+v (%v), got %+v (%v)", tc.expected, tc.ok, got, ok)
+			}
+		})
+	}
+}
+
+// TestDataRoundTrip tests that a payload survives rendering and ParseResponse.
+func TestDataRoundTrip(t *testing.T) {
+	q := quota{Limit: 10, Used: 12, Reset: "tomorrow"}
+	rr := httptest.NewRecorder()
+	Respond(rr, httptest.NewRequest("POST", "/charges", nil), TooManyRequestsError(WithData(q)))
+
+	if !strings.Contains(rr.Body.String(), `"data":{"limit":10,"used":12,"reset":"tomorrow"}`) {
+		t.Fatalf("expected the payload in the body, got %s", rr.Body)
+	}
+
+	parsed, err := ParseResponse(rr.Result())
+	if err != nil || parsed.Status != http.StatusTooManyRequests {
+		t.Fatalf("unexpected parse result %+v, %v", parsed, err)
+	}
+	if got, ok := DataOf[quota](parsed); !ok || got != q {
+		t.Errorf("expected %+v, got %+v (%v)", q, got, ok)
+	}
+	if got, ok := DataOf[*quota](parsed); !ok || *got != q {
+		t.Errorf("expected a pointer to %+v, got %v (%v)", q, got, ok)
+	}
+}
@@ -0,0 +1,79 @@
+package httperror
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Deprecation describes the retirement of an API version or endpoint.
+// Deprecation은 API 버전 또는 엔드포인트의 폐기 일정을 나타냅니다.
+type Deprecation struct {
+	// Since is when the API was deprecated, sent in the Deprecation header (RFC 9745).
+	Since time.Time
+	// Sunset is when the API stops responding, sent in the Sunset header (RFC 8594).
+	Sunset time.Time
+	// Successor is the URL of the API replacing it, linked with rel="successor-version".
+	Successor string
+}
+
+// setHeaders sets the Deprecation, Sunset and Link headers of d on h.
+func (d Deprecation) setHeaders(h http.Header) {
+	if !d.Since.IsZero() {
+		h.Set("Deprecation", "@"+strconv.FormatInt(d.Since.Unix(), 10))
+	}
+	if !d.Sunset.IsZero() {
+		h.Set("Sunset", d.Sunset.UTC().Format(http.TimeFormat))
+	}
+	if d.Successor != "" {
+		h.Add("Link", "<"+d.Successor+`>; rel="successor-version"`)
+	}
+}
+
+// WithDeprecation sets the Deprecation, Sunset and successor Link headers of d
+// and adds the dates to the details ("deprecated_since" and "sunset", in RFC
+// 3339 format) and the successor to the links.
+// WithDeprecation은 d의 Deprecation, Sunset, 후속 버전 Link 헤더를 설정하고, 날짜를 상세 정보
+// ("deprecated_since", "sunset", RFC 3339 형식)에, 후속 버전을 링크에 추가합니다.
+func WithDeprecation(d Deprecation) Option {
+	return optionFunc(func(e *HttpError) {
+		if e.Header == nil {
+			e.Header = make(http.Header)
+		}
+		d.setHeaders(e.Header)
+
+		if e.Details == nil {
+			e.Details = make(map[string]any)
+		}
+		if !d.Since.IsZero() {
+			e.Details["deprecated_since"] = d.Since.UTC().Format(time.RFC3339)
+		}
+		if !d.Sunset.IsZero() {
+			e.Details["sunset"] = d.Sunset.UTC().Format(time.RFC3339)
+		}
+		if d.Successor != "" {
+			if e.Links == nil {
+				e.Links = make(map[string]string)
+			}
+			e.Links["successor-version"] = d.Successor
+		}
+	})
+}
+
+// Deprecated returns a middleware retiring the wrapped handler according to
+// d: until the sunset, responses carry the deprecation headers; from then on,
+// requests are responded with a 410 Gone error built with WithDeprecation.
+// Deprecated는 d에 따라 감싼 핸들러를 폐기하는 미들웨어를 반환합니다. 종료 시점 전까지는 응답에 폐기 헤더를
+// 포함하고, 이후에는 WithDeprecation으로 생성한 410 Gone 오류로 응답합니다.
+func Deprecated(d Deprecation) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !d.Sunset.IsZero() && !time.Now().Before(d.Sunset) {
+				Gone(w, r, WithDeprecation(d))
+				return
+			}
+			d.setHeaders(w.Header())
+			next.ServeHTTP(w, r)
+		})
+	}
+}
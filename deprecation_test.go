@@ -0,0 +1,55 @@
+package httperror
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// TestDeprecated tests the deprecation headers before and after the sunset.
+func TestDeprecated(t *testing.T) {
+	since := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	})
+
+	testCases := []struct {
+		name   string
+		sunset time.Time
+		status int
+	}{
+		{"before sunset", time.Now().Add(time.Hour), http.StatusOK},
+		{"after sunset", time.Now().Add(-time.Hour), http.StatusGone},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := Deprecation{Since: since, Sunset: tc.sunset, Successor: "https://api.example.com/v2/users"}
+			rr := httptest.NewRecorder()
+			Deprecated(d)(ok).ServeHTTP(rr, httptest.NewRequest("GET", "/v1/users", nil))
+
+			if rr.Code != tc.status {
+				t.Errorf("expected status %d, got %d", tc.status, rr.Code)
+			}
+			h := rr.Header()
+			if h.Get("Deprecation") != "@1767225600" || h.Get("Sunset") != tc.sunset.UTC().Format(http.TimeFormat) ||
+				h.Get("Link") != `<https://api.example.com/v2/users>; rel="successor-version"` {
+				t.Errorf("unexpected headers %v", h)
+			}
+			if tc.status != http.StatusGone {
+				return
+			}
+
+			var body HttpError
+			if err := json.NewDecoder(rr.Body).Decode(&body); err != nil {
+				t.Fatalf("could not decode response body: %v", err)
+			}
+			if body.Details["sunset"] != tc.sunset.UTC().Format(time.RFC3339) || body.Details["deprecated_since"] != "2026-01-01T00:00:00Z" ||
+				body.Links["successor-version"] != d.Successor {
+				t.Errorf("unexpected body %+v", body)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package httperror
+
+import (
+	"net/http"
+	"sync"
+	"sync/atomic"
+)
+
+// errorDocs holds the documentation URLs registered per status and error code.
+type errorDocs struct {
+	byStatus map[int]string
+	byCode   map[string]string
+}
+
+// docs holds an immutable snapshot of the registered documentation URLs,
+// replaced as a whole under docsMu.
+var (
+	docsMu sync.Mutex
+	docs   atomic.Pointer[errorDocs]
+)
+
+// updateDocs replaces the registered documentation URLs with a modified copy.
+func updateDocs(fn func(d *errorDocs)) {
+	docsMu.Lock()
+	defer docsMu.Unlock()
+	next := errorDocs{byStatus: make(map[int]string), byCode: make(map[string]string)}
+	if d := docs.Load(); d != nil {
+		for k, v := range d.byStatus {
+			next.byStatus[k] = v
+		}
+		for k, v := range d.byCode {
+			next.byCode[k] = v
+		}
+	}
+	fn(&next)
+	docs.Store(&next)
+}
+
+// RegisterStatusDoc registers the documentation URL of the errors with the
+// given status, e.g. a runbook. Rendered errors then carry a
+// Link: <url>; rel="help" header and the URL in the "type" member of the body.
+// RegisterStatusDoc는 주어진 상태 코드를 가진 오류의 문서 URL(예: 런북)을 등록합니다.
+// 렌더링된 오류에는 Link: <url>; rel="help" 헤더와 본문의 "type" 멤버에 URL이 포함됩니다.
+func RegisterStatusDoc(status int, url string) {
+	updateDocs(func(d *errorDocs) { d.byStatus[status] = url })
+}
+
+// RegisterCodeDoc registers the documentation URL of the errors with the
+// given code, which takes precedence over the URL of their status.
+// RegisterCodeDoc는 주어진 코드를 가진 오류의 문서 URL을 등록하며, 상태 코드의 URL보다 우선합니다.
+func RegisterCodeDoc(code, url string) {
+	updateDocs(func(d *errorDocs) { d.byCode[code] = url })
+}
+
+// ResetDocs removes all registered documentation URLs.
+// ResetDocs는 등록된 모든 문서 URL을 제거합니다.
+func ResetDocs() {
+	docsMu.Lock()
+	defer docsMu.Unlock()
+	docs.Store(nil)
+}
+
+// docURL returns the documentation URL registered for e, if any.
+func docURL(e *HttpError) (string, bool) {
+	d := docs.Load()
+	if d == nil {
+		return "", false
+	}
+	if url, ok := d.byCode[e.Code]; ok && e.Code != "" {
+		return url, true
+	}
+	url, ok := d.byStatus[e.Status]
+	return url, ok
+}
+
+// withDocumentation returns a copy of e pointing at its registered
+// documentation, unless it already has a type.
+func withDocumentation(e *HttpError) *HttpError {
+	if e.Type != "" {
+		return e
+	}
+	url, ok := docURL(e)
+	if !ok {
+		return e
+	}
+	documented := *e
+	documented.Type = url
+	documented.Header = e.Header.Clone()
+	if documented.Header == nil {
+		documented.Header = make(http.Header)
+	}
+	documented.Header.Add("Link", "<"+url+`>; rel="help"`)
+	return &documented
+}
@@ -0,0 +1,51 @@
+package httperror
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestErrorDocs tests pointing errors at their registered documentation.
+func TestErrorDocs(t *testing.T) {
+	RegisterStatusDoc(http.StatusNotFound, "https://docs.example.com/errors/404")
+	RegisterCodeDoc("user_not_found", "https://docs.example.com/errors/user-not-found")
+	defer ResetDocs()
+
+	testCases := []struct {
+		name string
+		err  *HttpError
+		url  string
+	}{
+		{"status", NotFoundError(), "https://docs.example.com/errors/404"},
+		{"code wins", NotFoundError("user 7 not found", WithCode("user_not_found")), "https://docs.example.com/errors/user-not-found"},
+		{"unregistered code", ForbiddenError(WithCode("user_not_found")), "https://docs.example.com/errors/user-not-found"},
+		{"undocumented", ConflictError(), ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			Respond(rr, httptest.NewRequest("GET", "/", nil), tc.err)
+
+			var body HttpError
+			if err := json.NewDecoder(rr.Body).Decode(&body); err != nil {
+				t.Fatalf("could not decode response body: %v", err)
+			}
+			if body.Type != tc.url {
+				t.Errorf("expected type %q, got %q", tc.url, body.Type)
+			}
+			expectedLink := ""
+			if tc.url != "" {
+				expectedLink = "<" + tc.url + `>; rel="help"`
+			}
+			if got := rr.Header().Get("Link"); got != expectedLink {
+				t.Errorf("expected Link %q, got %q", expectedLink, got)
+			}
+			if tc.err.Type != "" || tc.err.Header != nil {
+				t.Errorf("expected the error not to be modified, got %+v", tc.err)
+			}
+		})
+	}
+}
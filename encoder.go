@@ -0,0 +1,175 @@
+package httperror
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// Encoder serializes an HttpError into a response body.
+// Encoder는 HttpError를 응답 본문으로 직렬화합니다.
+type Encoder interface {
+	// ContentType returns the Content-Type of the encoded body.
+	ContentType() string
+	// Encode writes the encoded error to w.
+	Encode(w io.Writer, e *HttpError) error
+}
+
+// statusEncoder is implemented by encoders whose responses are written with a
+// different status than the error's, such as GraphQLEncoder.
+type statusEncoder interface {
+	ResponseStatus(e *HttpError) int
+}
+
+// responseStatus returns the status the response for e is written with.
+func responseStatus(enc Encoder, e *HttpError) int {
+	if se, ok := enc.(statusEncoder); ok {
+		return se.ResponseStatus(e)
+	}
+	return e.Status
+}
+
+// JSONEncoder encodes errors as JSON objects. It is the default encoder.
+// JSONEncoder는 오류를 JSON 객체로 인코딩합니다. 기본 인코더입니다.
+type JSONEncoder struct{}
+
+// ContentType implements Encoder.
+func (JSONEncoder) ContentType() string {
+	return "application/json; charset=utf-8"
+}
+
+// Encode implements Encoder.
+func (JSONEncoder) Encode(w io.Writer, e *HttpError) error {
+	var scratch []byte
+	if buf, ok := w.(*bytes.Buffer); ok {
+		scratch = buf.AvailableBuffer()
+	}
+	b, err := e.AppendJSON(scratch)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(b, '\n'))
+	return err
+}
+
+// HTMLEncoder encodes errors as an HTML fragment, used for browsers.
+// HTMLEncoder는 오류를 브라우저용 HTML 조각으로 인코딩합니다.
+type HTMLEncoder struct{}
+
+// ContentType implements Encoder.
+func (HTMLEncoder) ContentType() string {
+	return "text/html; charset=utf-8"
+}
+
+// Encode implements Encoder.
+// The language of translated messages is set as the lang attribute, links
+// are rendered as anchors following the message, and details as a
+// description list whose values other than strings are formatted as JSON.
+func (HTMLEncoder) Encode(w io.Writer, e *HttpError) error {
+	var b strings.Builder
+	b.WriteString(`<div class="http-error"`)
+	if lang := e.Language(); lang != "" {
+		fmt.Fprintf(&b, ` lang="%s"`, html.EscapeString(lang))
+	}
+	b.WriteString(`>`)
+	b.WriteString(html.EscapeString(e.Message))
+	for _, rel := range sortedKeys(e.Links) {
+		escaped := html.EscapeString(rel)
+		fmt.Fprintf(&b, ` <a rel="%s" href="%s">%s</a>`, escaped, html.EscapeString(e.Links[rel]), escaped)
+	}
+	if len(e.Details) > 0 {
+		b.WriteString(`<dl class="http-error-details">`)
+		for _, key := range sortedDetailKeys(e.Details) {
+			value, ok := e.Details[key].(string)
+			if !ok {
+				data, err := appendJSONValue(nil, e.Details[key], nil)
+				if err != nil {
+					return err
+				}
+				value = string(data)
+			}
+			fmt.Fprintf(&b, `<dt>%s</dt><dd>%s</dd>`, html.EscapeString(key), html.EscapeString(value))
+		}
+		b.WriteString(`</dl>`)
+	}
+	b.WriteString(`</div>`)
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// SetEncoder makes the Responder render every error with enc, bypassing content
+// negotiation. If nil is provided, negotiation between JSON and HTML is restored.
+// SetEncoder는 콘텐츠 협상을 건너뛰고 모든 오류를 enc로 렌더링하도록 설정합니다.
+// nil이 제공되면 JSON과 HTML 간의 협상이 복원됩니다.
+func (rs *Responder) SetEncoder(enc Encoder) {
+	rs.update(func(cfg *responderConfig) { cfg.encoder = enc })
+}
+
+// encoderFor returns the encoder used to render an error for r. Encoders
+// fixed by path prefix take precedence over SetEncoder and negotiation.
+func (cfg *responderConfig) encoderFor(r *http.Request) Encoder {
+	if enc, ok := cfg.pathEncoder(r); ok {
+		return cfg.customize(enc)
+	}
+	if cfg.encoder != nil {
+		return cfg.customize(cfg.encoder)
+	}
+	if len(cfg.encoders) > 0 {
+		return cfg.customize(negotiateEncoder(r, cfg.encoders))
+	}
+
+	// Simple Content Negotiation (skipped for background errors without a request):
+	if r != nil {
+		accept := r.Header.Get("Accept")
+		if strings.Contains(accept, "text/html") || strings.Contains(accept, "application/xhtml+xml") {
+			return cfg.customize(HTMLEncoder{})
+		}
+	}
+	return cfg.customize(JSONEncoder{})
+}
+
+// setVary lists in the Vary header of h the request headers the response to
+// r depends on: Accept when its format is negotiated, and Accept-Language when
+// messages can be translated, so shared caches don't serve JSON to browsers.
+func (cfg *responderConfig) setVary(h http.Header, r *http.Request) {
+	if r == nil {
+		return
+	}
+	if _, fixed := cfg.pathEncoder(r); !fixed && cfg.encoder == nil {
+		addVary(h, "Accept")
+	}
+	if len(translator().Languages()) > 0 {
+		addVary(h, "Accept-Language")
+	}
+}
+
+// addVary adds field to the Vary header of h, unless it is already listed.
+func addVary(h http.Header, field string) {
+	for _, v := range h.Values("Vary") {
+		for _, f := range strings.Split(v, ",") {
+			if f = strings.TrimSpace(f); f == "*" || strings.EqualFold(f, field) {
+				return
+			}
+		}
+	}
+	h.Add("Vary", field)
+}
+
+// customize replaces the built-in encoders according to WithHTMLTemplate,
+// WithEnvelope, WithFieldNames and WithKeyCase.
+func (cfg *responderConfig) customize(enc Encoder) Encoder {
+	switch enc.(type) {
+	case HTMLEncoder:
+		if cfg.htmlTemplate != nil {
+			return templateEncoder{cfg.htmlTemplate}
+		}
+	case JSONEncoder:
+		if st := cfg.jsonStyle(); st != nil {
+			return styledJSONEncoder{st}
+		}
+	}
+	return enc
+}
@@ -0,0 +1,45 @@
+package httperror
+
+import (
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+// TestVary tests listing the negotiated request headers in the Vary header.
+func TestVary(t *testing.T) {
+	testCases := []struct {
+		name     string
+		opts     []ResponderOption
+		target   string
+		catalog  bool
+		preset   []string
+		expected []string
+	}{
+		{"negotiated", nil, "/", false, nil, []string{"Accept"}},
+		{"encoders", []ResponderOption{WithEncoders(JSONEncoder{}, HTMLEncoder{})}, "/", false, nil, []string{"Accept"}},
+		{"fixed encoder", []ResponderOption{WithEncoders(JSONEncoder{})}, "/", false, nil, nil},
+		{"fixed by path", []ResponderOption{WithPathEncoders(map[string]Encoder{"/api/": JSONEncoder{}})}, "/api/users", false, nil, nil},
+		{"translated", nil, "/", true, nil, []string{"Accept", "Accept-Language"}},
+		{"already listed", nil, "/", false, []string{"Origin, accept"}, []string{"Origin, accept"}},
+		{"wildcard", nil, "/", true, []string{"*"}, []string{"*"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.catalog {
+				RegisterCatalog("ko", Catalog{"Not Found": "찾을 수 없음"})
+				defer ResetCatalogs()
+			}
+			rr := httptest.NewRecorder()
+			for _, v := range tc.preset {
+				rr.Header().Add("Vary", v)
+			}
+			NewResponder(tc.opts...).HandleError(rr, httptest.NewRequest("GET", tc.target, nil), NotFoundError())
+
+			if got := rr.Header().Values("Vary"); !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected Vary %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+package httperror
+
+import (
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// HttpError represents an error with an associated HTTP status code.
+// Responding never modifies an HttpError, so errors can be predeclared as
+// package-level variables and responded concurrently; use With or Clone to
+// derive a request-specific error from a shared one.
+// HttpError는 HTTP 상태 코드와 관련된 오류를 나타냅니다. 응답 과정에서 HttpError는 변경되지 않으므로
+// 패키지 수준 변수로 미리 선언하여 동시에 응답할 수 있으며, 공유 오류에서 요청별 오류를 만들려면 With나 Clone을 사용합니다.
+type HttpError struct {
+	Status int    `json:"status"`
+	Code   string `json:"code,omitempty"`
+	// Type is a URI documenting the error, see RegisterStatusDoc and RegisterCodeDoc.
+	Type    string         `json:"type,omitempty"`
+	Message string         `json:"message"`
+	Details map[string]any `json:"details,omitempty"`
+	// Links maps link relations, e.g. "self", "documentation", "retry" or
+	// "support", to URLs, so hypermedia clients can navigate from the error.
+	Links map[string]string `json:"links,omitempty"`
+	// Data is a typed payload, e.g. a quota struct, set with WithData and
+	// read with DataOf.
+	Data any `json:"data,omitempty"`
+	// Severity tells how serious the error is, see SeverityOf. It is only
+	// rendered when the Responder exposes it, see WithSeverityDetail.
+	Severity Severity `json:"-"`
+	// Header holds extra response headers written along with the error.
+	Header http.Header `json:"-"`
+	// Params holds the values of the named placeholders of a message template
+	// such as "user {id} not found", substituted when the error is rendered.
+	Params map[string]any `json:"-"`
+
+	// cause is set by Wrap and Remap, originalStatus by Remap; they are never
+	// serialized.
+	cause          error
+	originalStatus int
+	// args are the message arguments set by WithMessageArgs.
+	args []any
+	// language is the language the message was translated into when the
+	// error was rendered.
+	language string
+}
+
+// Error returns the error message.
+// Error는 오류 메시지를 반환합니다.
+func (e *HttpError) Error() string {
+	return e.Message
+}
+
+// Set sets the detail key to value and returns e, so calls can be chained:
+// NotFoundError().Set("id", 42).Set("hint", "check the ID"). It modifies e,
+// so it must not be called on shared errors such as the Err* sentinels; use
+// With(WithDetail(key, value)) on those.
+// Set은 상세 정보 key를 value로 설정하고 e를 반환하여 호출을 연결할 수 있게 합니다.
+// e를 변경하므로 Err* 센티널 같은 공유 오류에는 호출하지 말고 With(WithDetail(key, value))를 사용하세요.
+func (e *HttpError) Set(key string, value any) *HttpError {
+	if e.Details == nil {
+		e.Details = make(map[string]any)
+	}
+	e.Details[key] = value
+	return e
+}
+
+// Clone returns a copy of e whose headers, details, links, params and message
+// arguments can be modified without affecting e. Detail values and Data are
+// shared.
+// Clone은 헤더, 상세 정보, 링크, 매개변수, 메시지 인자를 e에 영향을 주지 않고 변경할 수 있는 e의 복사본을 반환합니다.
+// 상세 정보의 값과 Data는 공유됩니다.
+func (e *HttpError) Clone() *HttpError {
+	c := *e
+	c.Header = e.Header.Clone()
+	c.Details = maps.Clone(e.Details)
+	c.Links = maps.Clone(e.Links)
+	c.Params = maps.Clone(e.Params)
+	c.args = slices.Clone(e.args)
+	return &c
+}
+
+// With returns a clone of e with opts applied, leaving e untouched, e.g.
+// ErrUserNotFound.With(WithDetail("id", id)).
+// With는 opts를 적용한 e의 복제본을 반환하며 e는 변경하지 않습니다(예: ErrUserNotFound.With(WithDetail("id", id))).
+func (e *HttpError) With(opts ...Option) *HttpError {
+	c := e.Clone()
+	applyOptions(c, opts)
+	return c
+}
+
+// Get returns the detail key and whether it is set.
+// Get은 상세 정보 key의 값과 설정 여부를 반환합니다.
+func (e *HttpError) Get(key string) (any, bool) {
+	v, ok := e.Details[key]
+	return v, ok
+}
+
+// Is reports whether target is an HttpError with the same status code.
+// This lets errors.Is match an error against the Err* sentinels.
+// Is는 target이 동일한 상태 코드를 가진 HttpError인지 보고합니다.
+// 이를 통해 errors.Is로 오류를 Err* 센티널과 비교할 수 있습니다.
+func (e *HttpError) Is(target error) bool {
+	t, ok := target.(*HttpError)
+	return ok && t != nil && t.Status == e.Status
+}
+
+// Unwrap returns the underlying cause of the error, if any.
+// Unwrap은 오류의 원인이 되는 오류가 있으면 반환합니다.
+func (e *HttpError) Unwrap() error {
+	return e.cause
+}
+
+// OriginalStatus returns the status the error had before it was remapped with Remap.
+// For errors that were never remapped it returns Status.
+// OriginalStatus는 Remap으로 재매핑되기 전의 상태 코드를 반환합니다. 재매핑되지 않은 오류는 Status를 반환합니다.
+func (e *HttpError) OriginalStatus() int {
+	if e.originalStatus != 0 {
+		return e.originalStatus
+	}
+	return e.Status
+}
+
+// Language returns the language tag the message was translated into for the
+// response, e.g. "ko", or "" if it was not translated. Encoders use it to
+// format the body for the locale; it is also sent as Content-Language.
+// Language는 응답을 위해 메시지가 번역된 언어 태그(예: "ko")를 반환하며, 번역되지 않았으면 빈 문자열을 반환합니다.
+// 인코더는 이를 사용하여 로케일에 맞게 본문을 서식화하며, Content-Language로도 전송됩니다.
+func (e *HttpError) Language() string {
+	return e.language
+}
+
+// Remap returns an HttpError that responds with status instead of the status err
+// resolves to, e.g. 404 instead of 403 to hide the existence of a resource.
+// The outward message becomes the default text of status, so nothing of the
+// original leaks to the client, while the original status and the cause remain
+// available internally (to hooks and reporters) through OriginalStatus and Unwrap.
+// Remap은 err의 상태 코드 대신 status로 응답하는 HttpError를 반환합니다(예: 리소스 존재를 숨기기 위해 403 대신 404).
+// 외부 메시지는 status의 기본 텍스트가 되어 원래 내용이 클라이언트에 노출되지 않으며,
+// 원래 상태 코드와 원인은 OriginalStatus와 Unwrap을 통해 내부(훅, 보고기)에서 확인할 수 있습니다.
+func Remap(err error, status int) *HttpError {
+	original := toHttpError(err)
+	return &HttpError{
+		Status:         status,
+		Message:        StatusText(status),
+		cause:          err,
+		originalStatus: original.OriginalStatus(),
+	}
+}
+
+// Wrap returns an HttpError with status whose cause is err, so the HTTP
+// semantics are assigned without losing the original error: errors.Is and
+// errors.As still reach it through Unwrap, e.g. for hooks and reporters. The
+// message is msg, joined with spaces, or the status text; the message of err
+// is never exposed to the client. A nil err gives an error without a cause.
+// Wrap은 err를 원인으로 하는 status 상태 코드의 HttpError를 반환하여, 원래 오류를 잃지 않고 HTTP 의미를 부여합니다.
+// errors.Is와 errors.As는 Unwrap을 통해 원래 오류에 계속 접근할 수 있습니다(예: 훅과 보고기).
+// 메시지는 공백으로 연결한 msg이거나 상태 코드의 텍스트이며, err의 메시지는 클라이언트에 노출되지 않습니다.
+// err가 nil이면 원인이 없는 오류를 반환합니다.
+func Wrap(err error, status int, msg ...string) *HttpError {
+	e := New(status, strings.Join(msg, " "))
+	e.cause = err
+	return e
+}
+
+// New creates a new HttpError. If message is empty, the status text is used.
+// New는 새로운 HttpError를 생성합니다. message가 비어 있으면 상태 코드의 텍스트를 사용합니다.
+func New(status int, message string) *HttpError {
+	if message == "" {
+		message = StatusText(status)
+	}
+	return &HttpError{
+		Status:  status,
+		Message: message,
+	}
+}
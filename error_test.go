@@ -0,0 +1,227 @@
+package httperror
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// TestRemap tests that Remap masks the outward status but keeps the original internally.
+func TestRemap(t *testing.T) {
+	SetErrorHandler(nil)
+	defer ResetHooks()
+
+	original := ForbiddenError("user 42 may not see project 7")
+	remapped := Remap(original, http.StatusNotFound)
+
+	if remapped.Status != http.StatusNotFound || remapped.Message != http.StatusText(http.StatusNotFound) {
+		t.Errorf("unexpected outward error: %d %q", remapped.Status, remapped.Message)
+	}
+	if remapped.OriginalStatus() != http.StatusForbidden {
+		t.Errorf("expected original status %d, got %d", http.StatusForbidden, remapped.OriginalStatus())
+	}
+	if !errors.Is(remapped, ErrNotFound) || !errors.Is(remapped, ErrForbidden) {
+		t.Error("expected remapped error to match both the outward and the original status")
+	}
+	if errors.Unwrap(remapped) != original {
+		t.Error("expected Unwrap to return the original error")
+	}
+
+	var seen *HttpError
+	AddHook(func(ev ErrorEvent) { seen = ev.HttpError })
+
+	rr := httptest.NewRecorder()
+	Respond(rr, httptest.NewRequest("GET", "/", nil), remapped)
+
+	if rr.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, rr.Code)
+	}
+	if strings.Contains(rr.Body.String(), "project 7") {
+		t.Errorf("original message leaked into the response: %s", rr.Body.String())
+	}
+	if seen == nil || seen.OriginalStatus() != http.StatusForbidden {
+		t.Error("expected hooks to see the original status")
+	}
+
+	t.Run("remapping twice keeps the first status", func(t *testing.T) {
+		twice := Remap(Remap(original, http.StatusNotFound), http.StatusGone)
+		if twice.Status != http.StatusGone || twice.OriginalStatus() != http.StatusForbidden {
+			t.Errorf("unexpected statuses: %d (original %d)", twice.Status, twice.OriginalStatus())
+		}
+	})
+
+	t.Run("non HttpError", func(t *testing.T) {
+		r := Remap(errors.New("db down"), http.StatusServiceUnavailable)
+		if r.OriginalStatus() != http.StatusInternalServerError {
+			t.Errorf("expected original status 500, got %d", r.OriginalStatus())
+		}
+	})
+
+	t.Run("internal fields are not serialized", func(t *testing.T) {
+		b, _ := json.Marshal(remapped)
+		if string(b) != `{"status":404,"message":"Not Found"}` {
+			t.Errorf("unexpected JSON: %s", b)
+		}
+	})
+
+	t.Run("original status of a plain error", func(t *testing.T) {
+		if got := NotFoundError().OriginalStatus(); got != http.StatusNotFound {
+			t.Errorf("expected %d, got %d", http.StatusNotFound, got)
+		}
+	})
+}
+
+// TestWrap tests assigning a status to an error while keeping it as the cause.
+func TestWrap(t *testing.T) {
+	cause := fmt.Errorf("querying users: %w", sql.ErrNoRows)
+
+	testCases := []struct {
+		name            string
+		err             error
+		status          int
+		msg             []string
+		expectedMessage string
+	}{
+		{"status text", cause, http.StatusNotFound, nil, "Not Found"},
+		{"message", cause, http.StatusNotFound, []string{"user not found"}, "user not found"},
+		{"message parts", cause, http.StatusNotFound, []string{"user", "not found"}, "user not found"},
+		{"nil cause", nil, http.StatusServiceUnavailable, nil, "Service Unavailable"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := Wrap(tc.err, tc.status, tc.msg...)
+			if e.Status != tc.status || e.Message != tc.expectedMessage {
+				t.Errorf("expected %d %q, got %d %q", tc.status, tc.expectedMessage, e.Status, e.Message)
+			}
+			if errors.Unwrap(e) != tc.err {
+				t.Errorf("expected Unwrap to return %v, got %v", tc.err, errors.Unwrap(e))
+			}
+			if e.OriginalStatus() != tc.status {
+				t.Errorf("expected original status %d, got %d", tc.status, e.OriginalStatus())
+			}
+		})
+	}
+
+	e := Wrap(cause, http.StatusNotFound)
+	if !errors.Is(e, sql.ErrNoRows) || !errors.Is(e, ErrNotFound) {
+		t.Error("expected the wrapped error to match both its cause and its status")
+	}
+	rr := httptest.NewRecorder()
+	Respond(rr, httptest.NewRequest("GET", "/", nil), fmt.Errorf("handler: %w", e))
+	if rr.Code != http.StatusNotFound || strings.Contains(rr.Body.String(), "querying") {
+		t.Errorf("unexpected response %d %s", rr.Code, rr.Body)
+	}
+}
+
+// TestDetails tests setting and getting details, and rendering them with every encoder.
+func TestDetails(t *testing.T) {
+	e := TooManyRequestsError().Set("limit", 100).Set("hint", "retry <later>")
+	if v, ok := e.Get("limit"); !ok || v != 100 {
+		t.Errorf("expected limit 100, got %v (%v)", v, ok)
+	}
+	if _, ok := e.Get("missing"); ok {
+		t.Error("expected a missing detail not to be set")
+	}
+	if _, ok := NotFoundError().Get("limit"); ok {
+		t.Error("expected no details on a new error")
+	}
+
+	testCases := []struct {
+		name     string
+		enc      Encoder
+		expected string
+	}{
+		{"json", JSONEncoder{}, `"details":{"hint":"retry \u003clater\u003e","limit":100}`},
+		{"html", HTMLEncoder{}, `<dl class="http-error-details"><dt>hint</dt><dd>retry &lt;later&gt;</dd><dt>limit</dt><dd>100</dd></dl>`},
+		{"aws", AWSEncoder{}, `"details":{"hint":"retry \u003clater\u003e","limit":100}`},
+		{"graphql", GraphQLEncoder{}, `"details":{"hint":"retry \u003clater\u003e","limit":100}`},
+		{"twirp", TwirpEncoder{}, `"limit":"100"`},
+		{"google", GoogleEncoder{}, `"limit":"100"`},
+		{"odata", ODataEncoder{}, `"limit":100`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := tc.enc.Encode(&buf, e); err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(buf.String(), tc.expected) {
+				t.Errorf("expected %s in %s", tc.expected, buf.String())
+			}
+		})
+	}
+}
+
+// TestClone tests that clones and derived errors do not share mutable state.
+func TestClone(t *testing.T) {
+	shared := NotFoundError("user {id} not found",
+		WithCode("user_not_found"),
+		WithDetail("hint", "check the ID"),
+		WithHeader("Cache-Control", "no-store"),
+		WithLink("self", "/users"),
+		WithParam("id", 7),
+		WithMessageArgs(1),
+	)
+	snapshot := shared.Clone()
+	if !reflect.DeepEqual(snapshot, shared) {
+		t.Fatalf("expected the clone to equal the original, got %+v", snapshot)
+	}
+
+	c := shared.Clone()
+	c.Set("hint", "changed")
+	c.Header.Add("Vary", "Accept")
+	c.Links["next"] = "/users?page=2"
+	c.Params["id"] = 8
+	c.args[0] = 2
+
+	derived := shared.With(WithDetail("id", 7), WithHeader("Retry-After", "1"), "user 7 not found")
+	if derived.Message != "user 7 not found" || derived.Details["id"] != 7 || derived.Header.Get("Retry-After") != "1" || derived.Code != "user_not_found" {
+		t.Errorf("unexpected derived error %+v", derived)
+	}
+	if !reflect.DeepEqual(snapshot, shared) {
+		t.Errorf("expected the shared error to be untouched, got %+v", shared)
+	}
+}
+
+// TestSharedErrorConcurrentRespond tests that responding a package-level error
+// concurrently with every rendering feature enabled never modifies it. Run with -race.
+func TestSharedErrorConcurrentRespond(t *testing.T) {
+	shared := TooManyRequestsError("quota {name} exceeded",
+		WithCode("quota_exceeded"),
+		WithParam("name", "uploads"),
+		WithDetail("owner", "jane@example.com"),
+		WithHeader("Retry-After", "30"),
+	)
+	snapshot := shared.Clone()
+
+	RegisterStatusDoc(http.StatusTooManyRequests, "https://docs.example.com/errors/429")
+	defer ResetDocs()
+	RegisterCatalog("ko", Catalog{"quota {name} exceeded": "{name} 할당량 초과"})
+	defer ResetCatalogs()
+
+	rs := NewResponder(WithDebug(true), WithSeverityDetail(true), WithRedactors(RedactPatterns()), WithEnvelope("error"))
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req := httptest.NewRequest("GET", "/", nil)
+			req.Header.Set("Accept-Language", "ko")
+			rs.HandleError(httptest.NewRecorder(), req, Annotate(shared, "uploading"))
+		}()
+	}
+	wg.Wait()
+
+	if !reflect.DeepEqual(snapshot, shared) {
+		t.Errorf("expected the shared error to be untouched, got %+v", shared)
+	}
+}
@@ -0,0 +1,49 @@
+package httperror
+
+import (
+	"expvar"
+	"strconv"
+	"sync"
+)
+
+// Error counters maintained by Respond. They are always updated, but only
+// become visible under /debug/vars once PublishExpvar has been called.
+var (
+	errorCounters     = new(expvar.Map).Init()
+	errorTotal        = new(expvar.Int)
+	errorByStatus     = new(expvar.Map).Init()
+	publishExpvarOnce sync.Once
+)
+
+func init() {
+	errorCounters.Set("total", errorTotal)
+	errorCounters.Set("status", errorByStatus)
+}
+
+// PublishExpvar publishes the error counters as an expvar variable with the given name
+// (e.g. "httperror"), exposing the total error count and per-status counts under /debug/vars.
+// Only the first call has an effect; subsequent calls are ignored.
+// PublishExpvar는 오류 카운터(전체 및 상태 코드별)를 지정된 이름의 expvar 변수로 공개합니다.
+// 첫 번째 호출만 적용되며 이후 호출은 무시됩니다.
+func PublishExpvar(name string) {
+	publishExpvarOnce.Do(func() {
+		expvar.Publish(name, errorCounters)
+	})
+}
+
+// ErrorCounters returns the expvar map holding the error counters.
+// It contains a "total" counter and a "status" map keyed by status code.
+// ErrorCounters는 오류 카운터를 담고 있는 expvar 맵을 반환합니다.
+func ErrorCounters() *expvar.Map {
+	return errorCounters
+}
+
+// countError increments the total and per-status error counters.
+// Statuses below 400, such as the 204 of a timed out LongPoll, are not errors and are not counted.
+func countError(status int) {
+	if status < 400 {
+		return
+	}
+	errorTotal.Add(1)
+	errorByStatus.Add(strconv.Itoa(status), 1)
+}
@@ -0,0 +1,50 @@
+package httperror
+
+import (
+	"errors"
+	"expvar"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestErrorCounters tests that Respond updates the expvar counters.
+func TestErrorCounters(t *testing.T) {
+	SetErrorHandler(nil)
+
+	total := errorTotal.Value()
+	notFound := counterValue(errorByStatus.Get("404"))
+	internal := counterValue(errorByStatus.Get("500"))
+
+	req := httptest.NewRequest("GET", "/", nil)
+	Respond(httptest.NewRecorder(), req, New(http.StatusNotFound, "Not Found"))
+	Respond(httptest.NewRecorder(), req, New(http.StatusNotFound, "Not Found"))
+	Respond(httptest.NewRecorder(), req, errors.New("boom"))
+
+	if got := errorTotal.Value() - total; got != 3 {
+		t.Errorf("expected total to increase by 3, got %d", got)
+	}
+	if got := counterValue(errorByStatus.Get("404")) - notFound; got != 2 {
+		t.Errorf("expected 404 count to increase by 2, got %d", got)
+	}
+	if got := counterValue(errorByStatus.Get("500")) - internal; got != 1 {
+		t.Errorf("expected 500 count to increase by 1, got %d", got)
+	}
+}
+
+// TestPublishExpvar tests that the counters are published once under the given name.
+func TestPublishExpvar(t *testing.T) {
+	PublishExpvar("httperror_test")
+	PublishExpvar("httperror_test") // must not panic on repeated calls
+
+	if expvar.Get("httperror_test") != ErrorCounters() {
+		t.Error("expected counters to be published under 'httperror_test'")
+	}
+}
+
+func counterValue(v expvar.Var) int64 {
+	if i, ok := v.(*expvar.Int); ok {
+		return i.Value()
+	}
+	return 0
+}
@@ -0,0 +1,165 @@
+//go:build ignore
+
+// gen_helpers generates httperror_helpers.go: the full helper family for every
+// status in the table below. Run it with `go generate`.
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"log"
+	"os"
+	"strings"
+	"text/template"
+)
+
+// status describes a single generated status code.
+type status struct {
+	Name   string // Helper name, e.g. "NotFound"
+	Const  string // net/http constant, e.g. "StatusNotFound", or the literal code of a non-standard status, e.g. "499"
+	Phrase string // English description, e.g. "404 Not Found error"
+	Korean string // Korean description used in the writer's doc comment
+}
+
+// Code returns the Go expression of the status code, e.g. "http.StatusNotFound" or "499".
+func (s status) Code() string {
+	if s.Const != "" && s.Const[0] >= '0' && s.Const[0] <= '9' {
+		return s.Const
+	}
+	return "http." + s.Const
+}
+
+// Label returns the phrase without its trailing " error", e.g. "404 Not Found".
+func (s status) Label() string {
+	return strings.TrimSuffix(s.Phrase, " error")
+}
+
+// KoreanText returns the Korean status text, the part of the description before its colon,
+// e.g. "찾을 수 없음".
+func (s status) KoreanText() string {
+	text, _, _ := strings.Cut(s.Korean, ":")
+	return text
+}
+
+var statuses = []status{
+	{"BadRequest", "StatusBadRequest", "400 Bad Request error", "잘못된 요청: 서버가 요청의 구문을 인식하지 못했습니다."},
+	{"Unauthorized", "StatusUnauthorized", "401 Unauthorized error", "인증 실패: 요청된 리소스에 대한 유효한 인증 자격 증명이 부족합니다."},
+	{"PaymentRequired", "StatusPaymentRequired", "402 Payment Required error", "결제 필요: 요청을 완료하려면 결제가 필요합니다."},
+	{"Forbidden", "StatusForbidden", "403 Forbidden error", "접근 금지: 서버가 요청을 이해했지만 승인을 거부했습니다."},
+	{"NotFound", "StatusNotFound", "404 Not Found error", "찾을 수 없음: 서버가 요청한 리소스를 찾을 수 없습니다."},
+	{"MethodNotAllowed", "StatusMethodNotAllowed", "405 Method Not Allowed error", "허용되지 않은 메소드: 요청한 리소스에 대해 요청한 메소드가 허용되지 않습니다."},
+	{"NotAcceptable", "StatusNotAcceptable", "406 Not Acceptable error", "수용할 수 없음: 서버가 요청의 Accept 헤더에 따라 수용할 수 없는 응답을 생성할 수 없습니다."},
+	{"ProxyAuthRequired", "StatusProxyAuthRequired", "407 Proxy Authentication Required error", "프록시 인증 필요: 프록시를 통해 인증해야 합니다."},
+	{"RequestTimeout", "StatusRequestTimeout", "408 Request Timeout error", "요청 시간 초과: 서버가 요청을 기다리는 동안 시간이 초과되었습니다."},
+	{"Conflict", "StatusConflict", "409 Conflict error", "충돌: 요청이 리소스의 현재 상태와 충돌하여 완료될 수 없습니다."},
+	{"Gone", "StatusGone", "410 Gone error", "사라짐: 요청한 리소스가 영구적으로 삭제되었습니다."},
+	{"LengthRequired", "StatusLengthRequired", "411 Length Required error", "길이 필요: Content-Length 헤더 없이 요청이 거부되었습니다."},
+	{"PreconditionFailed", "StatusPreconditionFailed", "412 Precondition Failed error", "사전 조건 실패: 서버가 요청자가 요청에 지정한 사전 조건 중 하나를 충족하지 못했습니다."},
+	{"PayloadTooLarge", "StatusRequestEntityTooLarge", "413 Payload Too Large error", "페이로드 너무 큼: 요청 페이로드가 서버가 처리할 수 있는 한도보다 큽니다."},
+	{"URITooLong", "StatusRequestURITooLong", "414 URI Too Long error", "URI 너무 긺: 클라이언트가 요청한 URI가 서버가 해석할 수 있는 것보다 깁니다."},
+	{"UnsupportedMediaType", "StatusUnsupportedMediaType", "415 Unsupported Media Type error", "지원되지 않는 미디어 유형: 서버가 요청 페이로드의 미디어 형식을 지원하지 않습니다."},
+	{"RangeNotSatisfiable", "StatusRequestedRangeNotSatisfiable", "416 Range Not Satisfiable error", "범위 만족할 수 없음: 요청의 Range 헤더 필드에 지정된 범위를 충족할 수 없습니다."},
+	{"ExpectationFailed", "StatusExpectationFailed", "417 Expectation Failed error", "기대 실패: Expect 요청 헤더 필드에 지정된 기대를 충족할 수 없습니다."},
+	{"Teapot", "StatusTeapot", "418 I'm a teapot error", "나는 찻주전자: 나는 찻주전자입니다."},
+	{"MisdirectedRequest", "StatusMisdirectedRequest", "421 Misdirected Request error", "잘못 전달된 요청: 요청이 응답을 생성할 수 없는 서버로 전달되었습니다."},
+	{"UnprocessableEntity", "StatusUnprocessableEntity", "422 Unprocessable Entity error", "처리할 수 없는 엔티티: 서버가 요청을 이해했지만, 의미론적 오류로 인해 처리할 수 없습니다."},
+	{"Locked", "StatusLocked", "423 Locked error", "잠김: 접근하려는 리소스가 잠겨 있습니다."},
+	{"FailedDependency", "StatusFailedDependency", "424 Failed Dependency error", "실패한 종속성: 이전 요청이 실패했기 때문에 현재 요청이 실패했습니다."},
+	{"TooEarly", "StatusTooEarly", "425 Too Early error", "너무 이름: 서버가 아직 처리 준비가 되지 않은 요청을 처리하려고 시도했습니다."},
+	{"UpgradeRequired", "StatusUpgradeRequired", "426 Upgrade Required error", "업그레이드 필요: 클라이언트는 다른 프로토콜로 업그레이드해야 합니다."},
+	{"PreconditionRequired", "StatusPreconditionRequired", "428 Precondition Required error", "사전 조건 필요: 원본 서버는 요청이 조건부여야 함을 요구합니다."},
+	{"TooManyRequests", "StatusTooManyRequests", "429 Too Many Requests error", "너무 많은 요청: 사용자가 지정된 시간 동안 너무 많은 요청을 보냈습니다."},
+	{"RequestHeaderFieldsTooLarge", "StatusRequestHeaderFieldsTooLarge", "431 Request Header Fields Too Large error", "요청 헤더 필드 너무 큼: 요청 헤더 필드가 너무 커서 서버가 처리할 수 없습니다."},
+	{"UnavailableForLegalReasons", "StatusUnavailableForLegalReasons", "451 Unavailable For Legal Reasons error", "법적 이유로 사용할 수 없음: 법적인 이유로 요청한 리소스에 접근할 수 없습니다."},
+	{"ClientClosedRequest", "499", "499 Client Closed Request error", "클라이언트 요청 종료: 서버가 응답하기 전에 클라이언트가 연결을 종료했습니다."},
+	{"InternalServerError", "StatusInternalServerError", "500 Internal Server Error", "내부 서버 오류: 서버에 예기치 않은 오류가 발생했습니다."},
+	{"NotImplemented", "StatusNotImplemented", "501 Not Implemented error", "구현되지 않음: 서버가 요청을 수행하는 데 필요한 기능을 지원하지 않습니다."},
+	{"BadGateway", "StatusBadGateway", "502 Bad Gateway error", "잘못된 게이트웨이: 서버가 게이트웨이 또는 프록시 역할을 하는 동안 업스트림 서버로부터 잘못된 응답을 받았습니다."},
+	{"ServiceUnavailable", "StatusServiceUnavailable", "503 Service Unavailable error", "서비스 사용 불가: 서버가 일시적으로 요청을 처리할 수 없습니다."},
+	{"GatewayTimeout", "StatusGatewayTimeout", "504 Gateway Timeout error", "게이트웨이 시간 초과: 서버가 게이트웨이 또는 프록시 역할을 하는 동안 업스트림 서버로부터 응답을 받지 못했습니다."},
+	{"HTTPVersionNotSupported", "StatusHTTPVersionNotSupported", "505 HTTP Version Not Supported error", "지원되지 않는 HTTP 버전: 서버가 요청에 사용된 HTTP 버전을 지원하지 않습니다."},
+	{"VariantAlsoNegotiates", "StatusVariantAlsoNegotiates", "506 Variant Also Negotiates error", "변형도 협상함: 서버에 내부 구성 오류가 있습니다."},
+	{"InsufficientStorage", "StatusInsufficientStorage", "507 Insufficient Storage error", "저장 공간 부족: 서버에 요청을 완료하는 데 필요한 저장 공간이 부족합니다."},
+	{"LoopDetected", "StatusLoopDetected", "508 Loop Detected error", "루프 감지됨: 서버가 요청을 처리하는 동안 무한 루프를 감지했습니다."},
+	{"NotExtended", "StatusNotExtended", "510 Not Extended error", "확장되지 않음: 요청을 이행하기 위해 추가 확장이 필요합니다."},
+	{"NetworkAuthenticationRequired", "StatusNetworkAuthenticationRequired", "511 Network Authentication Required error", "네트워크 인증 필요: 클라이언트는 네트워크 접근 권한을 얻기 위해 인증해야 합니다."},
+}
+
+var tmpl = template.Must(template.New("helpers").Parse(`// Code generated by gen_helpers.go; DO NOT EDIT.
+
+package httperror
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// --- Sentinel Errors ---
+
+// Sentinel errors for every supported status, for use with errors.Is.
+// They match any HttpError with the same status code.
+// errors.Is와 함께 사용할 수 있는 상태 코드별 센티널 오류입니다.
+var (
+{{- range .}}
+	Err{{.Name}} = {{.Name}}Error()
+{{- end}}
+)
+
+// --- Helper Functions ---
+{{range .}}
+// {{.Name}} responds with a {{.Phrase}}.
+// {{.Korean}}
+func {{.Name}}(w http.ResponseWriter, r *http.Request, opts ...Option) {
+	err := {{.Name}}Error(opts...)
+	Respond(w, r, err)
+}
+
+// {{.Name}}Error creates the HttpError struct for {{.Label}}.
+// {{.Name}}Error는 {{.Label}} HttpError를 생성합니다.
+func {{.Name}}Error(opts ...Option) *HttpError {
+	return newWithOptions({{.Code}}, opts)
+}
+
+// {{.Name}}f responds with a {{.Phrase}}, formatting the message according to a format specifier.
+// {{.Name}}f는 형식 지정자에 따라 메시지를 구성하여 {{.Label}} 오류로 응답합니다.
+func {{.Name}}f(w http.ResponseWriter, r *http.Request, format string, args ...any) {
+	err := {{.Name}}Error(fmt.Sprintf(format, args...))
+	Respond(w, r, err)
+}
+
+// {{.Name}}Handler returns an http.Handler that responds with a {{.Phrase}}.
+// {{.Name}}Handler는 {{.Label}} 오류로 응답하는 http.Handler를 반환합니다.
+func {{.Name}}Handler(opts ...Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		{{.Name}}(w, r, opts...)
+	})
+}
+{{end}}
+// statusFamilies lists the generated helper family of every status, for VetNames.
+var statusFamilies = []statusFamily{
+{{- range .}}
+	{ {{.Code}}, "{{.Name}}", {{.Name}}, {{.Name}}Error, {{.Name}}f, Err{{.Name}}, {{.Name}}Handler},
+{{- end}}
+}
+
+// koreanStatusTexts are the Korean texts of every generated status, for KoreanCatalog.
+var koreanStatusTexts = map[int]string{
+{{- range .}}
+	{{.Code}}: "{{.KoreanText}}",
+{{- end}}
+}
+`))
+
+func main() {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, statuses); err != nil {
+		log.Fatal(err)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("httperror_helpers.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,129 @@
+package httperror
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// grpcCodeNames are the names of the canonical gRPC codes, used as the
+// "status" of Google API errors.
+var grpcCodeNames = map[uint32]string{
+	grpcOK:                 "OK",
+	grpcCanceled:           "CANCELLED",
+	grpcUnknown:            "UNKNOWN",
+	grpcInvalidArgument:    "INVALID_ARGUMENT",
+	grpcDeadlineExceeded:   "DEADLINE_EXCEEDED",
+	grpcNotFound:           "NOT_FOUND",
+	grpcAlreadyExists:      "ALREADY_EXISTS",
+	grpcPermissionDenied:   "PERMISSION_DENIED",
+	grpcResourceExhausted:  "RESOURCE_EXHAUSTED",
+	grpcFailedPrecondition: "FAILED_PRECONDITION",
+	grpcAborted:            "ABORTED",
+	grpcOutOfRange:         "OUT_OF_RANGE",
+	grpcUnimplemented:      "UNIMPLEMENTED",
+	grpcInternal:           "INTERNAL",
+	grpcUnavailable:        "UNAVAILABLE",
+	grpcDataLoss:           "DATA_LOSS",
+	grpcUnauthenticated:    "UNAUTHENTICATED",
+}
+
+// Type URLs of the google.rpc error details produced by GoogleEncoder.
+const (
+	googleErrorInfoType  = "type.googleapis.com/google.rpc.ErrorInfo"
+	googleBadRequestType = "type.googleapis.com/google.rpc.BadRequest"
+	googleRetryInfoType  = "type.googleapis.com/google.rpc.RetryInfo"
+	googleHelpType       = "type.googleapis.com/google.rpc.Help"
+)
+
+// GoogleEncoder encodes errors in the Google API error model
+// ({"error":{"code","message","status","details"}}), for gateways whose
+// clients use Google-style SDKs. The status is the name of the canonical gRPC
+// code of the HTTP status. The details hold, when applicable:
+//   - an ErrorInfo whose reason is the error's Code (or the status text in
+//     upper snake case), with Domain and the error's details as metadata;
+//   - a BadRequest field violation for the "field" detail, e.g. set by the
+//     JSON mappers;
+//   - a RetryInfo from the Retry-After header, given in seconds;
+//   - a Help listing the error's links.
+//
+// GoogleEncoder는 오류를 Google API 오류 모델({"error":{"code","message","status","details"}})로 인코딩하여
+// Google 스타일 SDK를 사용하는 클라이언트와 호환되게 합니다. status는 HTTP 상태 코드에 대응하는 표준 gRPC 코드의
+// 이름입니다. details에는 해당하는 경우 ErrorInfo(Code 또는 상태 텍스트를 reason으로, Domain과 오류 상세 정보를
+// metadata로 사용), "field" 상세 정보에 대한 BadRequest, Retry-After 헤더로부터의 RetryInfo,
+// 오류 링크를 나열하는 Help가 포함됩니다.
+type GoogleEncoder struct {
+	// Domain is the domain of the ErrorInfo, e.g. "example.com".
+	Domain string
+}
+
+// ContentType implements Encoder.
+func (GoogleEncoder) ContentType() string {
+	return "application/json; charset=utf-8"
+}
+
+// Encode implements Encoder.
+func (enc GoogleEncoder) Encode(w io.Writer, e *HttpError) error {
+	body := struct {
+		Code    int              `json:"code"`
+		Message string           `json:"message"`
+		Status  string           `json:"status"`
+		Details []map[string]any `json:"details,omitempty"`
+	}{
+		Code:    e.Status,
+		Message: e.Message,
+		Status:  grpcCodeNames[e.GRPCCode()],
+	}
+
+	metadata := make(map[string]string, len(e.Details))
+	for k, v := range e.Details {
+		if k == "field" {
+			continue
+		}
+		if s, ok := v.(string); ok {
+			metadata[k] = s
+		} else {
+			metadata[k] = fmt.Sprint(v)
+		}
+	}
+	if e.Code != "" || len(metadata) > 0 {
+		info := map[string]any{"@type": googleErrorInfoType, "reason": graphQLCode(e)}
+		if enc.Domain != "" {
+			info["domain"] = enc.Domain
+		}
+		if len(metadata) > 0 {
+			info["metadata"] = metadata
+		}
+		body.Details = append(body.Details, info)
+	}
+
+	if field, ok := e.Details["field"].(string); ok {
+		body.Details = append(body.Details, map[string]any{
+			"@type": googleBadRequestType,
+			"fieldViolations": []map[string]string{
+				{"field": field, "description": e.Message},
+			},
+		})
+	}
+
+	if secs, err := strconv.ParseInt(e.Header.Get("Retry-After"), 10, 64); err == nil && secs >= 0 {
+		body.Details = append(body.Details, map[string]any{
+			"@type":      googleRetryInfoType,
+			"retryDelay": strconv.FormatInt(secs, 10) + "s",
+		})
+	}
+
+	if len(e.Links) > 0 {
+		rels := sortedKeys(e.Links)
+		links := make([]map[string]string, len(rels))
+		for i, rel := range rels {
+			links[i] = map[string]string{"description": rel, "url": e.Links[rel]}
+		}
+		body.Details = append(body.Details, map[string]any{"@type": googleHelpType, "links": links})
+	}
+
+	return json.NewEncoder(w).Encode(struct {
+		Error any `json:"error"`
+	}{body})
+}
@@ -0,0 +1,68 @@
+package httperror
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+// TestGoogleEncoder tests the Google API error model output.
+func TestGoogleEncoder(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      *HttpError
+		expected string
+	}{
+		{
+			"status only",
+			NotFoundError(),
+			`{"error":{"code":404,"message":"Not Found","status":"NOT_FOUND"}}`,
+		},
+		{
+			"error info",
+			ForbiddenError("quota project denied", WithCode("ACCESS_DENIED"), WithDetail("project", "demo")),
+			`{"error":{"code":403,"message":"quota project denied","status":"PERMISSION_DENIED","details":[` +
+				`{"@type":"type.googleapis.com/google.rpc.ErrorInfo","domain":"example.com","metadata":{"project":"demo"},"reason":"ACCESS_DENIED"}]}}`,
+		},
+		{
+			"field violation",
+			BadRequestError("age must be a number", WithDetail("field", "age")),
+			`{"error":{"code":400,"message":"age must be a number","status":"INVALID_ARGUMENT","details":[` +
+				`{"@type":"type.googleapis.com/google.rpc.BadRequest","fieldViolations":[{"description":"age must be a number","field":"age"}]}]}}`,
+		},
+		{
+			"retry and help",
+			ServiceUnavailableError(WithHeader("Retry-After", "30"), WithLink("status", "https://status.example.com")),
+			`{"error":{"code":503,"message":"Service Unavailable","status":"UNAVAILABLE","details":[` +
+				`{"@type":"type.googleapis.com/google.rpc.RetryInfo","retryDelay":"30s"},` +
+				`{"@type":"type.googleapis.com/google.rpc.Help","links":[{"description":"status","url":"https://status.example.com"}]}]}}`,
+		},
+	}
+
+	rs := NewResponder()
+	rs.SetEncoder(GoogleEncoder{Domain: "example.com"})
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			rs.HandleError(rr, httptest.NewRequest("GET", "/", nil), tc.err)
+
+			if rr.Code != tc.err.Status {
+				t.Errorf("expected status %d, got %d", tc.err.Status, rr.Code)
+			}
+			var got, expected any
+			if err := json.Unmarshal(rr.Body.Bytes(), &got); err != nil {
+				t.Fatalf("could not decode response body: %v", err)
+			}
+			json.Unmarshal([]byte(tc.expected), &expected)
+			if !reflect.DeepEqual(got, expected) {
+				t.Errorf("expected %s, got %s", tc.expected, rr.Body.String())
+			}
+		})
+	}
+
+	if name := grpcCodeNames[GRPCCodeFromHTTPStatus(http.StatusTeapot)]; name != "FAILED_PRECONDITION" {
+		t.Errorf("expected unlisted 4xx to be FAILED_PRECONDITION, got %q", name)
+	}
+}
@@ -0,0 +1,74 @@
+package httperror
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// GraphQLEncoder encodes errors as a GraphQL response
+// ({"errors":[{"message","extensions":{"code","status"}}]}), for gateways that
+// front GraphQL services. The extension code is the error's Code, or one derived
+// from the status text (e.g. "NOT_FOUND") when it has none.
+// GraphQL clients expect a 200 response, so the HTTP status is 200 unless
+// Status is set, or UseErrorStatus is true to keep the error's status.
+// GraphQLEncoder는 GraphQL 서비스 앞단의 게이트웨이를 위해 오류를 GraphQL 응답 형식으로 인코딩합니다.
+// GraphQL 클라이언트는 200 응답을 기대하므로 Status가 설정되거나 UseErrorStatus가 true가 아니면 HTTP 상태 코드는 200입니다.
+type GraphQLEncoder struct {
+	// Status is the HTTP status of the response. Defaults to 200.
+	Status int
+	// UseErrorStatus responds with the error's own status, ignoring Status.
+	UseErrorStatus bool
+}
+
+// ContentType implements Encoder.
+func (GraphQLEncoder) ContentType() string {
+	return "application/json; charset=utf-8"
+}
+
+// Encode implements Encoder.
+func (GraphQLEncoder) Encode(w io.Writer, e *HttpError) error {
+	type graphQLError struct {
+		Message    string         `json:"message"`
+		Extensions map[string]any `json:"extensions"`
+	}
+
+	ext := map[string]any{
+		"code":   graphQLCode(e),
+		"status": e.Status,
+	}
+	if len(e.Details) > 0 {
+		ext["details"] = e.Details
+	}
+	return json.NewEncoder(w).Encode(struct {
+		Errors []graphQLError `json:"errors"`
+	}{
+		Errors: []graphQLError{{Message: e.Message, Extensions: ext}},
+	})
+}
+
+// ResponseStatus returns the HTTP status the response is written with.
+// ResponseStatus는 응답에 사용되는 HTTP 상태 코드를 반환합니다.
+func (g GraphQLEncoder) ResponseStatus(e *HttpError) int {
+	if g.UseErrorStatus {
+		return e.Status
+	}
+	if g.Status != 0 {
+		return g.Status
+	}
+	return http.StatusOK
+}
+
+// graphQLCode returns the extension code of e.
+func graphQLCode(e *HttpError) string {
+	if e.Code != "" {
+		return e.Code
+	}
+	text := StatusText(e.Status)
+	if text == "" {
+		text = http.StatusText(http.StatusInternalServerError)
+	}
+	text = strings.NewReplacer(" ", "_", "-", "_", "'", "").Replace(text)
+	return strings.ToUpper(text)
+}
@@ -0,0 +1,57 @@
+package httperror
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestGraphQLEncoder tests rendering errors as a GraphQL errors array.
+func TestGraphQLEncoder(t *testing.T) {
+	testCases := []struct {
+		name           string
+		encoder        GraphQLEncoder
+		err            error
+		expectedStatus int
+		expectedCode   string
+	}{
+		{"default status", GraphQLEncoder{}, NotFoundError("no such user"), http.StatusOK, "NOT_FOUND"},
+		{"configured status", GraphQLEncoder{Status: http.StatusBadRequest}, ForbiddenError(), http.StatusBadRequest, "FORBIDDEN"},
+		{"error status", GraphQLEncoder{UseErrorStatus: true}, TeapotError(), http.StatusTeapot, "IM_A_TEAPOT"},
+		{"error code", GraphQLEncoder{}, BadRequestError(WithCode("BAD_USER_INPUT")), http.StatusOK, "BAD_USER_INPUT"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rs := NewResponder()
+			rs.SetEncoder(tc.encoder)
+			rr := httptest.NewRecorder()
+			rs.HandleError(rr, httptest.NewRequest("POST", "/graphql", nil), tc.err)
+
+			if rr.Code != tc.expectedStatus {
+				t.Errorf("expected status %d, got %d", tc.expectedStatus, rr.Code)
+			}
+			var body struct {
+				Errors []struct {
+					Message    string `json:"message"`
+					Extensions struct {
+						Code   string `json:"code"`
+						Status int    `json:"status"`
+					} `json:"extensions"`
+				} `json:"errors"`
+			}
+			if err := json.NewDecoder(rr.Body).Decode(&body); err != nil {
+				t.Fatalf("could not decode response body: %v", err)
+			}
+			if len(body.Errors) != 1 {
+				t.Fatalf("expected 1 error, got %d", len(body.Errors))
+			}
+			httpErr := toHttpError(tc.err)
+			got := body.Errors[0]
+			if got.Message != httpErr.Message || got.Extensions.Code != tc.expectedCode || got.Extensions.Status != httpErr.Status {
+				t.Errorf("unexpected error entry: %+v", got)
+			}
+		})
+	}
+}
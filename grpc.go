@@ -0,0 +1,106 @@
+package httperror
+
+import "net/http"
+
+// Canonical gRPC status codes, as defined by google.golang.org/grpc/codes.
+// They are declared here so the mapping does not depend on the gRPC module.
+const (
+	grpcOK                 uint32 = 0
+	grpcCanceled           uint32 = 1
+	grpcUnknown            uint32 = 2
+	grpcInvalidArgument    uint32 = 3
+	grpcDeadlineExceeded   uint32 = 4
+	grpcNotFound           uint32 = 5
+	grpcAlreadyExists      uint32 = 6
+	grpcPermissionDenied   uint32 = 7
+	grpcResourceExhausted  uint32 = 8
+	grpcFailedPrecondition uint32 = 9
+	grpcAborted            uint32 = 10
+	grpcOutOfRange         uint32 = 11
+	grpcUnimplemented      uint32 = 12
+	grpcInternal           uint32 = 13
+	grpcUnavailable        uint32 = 14
+	grpcDataLoss           uint32 = 15
+	grpcUnauthenticated    uint32 = 16
+)
+
+// grpcToHTTP maps canonical gRPC codes to HTTP statuses.
+var grpcToHTTP = map[uint32]int{
+	grpcOK:                 http.StatusOK,
+	grpcCanceled:           499, // Client Closed Request
+	grpcUnknown:            http.StatusInternalServerError,
+	grpcInvalidArgument:    http.StatusBadRequest,
+	grpcDeadlineExceeded:   http.StatusGatewayTimeout,
+	grpcNotFound:           http.StatusNotFound,
+	grpcAlreadyExists:      http.StatusConflict,
+	grpcPermissionDenied:   http.StatusForbidden,
+	grpcResourceExhausted:  http.StatusTooManyRequests,
+	grpcFailedPrecondition: http.StatusBadRequest,
+	grpcAborted:            http.StatusConflict,
+	grpcOutOfRange:         http.StatusBadRequest,
+	grpcUnimplemented:      http.StatusNotImplemented,
+	grpcInternal:           http.StatusInternalServerError,
+	grpcUnavailable:        http.StatusServiceUnavailable,
+	grpcDataLoss:           http.StatusInternalServerError,
+	grpcUnauthenticated:    http.StatusUnauthorized,
+}
+
+// httpToGRPC maps HTTP statuses to canonical gRPC codes.
+var httpToGRPC = map[int]uint32{
+	http.StatusBadRequest:                   grpcInvalidArgument,
+	http.StatusUnauthorized:                 grpcUnauthenticated,
+	http.StatusForbidden:                    grpcPermissionDenied,
+	http.StatusNotFound:                     grpcNotFound,
+	http.StatusMethodNotAllowed:             grpcUnimplemented,
+	http.StatusRequestTimeout:               grpcDeadlineExceeded,
+	http.StatusConflict:                     grpcAlreadyExists,
+	http.StatusPreconditionFailed:           grpcFailedPrecondition,
+	http.StatusRequestEntityTooLarge:        grpcResourceExhausted,
+	http.StatusRequestedRangeNotSatisfiable: grpcOutOfRange,
+	http.StatusTooManyRequests:              grpcResourceExhausted,
+	499:                                     grpcCanceled,
+	http.StatusNotImplemented:               grpcUnimplemented,
+	http.StatusBadGateway:                   grpcUnavailable,
+	http.StatusServiceUnavailable:           grpcUnavailable,
+	http.StatusGatewayTimeout:               grpcDeadlineExceeded,
+}
+
+// HTTPStatusFromGRPCCode returns the HTTP status corresponding to a canonical
+// gRPC code (codes.Code converted to uint32). Unknown codes map to 500.
+// HTTPStatusFromGRPCCode는 표준 gRPC 코드에 대응하는 HTTP 상태 코드를 반환합니다. 알 수 없는 코드는 500입니다.
+func HTTPStatusFromGRPCCode(code uint32) int {
+	if status, ok := grpcToHTTP[code]; ok {
+		return status
+	}
+	return http.StatusInternalServerError
+}
+
+// GRPCCodeFromHTTPStatus returns the canonical gRPC code corresponding to an
+// HTTP status. Unlisted statuses map to FailedPrecondition (4xx), Internal (5xx)
+// or OK (anything below 400).
+// GRPCCodeFromHTTPStatus는 HTTP 상태 코드에 대응하는 표준 gRPC 코드를 반환합니다.
+func GRPCCodeFromHTTPStatus(status int) uint32 {
+	if code, ok := httpToGRPC[status]; ok {
+		return code
+	}
+	switch {
+	case status >= 500:
+		return grpcInternal
+	case status >= 400:
+		return grpcFailedPrecondition
+	default:
+		return grpcOK
+	}
+}
+
+// GRPCCode returns the canonical gRPC code corresponding to the error's status.
+// HttpError deliberately has no GRPCStatus method, which would make this
+// module depend on gRPC: status.FromError reports HttpErrors as Unknown. The
+// httperrorgrpc package converts HttpErrors to and from *status.Status, and
+// its server interceptors convert the HttpErrors returned by handlers.
+// GRPCCode는 오류의 상태 코드에 대응하는 표준 gRPC 코드를 반환합니다. HttpError는 이 모듈이 gRPC에 의존하지 않도록
+// 의도적으로 GRPCStatus 메서드를 제공하지 않으므로 status.FromError는 HttpError를 Unknown으로 보고합니다.
+// httperrorgrpc 패키지는 HttpError와 *status.Status 간 변환 및 핸들러가 반환한 HttpError를 변환하는 서버 인터셉터를 제공합니다.
+func (e *HttpError) GRPCCode() uint32 {
+	return GRPCCodeFromHTTPStatus(e.Status)
+}
@@ -0,0 +1,46 @@
+package httperror
+
+import (
+	"net/http"
+	"testing"
+)
+
+// TestGRPCCodeMapping tests the mapping between gRPC codes and HTTP statuses.
+func TestGRPCCodeMapping(t *testing.T) {
+	testCases := []struct {
+		code   uint32
+		status int
+	}{
+		{grpcInvalidArgument, http.StatusBadRequest},
+		{grpcUnauthenticated, http.StatusUnauthorized},
+		{grpcPermissionDenied, http.StatusForbidden},
+		{grpcNotFound, http.StatusNotFound},
+		{grpcAlreadyExists, http.StatusConflict},
+		{grpcResourceExhausted, http.StatusTooManyRequests},
+		{grpcCanceled, 499},
+		{grpcUnimplemented, http.StatusNotImplemented},
+		{grpcUnavailable, http.StatusServiceUnavailable},
+		{grpcDeadlineExceeded, http.StatusGatewayTimeout},
+	}
+	for _, tc := range testCases {
+		if got := HTTPStatusFromGRPCCode(tc.code); got != tc.status {
+			t.Errorf("HTTPStatusFromGRPCCode(%d): expected %d, got %d", tc.code, tc.status, got)
+		}
+		if got := GRPCCodeFromHTTPStatus(tc.status); got != tc.code {
+			t.Errorf("GRPCCodeFromHTTPStatus(%d): expected %d, got %d", tc.status, tc.code, got)
+		}
+	}
+
+	if got := HTTPStatusFromGRPCCode(99); got != http.StatusInternalServerError {
+		t.Errorf("expected unknown code to map to 500, got %d", got)
+	}
+	if got := GRPCCodeFromHTTPStatus(http.StatusTeapot); got != grpcFailedPrecondition {
+		t.Errorf("expected unlisted 4xx to map to FailedPrecondition, got %d", got)
+	}
+	if got := GRPCCodeFromHTTPStatus(http.StatusLoopDetected); got != grpcInternal {
+		t.Errorf("expected unlisted 5xx to map to Internal, got %d", got)
+	}
+	if got := LockedError().GRPCCode(); got != grpcFailedPrecondition {
+		t.Errorf("expected GRPCCode %d, got %d", grpcFailedPrecondition, got)
+	}
+}
@@ -0,0 +1,117 @@
+package httperror
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+)
+
+// Checker reports whether a dependency of the service, such as a database or
+// a downstream API, is ready, returning an error describing why it is not.
+// Checker는 데이터베이스나 하위 API 같은 서비스 의존성이 준비되었는지 보고하며, 준비되지 않았으면 그 이유를 설명하는 오류를 반환합니다.
+type Checker func() error
+
+// namedCheck is a Checker registered under a name.
+type namedCheck struct {
+	name  string
+	check Checker
+}
+
+// checks holds an immutable snapshot of the registered checks, replaced as a whole under checksMu.
+var (
+	checksMu sync.Mutex
+	checks   atomic.Pointer[[]namedCheck]
+)
+
+// RegisterCheck registers a readiness check under name, replacing the check
+// already registered under it. Checks run on every request passing through
+// RequireHealthy or HealthHandler, so they should be cheap, e.g. reading a
+// state maintained in the background.
+// RegisterCheck는 name으로 준비 상태 검사를 등록하며, 같은 이름으로 등록된 검사는 대체됩니다.
+// 검사는 RequireHealthy나 HealthHandler를 거치는 모든 요청마다 실행되므로, 백그라운드에서 유지되는 상태를
+// 읽는 것처럼 가벼워야 합니다.
+func RegisterCheck(name string, c Checker) {
+	if c == nil {
+		return
+	}
+	checksMu.Lock()
+	defer checksMu.Unlock()
+	var next []namedCheck
+	if cs := checks.Load(); cs != nil {
+		for _, nc := range *cs {
+			if nc.name != name {
+				next = append(next, nc)
+			}
+		}
+	}
+	next = append(next, namedCheck{name, c})
+	checks.Store(&next)
+}
+
+// ResetChecks removes all registered checks.
+// ResetChecks는 등록된 모든 검사를 제거합니다.
+func ResetChecks() {
+	checksMu.Lock()
+	defer checksMu.Unlock()
+	checks.Store(nil)
+}
+
+// CheckHealth runs the registered checks in registration order and returns
+// nil if they all pass, or a 503 Service Unavailable error whose details map
+// the name of every failing check to its error ("checks"). A panicking check
+// fails.
+// CheckHealth는 등록된 검사를 등록 순서대로 실행하여 모두 통과하면 nil을, 그렇지 않으면 실패한 각 검사의
+// 이름과 오류를 상세 정보("checks")에 담은 503 Service Unavailable 오류를 반환합니다. 패닉이 발생한 검사는 실패합니다.
+func CheckHealth() *HttpError {
+	cs := checks.Load()
+	if cs == nil {
+		return nil
+	}
+	var failures map[string]any
+	for _, nc := range *cs {
+		if err := runCheck(nc.check); err != nil {
+			if failures == nil {
+				failures = make(map[string]any)
+			}
+			failures[nc.name] = err.Error()
+		}
+	}
+	if failures == nil {
+		return nil
+	}
+	return ServiceUnavailableError(WithDetail("checks", failures))
+}
+
+// runCheck runs c, turning a panic into an error.
+func runCheck(c Checker) (err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("check panicked: %v", p)
+		}
+	}()
+	return c()
+}
+
+// RequireHealthy returns a middleware responding with the error of
+// CheckHealth while the registered checks fail, and passing requests on to
+// next otherwise.
+// RequireHealthy는 등록된 검사가 실패하는 동안 CheckHealth의 오류로 응답하고, 그렇지 않으면 요청을 next로 전달하는 미들웨어를 반환합니다.
+func RequireHealthy(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := CheckHealth(); err != nil {
+			Respond(w, r, err)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// HealthHandler returns a readiness endpoint responding with 204 No Content
+// when the registered checks pass and with the error of CheckHealth otherwise.
+// HealthHandler는 등록된 검사가 통과하면 204 No Content로, 그렇지 않으면 CheckHealth의 오류로 응답하는 준비 상태 엔드포인트를 반환합니다.
+func HealthHandler() http.Handler {
+	return RequireHealthy(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+}
@@ -0,0 +1,79 @@
+package httperror
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestHealthHandler tests responding to readiness probes from the registered checks.
+func TestHealthHandler(t *testing.T) {
+	defer ResetChecks()
+
+	testCases := []struct {
+		name           string
+		checks         map[string]Checker
+		expectedStatus int
+		expectedBody   string
+	}{
+		{"no checks", nil, http.StatusNoContent, ""},
+		{"passing", map[string]Checker{"db": func() error { return nil }}, http.StatusNoContent, ""},
+		{"failing", map[string]Checker{
+			"db":    func() error { return errors.New("connection refused") },
+			"cache": func() error { return nil },
+			"queue": func() error { panic("broken") },
+		}, http.StatusServiceUnavailable, `{"status":503,"message":"Service Unavailable","details":{"checks":{"db":"connection refused","queue":"check panicked: broken"}}}` + "\n"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ResetChecks()
+			for name, c := range tc.checks {
+				RegisterCheck(name, c)
+			}
+			rr := httptest.NewRecorder()
+			HealthHandler().ServeHTTP(rr, httptest.NewRequest("GET", "/readyz", nil))
+
+			if rr.Code != tc.expectedStatus {
+				t.Errorf("expected status %d, got %d", tc.expectedStatus, rr.Code)
+			}
+			if got := rr.Body.String(); got != tc.expectedBody {
+				t.Errorf("expected body %q, got %q", tc.expectedBody, got)
+			}
+		})
+	}
+}
+
+// TestRequireHealthy tests that requests are rejected while a check fails.
+func TestRequireHealthy(t *testing.T) {
+	defer ResetChecks()
+
+	var ready bool
+	RegisterCheck("warmup", func() error {
+		if !ready {
+			return errors.New("warming up")
+		}
+		return nil
+	})
+	handler := RequireHealthy(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest("GET", "/", nil))
+	if rr.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected status 503 while warming up, got %d", rr.Code)
+	}
+
+	ready = true
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest("GET", "/", nil))
+	if rr.Code != http.StatusOK {
+		t.Errorf("expected status 200 once ready, got %d", rr.Code)
+	}
+
+	RegisterCheck("warmup", func() error { return errors.New("replaced") })
+	if err := CheckHealth(); err == nil || err.Details["checks"].(map[string]any)["warmup"] != "replaced" {
+		t.Errorf("expected the check to be replaced, got %v", err)
+	}
+}
@@ -0,0 +1,245 @@
+package httperror
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ErrorEvent describes an error response after it has been rendered.
+// ErrorEvent는 렌더링이 완료된 오류 응답을 나타냅니다.
+type ErrorEvent struct {
+	// Request is the request the error was responded to, or nil for background errors.
+	Request *http.Request
+	// RequestID is the ID of the request, as set by AccessLog or WithRequestID.
+	RequestID string
+	// Err is the error passed to Respond.
+	Err error
+	// HttpError is the HttpError the error was resolved to. It may be shared
+	// with other requests and must not be modified; see HttpError.Clone.
+	HttpError *HttpError
+	// Layers are the layers the error passed through, as recorded by Annotate.
+	Layers []string
+	// Response is a read-only snapshot of what was written to the client.
+	// It is empty when no ResponseWriter was given.
+	Response ResponseView
+	// Time is when the error was responded.
+	Time time.Time
+}
+
+// ResponseView is a read-only snapshot of a rendered error response.
+// Hooks receive copies, so modifying them has no effect on the response.
+// ResponseView는 렌더링된 오류 응답의 읽기 전용 스냅샷입니다.
+type ResponseView struct {
+	// Status is the status code written to the client.
+	Status int
+	// Header is a snapshot of the response headers.
+	Header http.Header
+	// Body is a copy of the response body.
+	Body []byte
+}
+
+// Hook is called synchronously by Respond after the error response has been
+// rendered. Hooks never see the ResponseWriter; they must not try to write to
+// the response, and any attempt to do so is rejected and logged.
+// Hook은 오류 응답이 렌더링된 후 Respond에 의해 동기적으로 호출됩니다.
+// 훅은 ResponseWriter에 접근할 수 없으며, 응답에 쓰려는 시도는 거부되고 기록됩니다.
+type Hook func(ev ErrorEvent)
+
+// hooks holds an immutable snapshot of the registered hooks, replaced as a whole under hooksMu.
+var (
+	hooksMu sync.Mutex
+	hooks   atomic.Pointer[[]Hook]
+)
+
+// AddHook registers a hook that is called after every error response.
+// Hooks are called in registration order; a panicking hook is recovered and logged.
+// AddHook은 모든 오류 응답 후에 호출되는 훅을 등록합니다.
+// 훅은 등록 순서대로 호출되며, 패닉이 발생한 훅은 복구되어 기록됩니다.
+func AddHook(h Hook) {
+	if h == nil {
+		return
+	}
+	hooksMu.Lock()
+	defer hooksMu.Unlock()
+	var next []Hook
+	if hs := hooks.Load(); hs != nil {
+		next = append(next, *hs...)
+	}
+	next = append(next, h)
+	hooks.Store(&next)
+}
+
+// ResetHooks removes all registered hooks.
+// ResetHooks는 등록된 모든 훅을 제거합니다.
+func ResetHooks() {
+	hooksMu.Lock()
+	defer hooksMu.Unlock()
+	hooks.Store(nil)
+}
+
+// runHooks calls every registered hook with ev.
+func runHooks(ev ErrorEvent) {
+	hs := hooks.Load()
+	if hs == nil {
+		return
+	}
+
+	start := time.Now()
+	for _, h := range *hs {
+		callHook(h, ev)
+	}
+	hookNanos.Add(int64(time.Since(start)))
+}
+
+func callHook(h Hook, ev ErrorEvent) {
+	defer func() {
+		if v := recover(); v != nil {
+			logger().Error("httperror: hook panicked", "panic", v)
+		}
+	}()
+	h(ev)
+}
+
+// ErrResponseSealed is returned by writes to an error response that has already been rendered.
+// ErrResponseSealed는 이미 렌더링이 완료된 오류 응답에 쓰기를 시도할 때 반환됩니다.
+var ErrResponseSealed = errors.New("httperror: response already rendered")
+
+// guardedWriter records what the error handler writes and rejects any write
+// attempted after the response has been sealed.
+type guardedWriter struct {
+	w      http.ResponseWriter
+	status int
+	// record is set when the body is kept for the hooks.
+	record bool
+	body   []byte
+	// failure is the first error that occurred while writing the response.
+	failure error
+
+	mu     sync.Mutex
+	sealed bool
+}
+
+// failureRecorder is implemented by writers recording the failures that occur
+// while an error response is written, such as the guardedWriter of Respond.
+type failureRecorder interface {
+	recordFailure(err error)
+}
+
+// recordFailure records err unless a failure was already recorded.
+func (g *guardedWriter) recordFailure(err error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.failure == nil {
+		g.failure = err
+	}
+}
+
+// newGuardedWriter wraps w, keeping a copy of the body if record is set.
+func newGuardedWriter(w http.ResponseWriter, record bool) *guardedWriter {
+	markRendered(w)
+	return &guardedWriter{w: w, record: record}
+}
+
+// renderedMarker is implemented by writers treating the responses rendered
+// by this package differently, such as the interceptWriter of Intercept.
+type renderedMarker interface {
+	markRendered()
+}
+
+// markRendered tells the writers w wraps, w included, that the response is
+// rendered by this package.
+func markRendered(w http.ResponseWriter) {
+	for w != nil {
+		if m, ok := w.(renderedMarker); ok {
+			m.markRendered()
+		}
+		u, ok := w.(interface{ Unwrap() http.ResponseWriter })
+		if !ok {
+			return
+		}
+		w = u.Unwrap()
+	}
+}
+
+// Header returns the live header map until sealed, and a detached copy afterwards.
+func (g *guardedWriter) Header() http.Header {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.sealed {
+		g.violation("Header")
+		return g.w.Header().Clone()
+	}
+	return g.w.Header()
+}
+
+func (g *guardedWriter) WriteHeader(status int) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.sealed {
+		g.violation("WriteHeader")
+		return
+	}
+	if g.status == 0 {
+		g.status = status
+	}
+	g.w.WriteHeader(status)
+}
+
+func (g *guardedWriter) Write(p []byte) (int, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.sealed {
+		g.violation("Write")
+		return 0, ErrResponseSealed
+	}
+	if g.status == 0 {
+		g.status = http.StatusOK
+	}
+	if g.record {
+		g.body = append(g.body, p...)
+	}
+	n, err := g.w.Write(p)
+	if err != nil && g.failure == nil {
+		g.failure = err
+	}
+	return n, err
+}
+
+// Flush implements http.Flusher when the underlying writer supports it.
+func (g *guardedWriter) Flush() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.sealed {
+		g.violation("Flush")
+		return
+	}
+	if f, ok := g.w.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Unwrap returns the underlying ResponseWriter, for use by http.ResponseController.
+func (g *guardedWriter) Unwrap() http.ResponseWriter {
+	return g.w
+}
+
+// seal marks the response as rendered and returns a snapshot of it, along
+// with the first failure that occurred while writing it.
+func (g *guardedWriter) seal() (ResponseView, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.sealed = true
+	return ResponseView{
+		Status: g.status,
+		Header: g.w.Header().Clone(),
+		Body:   append([]byte(nil), g.body...),
+	}, g.failure
+}
+
+// violation logs an attempt to modify a sealed response. The caller holds g.mu.
+func (g *guardedWriter) violation(method string) {
+	logger().Warn("httperror: attempt to modify an already rendered error response", "method", method)
+}
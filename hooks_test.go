@@ -0,0 +1,131 @@
+package httperror
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// TestHooks tests that hooks receive a read-only snapshot of the rendered response.
+func TestHooks(t *testing.T) {
+	SetErrorHandler(nil)
+	defer ResetHooks()
+
+	var got ErrorEvent
+	AddHook(func(ev ErrorEvent) {
+		got = ev
+		// Mutating the snapshot must not affect the response.
+		ev.Response.Header.Set("X-Hook", "mutated")
+	})
+
+	rr := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/", nil)
+	Respond(rr, req, New(http.StatusNotFound, "Not Found"))
+
+	if got.HttpError == nil || got.HttpError.Status != http.StatusNotFound {
+		t.Fatalf("expected hook to receive the 404 error, got %+v", got.HttpError)
+	}
+	if got.Request != req {
+		t.Error("expected hook to receive the request")
+	}
+	if got.Response.Status != http.StatusNotFound {
+		t.Errorf("expected response status 404, got %d", got.Response.Status)
+	}
+	if got.Response.Header.Get("Content-Type") != "application/json; charset=utf-8" {
+		t.Errorf("expected header snapshot, got %v", got.Response.Header)
+	}
+	if !bytes.Equal(got.Response.Body, rr.Body.Bytes()) {
+		t.Errorf("expected body snapshot %q, got %q", rr.Body.String(), got.Response.Body)
+	}
+	if rr.Header().Get("X-Hook") != "" {
+		t.Error("hook mutation of the snapshot leaked into the response")
+	}
+}
+
+// TestHookPanic tests that a panicking hook does not stop subsequent hooks.
+func TestHookPanic(t *testing.T) {
+	SetErrorHandler(nil)
+	defer ResetHooks()
+
+	called := false
+	AddHook(func(ev ErrorEvent) { panic("hook failure") })
+	AddHook(func(ev ErrorEvent) { called = true })
+
+	Respond(httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil), errors.New("err"))
+
+	if !called {
+		t.Error("expected the second hook to be called")
+	}
+}
+
+// TestResponseGuard tests that writes after rendering are rejected and logged.
+func TestResponseGuard(t *testing.T) {
+	var logs bytes.Buffer
+	SetLogger(slog.New(slog.NewTextHandler(&logs, nil)))
+	defer SetLogger(nil)
+
+	var leaked http.ResponseWriter
+	SetErrorHandler(func(w http.ResponseWriter, r *http.Request, err error) {
+		leaked = w
+		DefaultErrorHandler(w, r, err)
+	})
+	defer SetErrorHandler(nil)
+
+	rr := httptest.NewRecorder()
+	Respond(rr, httptest.NewRequest("GET", "/", nil), New(http.StatusConflict, "Conflict"))
+	body := rr.Body.String()
+
+	if _, err := leaked.Write([]byte("late")); !errors.Is(err, ErrResponseSealed) {
+		t.Errorf("expected ErrResponseSealed, got %v", err)
+	}
+	leaked.WriteHeader(http.StatusOK)
+	leaked.Header().Set("X-Late", "1")
+
+	if rr.Body.String() != body {
+		t.Errorf("expected body to be unchanged, got %q", rr.Body.String())
+	}
+	if rr.Header().Get("X-Late") != "" {
+		t.Error("expected late header mutation to be dropped")
+	}
+	if !strings.Contains(logs.String(), "already rendered") {
+		t.Errorf("expected violation to be logged, got %q", logs.String())
+	}
+}
+
+// TestRespondResolvesOnce tests that errors are resolved once per Respond, with and without hooks.
+func TestRespondResolvesOnce(t *testing.T) {
+	SetErrorHandler(nil)
+	errMissing := errors.New("missing")
+	var mapped int
+	RegisterMapper(func(err error) (*HttpError, bool) {
+		if !errors.Is(err, errMissing) {
+			return nil, false
+		}
+		mapped++
+		return NotFoundError(), true
+	})
+	defer ResetMappers()
+
+	for _, hooked := range []bool{false, true} {
+		ResetHooks()
+		var body []byte
+		if hooked {
+			AddHook(func(ev ErrorEvent) { body = ev.Response.Body })
+		}
+		mapped = 0
+		rr := httptest.NewRecorder()
+		Respond(rr, httptest.NewRequest("GET", "/", nil), errMissing)
+
+		if rr.Code != http.StatusNotFound || mapped != 1 {
+			t.Errorf("hooked %v: expected 404 mapped once, got %d mapped %d times", hooked, rr.Code, mapped)
+		}
+		if hooked && !bytes.Equal(body, rr.Body.Bytes()) {
+			t.Errorf("expected the hook to see the body %q, got %q", rr.Body.Bytes(), body)
+		}
+	}
+	ResetHooks()
+}
@@ -1,10 +1,10 @@
 package httperror
 
 import (
-	"encoding/json"
-	"io"
+	"errors"
 	"net/http"
-	"strings"
+	"sync/atomic"
+	"time"
 )
 
 // ErrorHandler defines the function signature for custom error handlers.
@@ -12,59 +12,160 @@ import (
 type ErrorHandler func(w http.ResponseWriter, r *http.Request, err error)
 
 // currentErrorHandler stores the currently active error handler.
-// Defaults to DefaultErrorHandler.
-var currentErrorHandler ErrorHandler = DefaultErrorHandler
+// A nil value means DefaultErrorHandler.
+var currentErrorHandler atomic.Pointer[ErrorHandler]
 
 // SetErrorHandler sets the global error handler.
 // If nil is provided, it sets the handler to DefaultErrorHandler.
+// It is safe to call while requests are being served; each Respond call uses
+// the handler that was set when it started.
 // SetErrorHandler는 전역 오류 핸들러를 설정합니다. nil이 제공되면 기본 핸들러로 설정됩니다.
+// 요청 처리 중에도 안전하게 호출할 수 있으며, 각 Respond 호출은 시작 시점의 핸들러를 사용합니다.
 func SetErrorHandler(handler ErrorHandler) {
 	if handler == nil {
-		currentErrorHandler = DefaultErrorHandler
-	} else {
-		currentErrorHandler = handler
+		currentErrorHandler.Store(nil)
+		return
 	}
+	currentErrorHandler.Store(&handler)
 }
 
 // Respond calls the globally configured error handler to handle the error.
+// Once the response is rendered, registered hooks are called with a read-only view of it.
+// A nil writer skips rendering but still counts, reports and emits hook events, so
+// background workers can reuse the pipeline for logging-only error handling.
+// A nil request is rendered as JSON.
 // Respond는 설정된 전역 오류 핸들러를 호출하여 오류를 처리합니다.
+// 응답이 렌더링된 후, 등록된 훅이 응답의 읽기 전용 뷰와 함께 호출됩니다.
+// w가 nil이면 렌더링은 건너뛰지만 집계, 보고, 훅 이벤트는 그대로 수행되므로
+// 백그라운드 작업에서도 로깅 전용으로 같은 파이프라인을 사용할 수 있습니다. r이 nil이면 JSON으로 렌더링합니다.
 func Respond(w http.ResponseWriter, r *http.Request, err error) {
-	currentErrorHandler(w, r, err)
+	respond(w, r, err)
 }
 
-// DefaultErrorHandler provides a default implementation for handling errors.
-// It checks if the error is an HttpError and writes the appropriate JSON or HTML response
-// based on the Request's Accept header.
-// For any other error, it returns a 500 Internal Server Error.
-// DefaultErrorHandler는 오류 처리를 위한 기본 구현을 제공합니다.
-// 오류가 HttpError인지 확인하고 요청의 Accept 헤더에 따라 적절한 JSON 또는 HTML 응답을 작성합니다.
-// 다른 모든 오류에 대해서는 500 내부 서버 오류를 반환합니다.
-func DefaultErrorHandler(w http.ResponseWriter, r *http.Request, err error) {
-	// Simple Content Negotiation:
-	accept := r.Header.Get("Accept")
-	useHTML := false
-	if accept != "" {
-		if strings.Contains(accept, "text/html") || strings.Contains(accept, "application/xhtml+xml") {
-			useHTML = true
+// RespondE is like Respond but returns the error that occurred while encoding
+// or writing the response, such as a broken pipe, so callers can log it.
+// Write failures are detected with any error handler; encoding failures are
+// reported by the handlers of this package.
+// RespondE는 Respond와 같지만 응답을 인코딩하거나 쓰는 중 발생한 오류(예: broken pipe)를 반환하여
+// 호출자가 이를 기록할 수 있게 합니다. 쓰기 실패는 모든 오류 핸들러에서 감지되며,
+// 인코딩 실패는 이 패키지의 핸들러가 보고합니다.
+func RespondE(w http.ResponseWriter, r *http.Request, err error) error {
+	return respond(w, r, err)
+}
+
+// respond implements Respond and RespondE. The default handler is passed on
+// as nil, so the error resolved for accounting is rendered as is.
+func respond(w http.ResponseWriter, r *http.Request, err error) error {
+	if h := currentErrorHandler.Load(); h != nil {
+		return respondWith(w, r, err, *h)
+	}
+	return respondWith(w, r, err, nil)
+}
+
+// respondWith renders err with handler, or with the default Responder if
+// handler is nil, then counts, reports and journals it and runs the hooks.
+// The response is only recorded when hooks are registered, and the writer
+// is only wrapped when the response is recorded or handler is not the
+// default one, whose write failures are detected by the wrapper.
+func respondWith(w http.ResponseWriter, r *http.Request, err error, handler ErrorHandler) error {
+	httpErr := toHttpError(err)
+	countError(httpErr.Status)
+	recordStats(httpErr, time.Now())
+	reportError(r, err, httpErr)
+	var requestID string
+	if r != nil {
+		recordLastError(r.Context(), httpErr)
+		requestID = RequestID(r.Context())
+	}
+
+	hooked := hooks.Load() != nil
+	var view ResponseView
+	var failure error
+	switch {
+	case w == nil:
+	case !hooked && handler == nil:
+		failure = defaultResponder.render(w, r, err, httpErr)
+	default:
+		gw := newGuardedWriter(w, hooked)
+		if handler == nil {
+			if f := defaultResponder.render(gw, r, err, httpErr); f != nil {
+				gw.recordFailure(f)
+			}
+		} else {
+			handler(gw, r, err)
 		}
+		view, failure = gw.seal()
 	}
 
-	// Ensure we are dealing with an HttpError
-	var httpErr *HttpError
-	if e, ok := err.(*HttpError); ok && e != nil {
-		httpErr = e
-	} else {
-		httpErr = InternalServerErrorError()
+	ev := ErrorEvent{
+		Request:   r,
+		RequestID: requestID,
+		Err:       err,
+		HttpError: httpErr,
+		Layers:    Layers(err),
+		Response:  view,
+		Time:      time.Now(),
+	}
+	journalError(ev)
+	runHooks(ev)
+	return failure
+}
+
+// toHttpError returns err as an *HttpError, falling back to a 500 error for
+// anything that cannot be resolved.
+func toHttpError(err error) *HttpError {
+	if e, ok := resolveError(err); ok {
+		return e
 	}
+	return InternalServerErrorError()
+}
 
-	// Header MUST be set before WriteHeader
-	if useHTML {
-		w.Header().Set("Content-Type", "text/html; charset=utf-8")
-		w.WriteHeader(httpErr.Status)
-		io.WriteString(w, `<div class="http-error">`+httpErr.Message+`</div>`)
-	} else {
-		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(httpErr.Status)
-		json.NewEncoder(w).Encode(httpErr)
+// resolveError translates err into an *HttpError using, in order, the error
+// itself, the registered mappers, an Errors or joined error members, an
+// HttpError wrapped in its chain and the built-in context, body limit and
+// DecodeJSON mappings. It returns false if none of them applies.
+func resolveError(err error) (*HttpError, bool) {
+	if e, ok := err.(*HttpError); ok && e != nil {
+		return e, true
+	}
+	if e, ok := mapError(err); ok {
+		return e, true
+	}
+	var aggregate Errors
+	if errors.As(err, &aggregate) && len(aggregate) > 0 {
+		return aggregate.HttpError(), true
+	}
+	if e, ok := resolveJoined(err); ok {
+		return e, true
+	}
+	var wrapped *HttpError
+	if errors.As(err, &wrapped) && wrapped != nil {
+		return wrapped, true
+	}
+	if e, ok := mapContextError(err); ok {
+		return e, true
+	}
+	if e, ok := mapBodyLimitError(err); ok {
+		return e, true
+	}
+	if e, ok := mapDecodeJSONError(err); ok {
+		return e, true
 	}
+	return nil, false
+}
+
+// DefaultErrorHandler provides a default implementation for handling errors.
+// It checks if the error is an HttpError and writes the appropriate JSON or HTML response
+// based on the Request's Accept header.
+// Other errors are translated by the registered mappers, then context.DeadlineExceeded
+// becomes 504 and context.Canceled the status set by SetCanceledStatus;
+// any error left over results in a 500 Internal Server Error.
+// It renders through the default Responder, see DefaultResponder.
+// DefaultErrorHandler는 오류 처리를 위한 기본 구현을 제공합니다.
+// 오류가 HttpError인지 확인하고 요청의 Accept 헤더에 따라 적절한 JSON 또는 HTML 응답을 작성합니다.
+// 다른 오류는 등록된 매퍼로 변환되며, 이후 context.DeadlineExceeded는 504, context.Canceled는
+// SetCanceledStatus로 설정된 상태 코드가 됩니다. 변환되지 않은 오류에 대해서는 500 내부 서버 오류를 반환합니다.
+// 렌더링은 기본 Responder를 통해 이루어집니다(DefaultResponder 참고).
+func DefaultErrorHandler(w http.ResponseWriter, r *http.Request, err error) {
+	defaultResponder.HandleError(w, r, err)
 }
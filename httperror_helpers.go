@@ -1,321 +1,1297 @@
+// Code generated by gen_helpers.go; DO NOT EDIT.
+
 package httperror
 
-import "net/http"
+import (
+	"fmt"
+	"net/http"
+)
+
+// --- Sentinel Errors ---
+
+// Sentinel errors for every supported status, for use with errors.Is.
+// They match any HttpError with the same status code.
+// errors.Is와 함께 사용할 수 있는 상태 코드별 센티널 오류입니다.
+var (
+	ErrBadRequest                    = BadRequestError()
+	ErrUnauthorized                  = UnauthorizedError()
+	ErrPaymentRequired               = PaymentRequiredError()
+	ErrForbidden                     = ForbiddenError()
+	ErrNotFound                      = NotFoundError()
+	ErrMethodNotAllowed              = MethodNotAllowedError()
+	ErrNotAcceptable                 = NotAcceptableError()
+	ErrProxyAuthRequired             = ProxyAuthRequiredError()
+	ErrRequestTimeout                = RequestTimeoutError()
+	ErrConflict                      = ConflictError()
+	ErrGone                          = GoneError()
+	ErrLengthRequired                = LengthRequiredError()
+	ErrPreconditionFailed            = PreconditionFailedError()
+	ErrPayloadTooLarge               = PayloadTooLargeError()
+	ErrURITooLong                    = URITooLongError()
+	ErrUnsupportedMediaType          = UnsupportedMediaTypeError()
+	ErrRangeNotSatisfiable           = RangeNotSatisfiableError()
+	ErrExpectationFailed             = ExpectationFailedError()
+	ErrTeapot                        = TeapotError()
+	ErrMisdirectedRequest            = MisdirectedRequestError()
+	ErrUnprocessableEntity           = UnprocessableEntityError()
+	ErrLocked                        = LockedError()
+	ErrFailedDependency              = FailedDependencyError()
+	ErrTooEarly                      = TooEarlyError()
+	ErrUpgradeRequired               = UpgradeRequiredError()
+	ErrPreconditionRequired          = PreconditionRequiredError()
+	ErrTooManyRequests               = TooManyRequestsError()
+	ErrRequestHeaderFieldsTooLarge   = RequestHeaderFieldsTooLargeError()
+	ErrUnavailableForLegalReasons    = UnavailableForLegalReasonsError()
+	ErrClientClosedRequest           = ClientClosedRequestError()
+	ErrInternalServerError           = InternalServerErrorError()
+	ErrNotImplemented                = NotImplementedError()
+	ErrBadGateway                    = BadGatewayError()
+	ErrServiceUnavailable            = ServiceUnavailableError()
+	ErrGatewayTimeout                = GatewayTimeoutError()
+	ErrHTTPVersionNotSupported       = HTTPVersionNotSupportedError()
+	ErrVariantAlsoNegotiates         = VariantAlsoNegotiatesError()
+	ErrInsufficientStorage           = InsufficientStorageError()
+	ErrLoopDetected                  = LoopDetectedError()
+	ErrNotExtended                   = NotExtendedError()
+	ErrNetworkAuthenticationRequired = NetworkAuthenticationRequiredError()
+)
+
+// --- Helper Functions ---
 
-// HttpError represents an error with an associated HTTP status code.
-// HttpError는 HTTP 상태 코드와 관련된 오류를 나타냅니다.
-type HttpError struct {
-	Status  int    `json:"status"`
-	Message string `json:"message"`
+// BadRequest responds with a 400 Bad Request error.
+// 잘못된 요청: 서버가 요청의 구문을 인식하지 못했습니다.
+func BadRequest(w http.ResponseWriter, r *http.Request, opts ...Option) {
+	err := BadRequestError(opts...)
+	Respond(w, r, err)
 }
 
-// Error returns the error message.
-// Error는 오류 메시지를 반환합니다.
-func (e *HttpError) Error() string {
-	return e.Message
+// BadRequestError creates the HttpError struct for 400 Bad Request.
+// BadRequestError는 400 Bad Request HttpError를 생성합니다.
+func BadRequestError(opts ...Option) *HttpError {
+	return newWithOptions(http.StatusBadRequest, opts)
 }
 
-// New creates a new HttpError.
-// New는 새로운 HttpError를 생성합니다.
-func New(status int, message string) *HttpError {
-	return &HttpError{
-		Status:  status,
-		Message: message,
-	}
+// BadRequestf responds with a 400 Bad Request error, formatting the message according to a format specifier.
+// BadRequestf는 형식 지정자에 따라 메시지를 구성하여 400 Bad Request 오류로 응답합니다.
+func BadRequestf(w http.ResponseWriter, r *http.Request, format string, args ...any) {
+	err := BadRequestError(fmt.Sprintf(format, args...))
+	Respond(w, r, err)
 }
 
-// joinMessages is a helper to handle the variadic message argument.
-func joinMessages(defaultMsg string, message []string) string {
-	if len(message) > 0 {
-		return message[0]
-	}
-	return defaultMsg
+// BadRequestHandler returns an http.Handler that responds with a 400 Bad Request error.
+// BadRequestHandler는 400 Bad Request 오류로 응답하는 http.Handler를 반환합니다.
+func BadRequestHandler(opts ...Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		BadRequest(w, r, opts...)
+	})
 }
 
-// InternalServerErrorError creates the HttpError struct for 500.
-// This is used internally by DefaultErrorHandler for unknown errors.
-func InternalServerErrorError(message ...string) *HttpError {
-	return New(http.StatusInternalServerError, joinMessages(http.StatusText(http.StatusInternalServerError), message))
+// Unauthorized responds with a 401 Unauthorized error.
+// 인증 실패: 요청된 리소스에 대한 유효한 인증 자격 증명이 부족합니다.
+func Unauthorized(w http.ResponseWriter, r *http.Request, opts ...Option) {
+	err := UnauthorizedError(opts...)
+	Respond(w, r, err)
 }
 
-// --- Helper Functions ---
+// UnauthorizedError creates the HttpError struct for 401 Unauthorized.
+// UnauthorizedError는 401 Unauthorized HttpError를 생성합니다.
+func UnauthorizedError(opts ...Option) *HttpError {
+	return newWithOptions(http.StatusUnauthorized, opts)
+}
 
-// BadRequest responds with a 400 Bad Request error.
-// 잘못된 요청: 서버가 요청의 구문을 인식하지 못했습니다.
-func BadRequest(w http.ResponseWriter, r *http.Request, message ...string) {
-	err := New(http.StatusBadRequest, joinMessages(http.StatusText(http.StatusBadRequest), message))
+// Unauthorizedf responds with a 401 Unauthorized error, formatting the message according to a format specifier.
+// Unauthorizedf는 형식 지정자에 따라 메시지를 구성하여 401 Unauthorized 오류로 응답합니다.
+func Unauthorizedf(w http.ResponseWriter, r *http.Request, format string, args ...any) {
+	err := UnauthorizedError(fmt.Sprintf(format, args...))
 	Respond(w, r, err)
 }
 
-// Unauthorized responds with a 401 Unauthorized error.
-// 인증 실패: 요청된 리소스에 대한 유효한 인증 자격 증명이 부족합니다.
-func Unauthorized(w http.ResponseWriter, r *http.Request, message ...string) {
-	err := New(http.StatusUnauthorized, joinMessages(http.StatusText(http.StatusUnauthorized), message))
-	Respond(w, r, err)
+// UnauthorizedHandler returns an http.Handler that responds with a 401 Unauthorized error.
+// UnauthorizedHandler는 401 Unauthorized 오류로 응답하는 http.Handler를 반환합니다.
+func UnauthorizedHandler(opts ...Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		Unauthorized(w, r, opts...)
+	})
 }
 
 // PaymentRequired responds with a 402 Payment Required error.
 // 결제 필요: 요청을 완료하려면 결제가 필요합니다.
-func PaymentRequired(w http.ResponseWriter, r *http.Request, message ...string) {
-	err := New(http.StatusPaymentRequired, joinMessages(http.StatusText(http.StatusPaymentRequired), message))
+func PaymentRequired(w http.ResponseWriter, r *http.Request, opts ...Option) {
+	err := PaymentRequiredError(opts...)
 	Respond(w, r, err)
 }
 
+// PaymentRequiredError creates the HttpError struct for 402 Payment Required.
+// PaymentRequiredError는 402 Payment Required HttpError를 생성합니다.
+func PaymentRequiredError(opts ...Option) *HttpError {
+	return newWithOptions(http.StatusPaymentRequired, opts)
+}
+
+// PaymentRequiredf responds with a 402 Payment Required error, formatting the message according to a format specifier.
+// PaymentRequiredf는 형식 지정자에 따라 메시지를 구성하여 402 Payment Required 오류로 응답합니다.
+func PaymentRequiredf(w http.ResponseWriter, r *http.Request, format string, args ...any) {
+	err := PaymentRequiredError(fmt.Sprintf(format, args...))
+	Respond(w, r, err)
+}
+
+// PaymentRequiredHandler returns an http.Handler that responds with a 402 Payment Required error.
+// PaymentRequiredHandler는 402 Payment Required 오류로 응답하는 http.Handler를 반환합니다.
+func PaymentRequiredHandler(opts ...Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		PaymentRequired(w, r, opts...)
+	})
+}
+
 // Forbidden responds with a 403 Forbidden error.
 // 접근 금지: 서버가 요청을 이해했지만 승인을 거부했습니다.
-func Forbidden(w http.ResponseWriter, r *http.Request, message ...string) {
-	err := New(http.StatusForbidden, joinMessages(http.StatusText(http.StatusForbidden), message))
+func Forbidden(w http.ResponseWriter, r *http.Request, opts ...Option) {
+	err := ForbiddenError(opts...)
 	Respond(w, r, err)
 }
 
+// ForbiddenError creates the HttpError struct for 403 Forbidden.
+// ForbiddenError는 403 Forbidden HttpError를 생성합니다.
+func ForbiddenError(opts ...Option) *HttpError {
+	return newWithOptions(http.StatusForbidden, opts)
+}
+
+// Forbiddenf responds with a 403 Forbidden error, formatting the message according to a format specifier.
+// Forbiddenf는 형식 지정자에 따라 메시지를 구성하여 403 Forbidden 오류로 응답합니다.
+func Forbiddenf(w http.ResponseWriter, r *http.Request, format string, args ...any) {
+	err := ForbiddenError(fmt.Sprintf(format, args...))
+	Respond(w, r, err)
+}
+
+// ForbiddenHandler returns an http.Handler that responds with a 403 Forbidden error.
+// ForbiddenHandler는 403 Forbidden 오류로 응답하는 http.Handler를 반환합니다.
+func ForbiddenHandler(opts ...Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		Forbidden(w, r, opts...)
+	})
+}
+
 // NotFound responds with a 404 Not Found error.
 // 찾을 수 없음: 서버가 요청한 리소스를 찾을 수 없습니다.
-func NotFound(w http.ResponseWriter, r *http.Request, message ...string) {
-	err := New(http.StatusNotFound, joinMessages(http.StatusText(http.StatusNotFound), message))
+func NotFound(w http.ResponseWriter, r *http.Request, opts ...Option) {
+	err := NotFoundError(opts...)
+	Respond(w, r, err)
+}
+
+// NotFoundError creates the HttpError struct for 404 Not Found.
+// NotFoundError는 404 Not Found HttpError를 생성합니다.
+func NotFoundError(opts ...Option) *HttpError {
+	return newWithOptions(http.StatusNotFound, opts)
+}
+
+// NotFoundf responds with a 404 Not Found error, formatting the message according to a format specifier.
+// NotFoundf는 형식 지정자에 따라 메시지를 구성하여 404 Not Found 오류로 응답합니다.
+func NotFoundf(w http.ResponseWriter, r *http.Request, format string, args ...any) {
+	err := NotFoundError(fmt.Sprintf(format, args...))
 	Respond(w, r, err)
 }
 
+// NotFoundHandler returns an http.Handler that responds with a 404 Not Found error.
+// NotFoundHandler는 404 Not Found 오류로 응답하는 http.Handler를 반환합니다.
+func NotFoundHandler(opts ...Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		NotFound(w, r, opts...)
+	})
+}
+
 // MethodNotAllowed responds with a 405 Method Not Allowed error.
 // 허용되지 않은 메소드: 요청한 리소스에 대해 요청한 메소드가 허용되지 않습니다.
-func MethodNotAllowed(w http.ResponseWriter, r *http.Request, message ...string) {
-	err := New(http.StatusMethodNotAllowed, joinMessages(http.StatusText(http.StatusMethodNotAllowed), message))
+func MethodNotAllowed(w http.ResponseWriter, r *http.Request, opts ...Option) {
+	err := MethodNotAllowedError(opts...)
+	Respond(w, r, err)
+}
+
+// MethodNotAllowedError creates the HttpError struct for 405 Method Not Allowed.
+// MethodNotAllowedError는 405 Method Not Allowed HttpError를 생성합니다.
+func MethodNotAllowedError(opts ...Option) *HttpError {
+	return newWithOptions(http.StatusMethodNotAllowed, opts)
+}
+
+// MethodNotAllowedf responds with a 405 Method Not Allowed error, formatting the message according to a format specifier.
+// MethodNotAllowedf는 형식 지정자에 따라 메시지를 구성하여 405 Method Not Allowed 오류로 응답합니다.
+func MethodNotAllowedf(w http.ResponseWriter, r *http.Request, format string, args ...any) {
+	err := MethodNotAllowedError(fmt.Sprintf(format, args...))
 	Respond(w, r, err)
 }
 
+// MethodNotAllowedHandler returns an http.Handler that responds with a 405 Method Not Allowed error.
+// MethodNotAllowedHandler는 405 Method Not Allowed 오류로 응답하는 http.Handler를 반환합니다.
+func MethodNotAllowedHandler(opts ...Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		MethodNotAllowed(w, r, opts...)
+	})
+}
+
 // NotAcceptable responds with a 406 Not Acceptable error.
 // 수용할 수 없음: 서버가 요청의 Accept 헤더에 따라 수용할 수 없는 응답을 생성할 수 없습니다.
-func NotAcceptable(w http.ResponseWriter, r *http.Request, message ...string) {
-	err := New(http.StatusNotAcceptable, joinMessages(http.StatusText(http.StatusNotAcceptable), message))
+func NotAcceptable(w http.ResponseWriter, r *http.Request, opts ...Option) {
+	err := NotAcceptableError(opts...)
 	Respond(w, r, err)
 }
 
+// NotAcceptableError creates the HttpError struct for 406 Not Acceptable.
+// NotAcceptableError는 406 Not Acceptable HttpError를 생성합니다.
+func NotAcceptableError(opts ...Option) *HttpError {
+	return newWithOptions(http.StatusNotAcceptable, opts)
+}
+
+// NotAcceptablef responds with a 406 Not Acceptable error, formatting the message according to a format specifier.
+// NotAcceptablef는 형식 지정자에 따라 메시지를 구성하여 406 Not Acceptable 오류로 응답합니다.
+func NotAcceptablef(w http.ResponseWriter, r *http.Request, format string, args ...any) {
+	err := NotAcceptableError(fmt.Sprintf(format, args...))
+	Respond(w, r, err)
+}
+
+// NotAcceptableHandler returns an http.Handler that responds with a 406 Not Acceptable error.
+// NotAcceptableHandler는 406 Not Acceptable 오류로 응답하는 http.Handler를 반환합니다.
+func NotAcceptableHandler(opts ...Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		NotAcceptable(w, r, opts...)
+	})
+}
+
 // ProxyAuthRequired responds with a 407 Proxy Authentication Required error.
 // 프록시 인증 필요: 프록시를 통해 인증해야 합니다.
-func ProxyAuthRequired(w http.ResponseWriter, r *http.Request, message ...string) {
-	err := New(http.StatusProxyAuthRequired, joinMessages(http.StatusText(http.StatusProxyAuthRequired), message))
+func ProxyAuthRequired(w http.ResponseWriter, r *http.Request, opts ...Option) {
+	err := ProxyAuthRequiredError(opts...)
 	Respond(w, r, err)
 }
 
+// ProxyAuthRequiredError creates the HttpError struct for 407 Proxy Authentication Required.
+// ProxyAuthRequiredError는 407 Proxy Authentication Required HttpError를 생성합니다.
+func ProxyAuthRequiredError(opts ...Option) *HttpError {
+	return newWithOptions(http.StatusProxyAuthRequired, opts)
+}
+
+// ProxyAuthRequiredf responds with a 407 Proxy Authentication Required error, formatting the message according to a format specifier.
+// ProxyAuthRequiredf는 형식 지정자에 따라 메시지를 구성하여 407 Proxy Authentication Required 오류로 응답합니다.
+func ProxyAuthRequiredf(w http.ResponseWriter, r *http.Request, format string, args ...any) {
+	err := ProxyAuthRequiredError(fmt.Sprintf(format, args...))
+	Respond(w, r, err)
+}
+
+// ProxyAuthRequiredHandler returns an http.Handler that responds with a 407 Proxy Authentication Required error.
+// ProxyAuthRequiredHandler는 407 Proxy Authentication Required 오류로 응답하는 http.Handler를 반환합니다.
+func ProxyAuthRequiredHandler(opts ...Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ProxyAuthRequired(w, r, opts...)
+	})
+}
+
 // RequestTimeout responds with a 408 Request Timeout error.
 // 요청 시간 초과: 서버가 요청을 기다리는 동안 시간이 초과되었습니다.
-func RequestTimeout(w http.ResponseWriter, r *http.Request, message ...string) {
-	err := New(http.StatusRequestTimeout, joinMessages(http.StatusText(http.StatusRequestTimeout), message))
+func RequestTimeout(w http.ResponseWriter, r *http.Request, opts ...Option) {
+	err := RequestTimeoutError(opts...)
+	Respond(w, r, err)
+}
+
+// RequestTimeoutError creates the HttpError struct for 408 Request Timeout.
+// RequestTimeoutError는 408 Request Timeout HttpError를 생성합니다.
+func RequestTimeoutError(opts ...Option) *HttpError {
+	return newWithOptions(http.StatusRequestTimeout, opts)
+}
+
+// RequestTimeoutf responds with a 408 Request Timeout error, formatting the message according to a format specifier.
+// RequestTimeoutf는 형식 지정자에 따라 메시지를 구성하여 408 Request Timeout 오류로 응답합니다.
+func RequestTimeoutf(w http.ResponseWriter, r *http.Request, format string, args ...any) {
+	err := RequestTimeoutError(fmt.Sprintf(format, args...))
 	Respond(w, r, err)
 }
 
+// RequestTimeoutHandler returns an http.Handler that responds with a 408 Request Timeout error.
+// RequestTimeoutHandler는 408 Request Timeout 오류로 응답하는 http.Handler를 반환합니다.
+func RequestTimeoutHandler(opts ...Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		RequestTimeout(w, r, opts...)
+	})
+}
+
 // Conflict responds with a 409 Conflict error.
 // 충돌: 요청이 리소스의 현재 상태와 충돌하여 완료될 수 없습니다.
-func Conflict(w http.ResponseWriter, r *http.Request, message ...string) {
-	err := New(http.StatusConflict, joinMessages(http.StatusText(http.StatusConflict), message))
+func Conflict(w http.ResponseWriter, r *http.Request, opts ...Option) {
+	err := ConflictError(opts...)
 	Respond(w, r, err)
 }
 
+// ConflictError creates the HttpError struct for 409 Conflict.
+// ConflictError는 409 Conflict HttpError를 생성합니다.
+func ConflictError(opts ...Option) *HttpError {
+	return newWithOptions(http.StatusConflict, opts)
+}
+
+// Conflictf responds with a 409 Conflict error, formatting the message according to a format specifier.
+// Conflictf는 형식 지정자에 따라 메시지를 구성하여 409 Conflict 오류로 응답합니다.
+func Conflictf(w http.ResponseWriter, r *http.Request, format string, args ...any) {
+	err := ConflictError(fmt.Sprintf(format, args...))
+	Respond(w, r, err)
+}
+
+// ConflictHandler returns an http.Handler that responds with a 409 Conflict error.
+// ConflictHandler는 409 Conflict 오류로 응답하는 http.Handler를 반환합니다.
+func ConflictHandler(opts ...Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		Conflict(w, r, opts...)
+	})
+}
+
 // Gone responds with a 410 Gone error.
 // 사라짐: 요청한 리소스가 영구적으로 삭제되었습니다.
-func Gone(w http.ResponseWriter, r *http.Request, message ...string) {
-	err := New(http.StatusGone, joinMessages(http.StatusText(http.StatusGone), message))
+func Gone(w http.ResponseWriter, r *http.Request, opts ...Option) {
+	err := GoneError(opts...)
 	Respond(w, r, err)
 }
 
+// GoneError creates the HttpError struct for 410 Gone.
+// GoneError는 410 Gone HttpError를 생성합니다.
+func GoneError(opts ...Option) *HttpError {
+	return newWithOptions(http.StatusGone, opts)
+}
+
+// Gonef responds with a 410 Gone error, formatting the message according to a format specifier.
+// Gonef는 형식 지정자에 따라 메시지를 구성하여 410 Gone 오류로 응답합니다.
+func Gonef(w http.ResponseWriter, r *http.Request, format string, args ...any) {
+	err := GoneError(fmt.Sprintf(format, args...))
+	Respond(w, r, err)
+}
+
+// GoneHandler returns an http.Handler that responds with a 410 Gone error.
+// GoneHandler는 410 Gone 오류로 응답하는 http.Handler를 반환합니다.
+func GoneHandler(opts ...Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		Gone(w, r, opts...)
+	})
+}
+
 // LengthRequired responds with a 411 Length Required error.
 // 길이 필요: Content-Length 헤더 없이 요청이 거부되었습니다.
-func LengthRequired(w http.ResponseWriter, r *http.Request, message ...string) {
-	err := New(http.StatusLengthRequired, joinMessages(http.StatusText(http.StatusLengthRequired), message))
+func LengthRequired(w http.ResponseWriter, r *http.Request, opts ...Option) {
+	err := LengthRequiredError(opts...)
 	Respond(w, r, err)
 }
 
+// LengthRequiredError creates the HttpError struct for 411 Length Required.
+// LengthRequiredError는 411 Length Required HttpError를 생성합니다.
+func LengthRequiredError(opts ...Option) *HttpError {
+	return newWithOptions(http.StatusLengthRequired, opts)
+}
+
+// LengthRequiredf responds with a 411 Length Required error, formatting the message according to a format specifier.
+// LengthRequiredf는 형식 지정자에 따라 메시지를 구성하여 411 Length Required 오류로 응답합니다.
+func LengthRequiredf(w http.ResponseWriter, r *http.Request, format string, args ...any) {
+	err := LengthRequiredError(fmt.Sprintf(format, args...))
+	Respond(w, r, err)
+}
+
+// LengthRequiredHandler returns an http.Handler that responds with a 411 Length Required error.
+// LengthRequiredHandler는 411 Length Required 오류로 응답하는 http.Handler를 반환합니다.
+func LengthRequiredHandler(opts ...Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		LengthRequired(w, r, opts...)
+	})
+}
+
 // PreconditionFailed responds with a 412 Precondition Failed error.
 // 사전 조건 실패: 서버가 요청자가 요청에 지정한 사전 조건 중 하나를 충족하지 못했습니다.
-func PreconditionFailed(w http.ResponseWriter, r *http.Request, message ...string) {
-	err := New(http.StatusPreconditionFailed, joinMessages(http.StatusText(http.StatusPreconditionFailed), message))
+func PreconditionFailed(w http.ResponseWriter, r *http.Request, opts ...Option) {
+	err := PreconditionFailedError(opts...)
+	Respond(w, r, err)
+}
+
+// PreconditionFailedError creates the HttpError struct for 412 Precondition Failed.
+// PreconditionFailedError는 412 Precondition Failed HttpError를 생성합니다.
+func PreconditionFailedError(opts ...Option) *HttpError {
+	return newWithOptions(http.StatusPreconditionFailed, opts)
+}
+
+// PreconditionFailedf responds with a 412 Precondition Failed error, formatting the message according to a format specifier.
+// PreconditionFailedf는 형식 지정자에 따라 메시지를 구성하여 412 Precondition Failed 오류로 응답합니다.
+func PreconditionFailedf(w http.ResponseWriter, r *http.Request, format string, args ...any) {
+	err := PreconditionFailedError(fmt.Sprintf(format, args...))
 	Respond(w, r, err)
 }
 
+// PreconditionFailedHandler returns an http.Handler that responds with a 412 Precondition Failed error.
+// PreconditionFailedHandler는 412 Precondition Failed 오류로 응답하는 http.Handler를 반환합니다.
+func PreconditionFailedHandler(opts ...Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		PreconditionFailed(w, r, opts...)
+	})
+}
+
 // PayloadTooLarge responds with a 413 Payload Too Large error.
 // 페이로드 너무 큼: 요청 페이로드가 서버가 처리할 수 있는 한도보다 큽니다.
-func PayloadTooLarge(w http.ResponseWriter, r *http.Request, message ...string) {
-	err := New(http.StatusRequestEntityTooLarge, joinMessages(http.StatusText(http.StatusRequestEntityTooLarge), message))
+func PayloadTooLarge(w http.ResponseWriter, r *http.Request, opts ...Option) {
+	err := PayloadTooLargeError(opts...)
+	Respond(w, r, err)
+}
+
+// PayloadTooLargeError creates the HttpError struct for 413 Payload Too Large.
+// PayloadTooLargeError는 413 Payload Too Large HttpError를 생성합니다.
+func PayloadTooLargeError(opts ...Option) *HttpError {
+	return newWithOptions(http.StatusRequestEntityTooLarge, opts)
+}
+
+// PayloadTooLargef responds with a 413 Payload Too Large error, formatting the message according to a format specifier.
+// PayloadTooLargef는 형식 지정자에 따라 메시지를 구성하여 413 Payload Too Large 오류로 응답합니다.
+func PayloadTooLargef(w http.ResponseWriter, r *http.Request, format string, args ...any) {
+	err := PayloadTooLargeError(fmt.Sprintf(format, args...))
 	Respond(w, r, err)
 }
 
+// PayloadTooLargeHandler returns an http.Handler that responds with a 413 Payload Too Large error.
+// PayloadTooLargeHandler는 413 Payload Too Large 오류로 응답하는 http.Handler를 반환합니다.
+func PayloadTooLargeHandler(opts ...Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		PayloadTooLarge(w, r, opts...)
+	})
+}
+
 // URITooLong responds with a 414 URI Too Long error.
 // URI 너무 긺: 클라이언트가 요청한 URI가 서버가 해석할 수 있는 것보다 깁니다.
-func URITooLong(w http.ResponseWriter, r *http.Request, message ...string) {
-	err := New(http.StatusRequestURITooLong, joinMessages(http.StatusText(http.StatusRequestURITooLong), message))
+func URITooLong(w http.ResponseWriter, r *http.Request, opts ...Option) {
+	err := URITooLongError(opts...)
 	Respond(w, r, err)
 }
 
+// URITooLongError creates the HttpError struct for 414 URI Too Long.
+// URITooLongError는 414 URI Too Long HttpError를 생성합니다.
+func URITooLongError(opts ...Option) *HttpError {
+	return newWithOptions(http.StatusRequestURITooLong, opts)
+}
+
+// URITooLongf responds with a 414 URI Too Long error, formatting the message according to a format specifier.
+// URITooLongf는 형식 지정자에 따라 메시지를 구성하여 414 URI Too Long 오류로 응답합니다.
+func URITooLongf(w http.ResponseWriter, r *http.Request, format string, args ...any) {
+	err := URITooLongError(fmt.Sprintf(format, args...))
+	Respond(w, r, err)
+}
+
+// URITooLongHandler returns an http.Handler that responds with a 414 URI Too Long error.
+// URITooLongHandler는 414 URI Too Long 오류로 응답하는 http.Handler를 반환합니다.
+func URITooLongHandler(opts ...Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		URITooLong(w, r, opts...)
+	})
+}
+
 // UnsupportedMediaType responds with a 415 Unsupported Media Type error.
 // 지원되지 않는 미디어 유형: 서버가 요청 페이로드의 미디어 형식을 지원하지 않습니다.
-func UnsupportedMediaType(w http.ResponseWriter, r *http.Request, message ...string) {
-	err := New(http.StatusUnsupportedMediaType, joinMessages(http.StatusText(http.StatusUnsupportedMediaType), message))
+func UnsupportedMediaType(w http.ResponseWriter, r *http.Request, opts ...Option) {
+	err := UnsupportedMediaTypeError(opts...)
 	Respond(w, r, err)
 }
 
+// UnsupportedMediaTypeError creates the HttpError struct for 415 Unsupported Media Type.
+// UnsupportedMediaTypeError는 415 Unsupported Media Type HttpError를 생성합니다.
+func UnsupportedMediaTypeError(opts ...Option) *HttpError {
+	return newWithOptions(http.StatusUnsupportedMediaType, opts)
+}
+
+// UnsupportedMediaTypef responds with a 415 Unsupported Media Type error, formatting the message according to a format specifier.
+// UnsupportedMediaTypef는 형식 지정자에 따라 메시지를 구성하여 415 Unsupported Media Type 오류로 응답합니다.
+func UnsupportedMediaTypef(w http.ResponseWriter, r *http.Request, format string, args ...any) {
+	err := UnsupportedMediaTypeError(fmt.Sprintf(format, args...))
+	Respond(w, r, err)
+}
+
+// UnsupportedMediaTypeHandler returns an http.Handler that responds with a 415 Unsupported Media Type error.
+// UnsupportedMediaTypeHandler는 415 Unsupported Media Type 오류로 응답하는 http.Handler를 반환합니다.
+func UnsupportedMediaTypeHandler(opts ...Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		UnsupportedMediaType(w, r, opts...)
+	})
+}
+
 // RangeNotSatisfiable responds with a 416 Range Not Satisfiable error.
 // 범위 만족할 수 없음: 요청의 Range 헤더 필드에 지정된 범위를 충족할 수 없습니다.
-func RangeNotSatisfiable(w http.ResponseWriter, r *http.Request, message ...string) {
-	err := New(http.StatusRequestedRangeNotSatisfiable, joinMessages(http.StatusText(http.StatusRequestedRangeNotSatisfiable), message))
+func RangeNotSatisfiable(w http.ResponseWriter, r *http.Request, opts ...Option) {
+	err := RangeNotSatisfiableError(opts...)
+	Respond(w, r, err)
+}
+
+// RangeNotSatisfiableError creates the HttpError struct for 416 Range Not Satisfiable.
+// RangeNotSatisfiableError는 416 Range Not Satisfiable HttpError를 생성합니다.
+func RangeNotSatisfiableError(opts ...Option) *HttpError {
+	return newWithOptions(http.StatusRequestedRangeNotSatisfiable, opts)
+}
+
+// RangeNotSatisfiablef responds with a 416 Range Not Satisfiable error, formatting the message according to a format specifier.
+// RangeNotSatisfiablef는 형식 지정자에 따라 메시지를 구성하여 416 Range Not Satisfiable 오류로 응답합니다.
+func RangeNotSatisfiablef(w http.ResponseWriter, r *http.Request, format string, args ...any) {
+	err := RangeNotSatisfiableError(fmt.Sprintf(format, args...))
 	Respond(w, r, err)
 }
 
+// RangeNotSatisfiableHandler returns an http.Handler that responds with a 416 Range Not Satisfiable error.
+// RangeNotSatisfiableHandler는 416 Range Not Satisfiable 오류로 응답하는 http.Handler를 반환합니다.
+func RangeNotSatisfiableHandler(opts ...Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		RangeNotSatisfiable(w, r, opts...)
+	})
+}
+
 // ExpectationFailed responds with a 417 Expectation Failed error.
 // 기대 실패: Expect 요청 헤더 필드에 지정된 기대를 충족할 수 없습니다.
-func ExpectationFailed(w http.ResponseWriter, r *http.Request, message ...string) {
-	err := New(http.StatusExpectationFailed, joinMessages(http.StatusText(http.StatusExpectationFailed), message))
+func ExpectationFailed(w http.ResponseWriter, r *http.Request, opts ...Option) {
+	err := ExpectationFailedError(opts...)
 	Respond(w, r, err)
 }
 
+// ExpectationFailedError creates the HttpError struct for 417 Expectation Failed.
+// ExpectationFailedError는 417 Expectation Failed HttpError를 생성합니다.
+func ExpectationFailedError(opts ...Option) *HttpError {
+	return newWithOptions(http.StatusExpectationFailed, opts)
+}
+
+// ExpectationFailedf responds with a 417 Expectation Failed error, formatting the message according to a format specifier.
+// ExpectationFailedf는 형식 지정자에 따라 메시지를 구성하여 417 Expectation Failed 오류로 응답합니다.
+func ExpectationFailedf(w http.ResponseWriter, r *http.Request, format string, args ...any) {
+	err := ExpectationFailedError(fmt.Sprintf(format, args...))
+	Respond(w, r, err)
+}
+
+// ExpectationFailedHandler returns an http.Handler that responds with a 417 Expectation Failed error.
+// ExpectationFailedHandler는 417 Expectation Failed 오류로 응답하는 http.Handler를 반환합니다.
+func ExpectationFailedHandler(opts ...Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ExpectationFailed(w, r, opts...)
+	})
+}
+
 // Teapot responds with a 418 I'm a teapot error.
 // 나는 찻주전자: 나는 찻주전자입니다.
-func Teapot(w http.ResponseWriter, r *http.Request, message ...string) {
-	err := New(http.StatusTeapot, joinMessages(http.StatusText(http.StatusTeapot), message))
+func Teapot(w http.ResponseWriter, r *http.Request, opts ...Option) {
+	err := TeapotError(opts...)
 	Respond(w, r, err)
 }
 
+// TeapotError creates the HttpError struct for 418 I'm a teapot.
+// TeapotError는 418 I'm a teapot HttpError를 생성합니다.
+func TeapotError(opts ...Option) *HttpError {
+	return newWithOptions(http.StatusTeapot, opts)
+}
+
+// Teapotf responds with a 418 I'm a teapot error, formatting the message according to a format specifier.
+// Teapotf는 형식 지정자에 따라 메시지를 구성하여 418 I'm a teapot 오류로 응답합니다.
+func Teapotf(w http.ResponseWriter, r *http.Request, format string, args ...any) {
+	err := TeapotError(fmt.Sprintf(format, args...))
+	Respond(w, r, err)
+}
+
+// TeapotHandler returns an http.Handler that responds with a 418 I'm a teapot error.
+// TeapotHandler는 418 I'm a teapot 오류로 응답하는 http.Handler를 반환합니다.
+func TeapotHandler(opts ...Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		Teapot(w, r, opts...)
+	})
+}
+
 // MisdirectedRequest responds with a 421 Misdirected Request error.
-// 잘못된 요청: 요청이 응답을 생성할 수 없는 서버로 전달되었습니다.
-func MisdirectedRequest(w http.ResponseWriter, r *http.Request, message ...string) {
-	err := New(http.StatusMisdirectedRequest, joinMessages(http.StatusText(http.StatusMisdirectedRequest), message))
+// 잘못 전달된 요청: 요청이 응답을 생성할 수 없는 서버로 전달되었습니다.
+func MisdirectedRequest(w http.ResponseWriter, r *http.Request, opts ...Option) {
+	err := MisdirectedRequestError(opts...)
+	Respond(w, r, err)
+}
+
+// MisdirectedRequestError creates the HttpError struct for 421 Misdirected Request.
+// MisdirectedRequestError는 421 Misdirected Request HttpError를 생성합니다.
+func MisdirectedRequestError(opts ...Option) *HttpError {
+	return newWithOptions(http.StatusMisdirectedRequest, opts)
+}
+
+// MisdirectedRequestf responds with a 421 Misdirected Request error, formatting the message according to a format specifier.
+// MisdirectedRequestf는 형식 지정자에 따라 메시지를 구성하여 421 Misdirected Request 오류로 응답합니다.
+func MisdirectedRequestf(w http.ResponseWriter, r *http.Request, format string, args ...any) {
+	err := MisdirectedRequestError(fmt.Sprintf(format, args...))
 	Respond(w, r, err)
 }
 
+// MisdirectedRequestHandler returns an http.Handler that responds with a 421 Misdirected Request error.
+// MisdirectedRequestHandler는 421 Misdirected Request 오류로 응답하는 http.Handler를 반환합니다.
+func MisdirectedRequestHandler(opts ...Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		MisdirectedRequest(w, r, opts...)
+	})
+}
+
 // UnprocessableEntity responds with a 422 Unprocessable Entity error.
 // 처리할 수 없는 엔티티: 서버가 요청을 이해했지만, 의미론적 오류로 인해 처리할 수 없습니다.
-func UnprocessableEntity(w http.ResponseWriter, r *http.Request, message ...string) {
-	err := New(http.StatusUnprocessableEntity, joinMessages(http.StatusText(http.StatusUnprocessableEntity), message))
+func UnprocessableEntity(w http.ResponseWriter, r *http.Request, opts ...Option) {
+	err := UnprocessableEntityError(opts...)
+	Respond(w, r, err)
+}
+
+// UnprocessableEntityError creates the HttpError struct for 422 Unprocessable Entity.
+// UnprocessableEntityError는 422 Unprocessable Entity HttpError를 생성합니다.
+func UnprocessableEntityError(opts ...Option) *HttpError {
+	return newWithOptions(http.StatusUnprocessableEntity, opts)
+}
+
+// UnprocessableEntityf responds with a 422 Unprocessable Entity error, formatting the message according to a format specifier.
+// UnprocessableEntityf는 형식 지정자에 따라 메시지를 구성하여 422 Unprocessable Entity 오류로 응답합니다.
+func UnprocessableEntityf(w http.ResponseWriter, r *http.Request, format string, args ...any) {
+	err := UnprocessableEntityError(fmt.Sprintf(format, args...))
 	Respond(w, r, err)
 }
 
+// UnprocessableEntityHandler returns an http.Handler that responds with a 422 Unprocessable Entity error.
+// UnprocessableEntityHandler는 422 Unprocessable Entity 오류로 응답하는 http.Handler를 반환합니다.
+func UnprocessableEntityHandler(opts ...Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		UnprocessableEntity(w, r, opts...)
+	})
+}
+
 // Locked responds with a 423 Locked error.
 // 잠김: 접근하려는 리소스가 잠겨 있습니다.
-func Locked(w http.ResponseWriter, r *http.Request, message ...string) {
-	err := New(http.StatusLocked, joinMessages(http.StatusText(http.StatusLocked), message))
+func Locked(w http.ResponseWriter, r *http.Request, opts ...Option) {
+	err := LockedError(opts...)
+	Respond(w, r, err)
+}
+
+// LockedError creates the HttpError struct for 423 Locked.
+// LockedError는 423 Locked HttpError를 생성합니다.
+func LockedError(opts ...Option) *HttpError {
+	return newWithOptions(http.StatusLocked, opts)
+}
+
+// Lockedf responds with a 423 Locked error, formatting the message according to a format specifier.
+// Lockedf는 형식 지정자에 따라 메시지를 구성하여 423 Locked 오류로 응답합니다.
+func Lockedf(w http.ResponseWriter, r *http.Request, format string, args ...any) {
+	err := LockedError(fmt.Sprintf(format, args...))
 	Respond(w, r, err)
 }
 
+// LockedHandler returns an http.Handler that responds with a 423 Locked error.
+// LockedHandler는 423 Locked 오류로 응답하는 http.Handler를 반환합니다.
+func LockedHandler(opts ...Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		Locked(w, r, opts...)
+	})
+}
+
 // FailedDependency responds with a 424 Failed Dependency error.
 // 실패한 종속성: 이전 요청이 실패했기 때문에 현재 요청이 실패했습니다.
-func FailedDependency(w http.ResponseWriter, r *http.Request, message ...string) {
-	err := New(http.StatusFailedDependency, joinMessages(http.StatusText(http.StatusFailedDependency), message))
+func FailedDependency(w http.ResponseWriter, r *http.Request, opts ...Option) {
+	err := FailedDependencyError(opts...)
 	Respond(w, r, err)
 }
 
+// FailedDependencyError creates the HttpError struct for 424 Failed Dependency.
+// FailedDependencyError는 424 Failed Dependency HttpError를 생성합니다.
+func FailedDependencyError(opts ...Option) *HttpError {
+	return newWithOptions(http.StatusFailedDependency, opts)
+}
+
+// FailedDependencyf responds with a 424 Failed Dependency error, formatting the message according to a format specifier.
+// FailedDependencyf는 형식 지정자에 따라 메시지를 구성하여 424 Failed Dependency 오류로 응답합니다.
+func FailedDependencyf(w http.ResponseWriter, r *http.Request, format string, args ...any) {
+	err := FailedDependencyError(fmt.Sprintf(format, args...))
+	Respond(w, r, err)
+}
+
+// FailedDependencyHandler returns an http.Handler that responds with a 424 Failed Dependency error.
+// FailedDependencyHandler는 424 Failed Dependency 오류로 응답하는 http.Handler를 반환합니다.
+func FailedDependencyHandler(opts ...Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		FailedDependency(w, r, opts...)
+	})
+}
+
 // TooEarly responds with a 425 Too Early error.
 // 너무 이름: 서버가 아직 처리 준비가 되지 않은 요청을 처리하려고 시도했습니다.
-func TooEarly(w http.ResponseWriter, r *http.Request, message ...string) {
-	err := New(http.StatusTooEarly, joinMessages(http.StatusText(http.StatusTooEarly), message))
+func TooEarly(w http.ResponseWriter, r *http.Request, opts ...Option) {
+	err := TooEarlyError(opts...)
+	Respond(w, r, err)
+}
+
+// TooEarlyError creates the HttpError struct for 425 Too Early.
+// TooEarlyError는 425 Too Early HttpError를 생성합니다.
+func TooEarlyError(opts ...Option) *HttpError {
+	return newWithOptions(http.StatusTooEarly, opts)
+}
+
+// TooEarlyf responds with a 425 Too Early error, formatting the message according to a format specifier.
+// TooEarlyf는 형식 지정자에 따라 메시지를 구성하여 425 Too Early 오류로 응답합니다.
+func TooEarlyf(w http.ResponseWriter, r *http.Request, format string, args ...any) {
+	err := TooEarlyError(fmt.Sprintf(format, args...))
 	Respond(w, r, err)
 }
 
+// TooEarlyHandler returns an http.Handler that responds with a 425 Too Early error.
+// TooEarlyHandler는 425 Too Early 오류로 응답하는 http.Handler를 반환합니다.
+func TooEarlyHandler(opts ...Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		TooEarly(w, r, opts...)
+	})
+}
+
 // UpgradeRequired responds with a 426 Upgrade Required error.
 // 업그레이드 필요: 클라이언트는 다른 프로토콜로 업그레이드해야 합니다.
-func UpgradeRequired(w http.ResponseWriter, r *http.Request, message ...string) {
-	err := New(http.StatusUpgradeRequired, joinMessages(http.StatusText(http.StatusUpgradeRequired), message))
+func UpgradeRequired(w http.ResponseWriter, r *http.Request, opts ...Option) {
+	err := UpgradeRequiredError(opts...)
+	Respond(w, r, err)
+}
+
+// UpgradeRequiredError creates the HttpError struct for 426 Upgrade Required.
+// UpgradeRequiredError는 426 Upgrade Required HttpError를 생성합니다.
+func UpgradeRequiredError(opts ...Option) *HttpError {
+	return newWithOptions(http.StatusUpgradeRequired, opts)
+}
+
+// UpgradeRequiredf responds with a 426 Upgrade Required error, formatting the message according to a format specifier.
+// UpgradeRequiredf는 형식 지정자에 따라 메시지를 구성하여 426 Upgrade Required 오류로 응답합니다.
+func UpgradeRequiredf(w http.ResponseWriter, r *http.Request, format string, args ...any) {
+	err := UpgradeRequiredError(fmt.Sprintf(format, args...))
 	Respond(w, r, err)
 }
 
+// UpgradeRequiredHandler returns an http.Handler that responds with a 426 Upgrade Required error.
+// UpgradeRequiredHandler는 426 Upgrade Required 오류로 응답하는 http.Handler를 반환합니다.
+func UpgradeRequiredHandler(opts ...Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		UpgradeRequired(w, r, opts...)
+	})
+}
+
 // PreconditionRequired responds with a 428 Precondition Required error.
 // 사전 조건 필요: 원본 서버는 요청이 조건부여야 함을 요구합니다.
-func PreconditionRequired(w http.ResponseWriter, r *http.Request, message ...string) {
-	err := New(http.StatusPreconditionRequired, joinMessages(http.StatusText(http.StatusPreconditionRequired), message))
+func PreconditionRequired(w http.ResponseWriter, r *http.Request, opts ...Option) {
+	err := PreconditionRequiredError(opts...)
 	Respond(w, r, err)
 }
 
+// PreconditionRequiredError creates the HttpError struct for 428 Precondition Required.
+// PreconditionRequiredError는 428 Precondition Required HttpError를 생성합니다.
+func PreconditionRequiredError(opts ...Option) *HttpError {
+	return newWithOptions(http.StatusPreconditionRequired, opts)
+}
+
+// PreconditionRequiredf responds with a 428 Precondition Required error, formatting the message according to a format specifier.
+// PreconditionRequiredf는 형식 지정자에 따라 메시지를 구성하여 428 Precondition Required 오류로 응답합니다.
+func PreconditionRequiredf(w http.ResponseWriter, r *http.Request, format string, args ...any) {
+	err := PreconditionRequiredError(fmt.Sprintf(format, args...))
+	Respond(w, r, err)
+}
+
+// PreconditionRequiredHandler returns an http.Handler that responds with a 428 Precondition Required error.
+// PreconditionRequiredHandler는 428 Precondition Required 오류로 응답하는 http.Handler를 반환합니다.
+func PreconditionRequiredHandler(opts ...Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		PreconditionRequired(w, r, opts...)
+	})
+}
+
 // TooManyRequests responds with a 429 Too Many Requests error.
 // 너무 많은 요청: 사용자가 지정된 시간 동안 너무 많은 요청을 보냈습니다.
-func TooManyRequests(w http.ResponseWriter, r *http.Request, message ...string) {
-	err := New(http.StatusTooManyRequests, joinMessages(http.StatusText(http.StatusTooManyRequests), message))
+func TooManyRequests(w http.ResponseWriter, r *http.Request, opts ...Option) {
+	err := TooManyRequestsError(opts...)
 	Respond(w, r, err)
 }
 
+// TooManyRequestsError creates the HttpError struct for 429 Too Many Requests.
+// TooManyRequestsError는 429 Too Many Requests HttpError를 생성합니다.
+func TooManyRequestsError(opts ...Option) *HttpError {
+	return newWithOptions(http.StatusTooManyRequests, opts)
+}
+
+// TooManyRequestsf responds with a 429 Too Many Requests error, formatting the message according to a format specifier.
+// TooManyRequestsf는 형식 지정자에 따라 메시지를 구성하여 429 Too Many Requests 오류로 응답합니다.
+func TooManyRequestsf(w http.ResponseWriter, r *http.Request, format string, args ...any) {
+	err := TooManyRequestsError(fmt.Sprintf(format, args...))
+	Respond(w, r, err)
+}
+
+// TooManyRequestsHandler returns an http.Handler that responds with a 429 Too Many Requests error.
+// TooManyRequestsHandler는 429 Too Many Requests 오류로 응답하는 http.Handler를 반환합니다.
+func TooManyRequestsHandler(opts ...Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		TooManyRequests(w, r, opts...)
+	})
+}
+
 // RequestHeaderFieldsTooLarge responds with a 431 Request Header Fields Too Large error.
 // 요청 헤더 필드 너무 큼: 요청 헤더 필드가 너무 커서 서버가 처리할 수 없습니다.
-func RequestHeaderFieldsTooLarge(w http.ResponseWriter, r *http.Request, message ...string) {
-	err := New(http.StatusRequestHeaderFieldsTooLarge, joinMessages(http.StatusText(http.StatusRequestHeaderFieldsTooLarge), message))
+func RequestHeaderFieldsTooLarge(w http.ResponseWriter, r *http.Request, opts ...Option) {
+	err := RequestHeaderFieldsTooLargeError(opts...)
 	Respond(w, r, err)
 }
 
+// RequestHeaderFieldsTooLargeError creates the HttpError struct for 431 Request Header Fields Too Large.
+// RequestHeaderFieldsTooLargeError는 431 Request Header Fields Too Large HttpError를 생성합니다.
+func RequestHeaderFieldsTooLargeError(opts ...Option) *HttpError {
+	return newWithOptions(http.StatusRequestHeaderFieldsTooLarge, opts)
+}
+
+// RequestHeaderFieldsTooLargef responds with a 431 Request Header Fields Too Large error, formatting the message according to a format specifier.
+// RequestHeaderFieldsTooLargef는 형식 지정자에 따라 메시지를 구성하여 431 Request Header Fields Too Large 오류로 응답합니다.
+func RequestHeaderFieldsTooLargef(w http.ResponseWriter, r *http.Request, format string, args ...any) {
+	err := RequestHeaderFieldsTooLargeError(fmt.Sprintf(format, args...))
+	Respond(w, r, err)
+}
+
+// RequestHeaderFieldsTooLargeHandler returns an http.Handler that responds with a 431 Request Header Fields Too Large error.
+// RequestHeaderFieldsTooLargeHandler는 431 Request Header Fields Too Large 오류로 응답하는 http.Handler를 반환합니다.
+func RequestHeaderFieldsTooLargeHandler(opts ...Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		RequestHeaderFieldsTooLarge(w, r, opts...)
+	})
+}
+
 // UnavailableForLegalReasons responds with a 451 Unavailable For Legal Reasons error.
 // 법적 이유로 사용할 수 없음: 법적인 이유로 요청한 리소스에 접근할 수 없습니다.
-func UnavailableForLegalReasons(w http.ResponseWriter, r *http.Request, message ...string) {
-	err := New(http.StatusUnavailableForLegalReasons, joinMessages(http.StatusText(http.StatusUnavailableForLegalReasons), message))
+func UnavailableForLegalReasons(w http.ResponseWriter, r *http.Request, opts ...Option) {
+	err := UnavailableForLegalReasonsError(opts...)
 	Respond(w, r, err)
 }
 
+// UnavailableForLegalReasonsError creates the HttpError struct for 451 Unavailable For Legal Reasons.
+// UnavailableForLegalReasonsError는 451 Unavailable For Legal Reasons HttpError를 생성합니다.
+func UnavailableForLegalReasonsError(opts ...Option) *HttpError {
+	return newWithOptions(http.StatusUnavailableForLegalReasons, opts)
+}
+
+// UnavailableForLegalReasonsf responds with a 451 Unavailable For Legal Reasons error, formatting the message according to a format specifier.
+// UnavailableForLegalReasonsf는 형식 지정자에 따라 메시지를 구성하여 451 Unavailable For Legal Reasons 오류로 응답합니다.
+func UnavailableForLegalReasonsf(w http.ResponseWriter, r *http.Request, format string, args ...any) {
+	err := UnavailableForLegalReasonsError(fmt.Sprintf(format, args...))
+	Respond(w, r, err)
+}
+
+// UnavailableForLegalReasonsHandler returns an http.Handler that responds with a 451 Unavailable For Legal Reasons error.
+// UnavailableForLegalReasonsHandler는 451 Unavailable For Legal Reasons 오류로 응답하는 http.Handler를 반환합니다.
+func UnavailableForLegalReasonsHandler(opts ...Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		UnavailableForLegalReasons(w, r, opts...)
+	})
+}
+
+// ClientClosedRequest responds with a 499 Client Closed Request error.
+// 클라이언트 요청 종료: 서버가 응답하기 전에 클라이언트가 연결을 종료했습니다.
+func ClientClosedRequest(w http.ResponseWriter, r *http.Request, opts ...Option) {
+	err := ClientClosedRequestError(opts...)
+	Respond(w, r, err)
+}
+
+// ClientClosedRequestError creates the HttpError struct for 499 Client Closed Request.
+// ClientClosedRequestError는 499 Client Closed Request HttpError를 생성합니다.
+func ClientClosedRequestError(opts ...Option) *HttpError {
+	return newWithOptions(499, opts)
+}
+
+// ClientClosedRequestf responds with a 499 Client Closed Request error, formatting the message according to a format specifier.
+// ClientClosedRequestf는 형식 지정자에 따라 메시지를 구성하여 499 Client Closed Request 오류로 응답합니다.
+func ClientClosedRequestf(w http.ResponseWriter, r *http.Request, format string, args ...any) {
+	err := ClientClosedRequestError(fmt.Sprintf(format, args...))
+	Respond(w, r, err)
+}
+
+// ClientClosedRequestHandler returns an http.Handler that responds with a 499 Client Closed Request error.
+// ClientClosedRequestHandler는 499 Client Closed Request 오류로 응답하는 http.Handler를 반환합니다.
+func ClientClosedRequestHandler(opts ...Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ClientClosedRequest(w, r, opts...)
+	})
+}
+
 // InternalServerError responds with a 500 Internal Server Error.
 // 내부 서버 오류: 서버에 예기치 않은 오류가 발생했습니다.
-func InternalServerError(w http.ResponseWriter, r *http.Request, message ...string) {
-	err := New(http.StatusInternalServerError, joinMessages(http.StatusText(http.StatusInternalServerError), message))
+func InternalServerError(w http.ResponseWriter, r *http.Request, opts ...Option) {
+	err := InternalServerErrorError(opts...)
 	Respond(w, r, err)
 }
 
+// InternalServerErrorError creates the HttpError struct for 500 Internal Server Error.
+// InternalServerErrorError는 500 Internal Server Error HttpError를 생성합니다.
+func InternalServerErrorError(opts ...Option) *HttpError {
+	return newWithOptions(http.StatusInternalServerError, opts)
+}
+
+// InternalServerErrorf responds with a 500 Internal Server Error, formatting the message according to a format specifier.
+// InternalServerErrorf는 형식 지정자에 따라 메시지를 구성하여 500 Internal Server Error 오류로 응답합니다.
+func InternalServerErrorf(w http.ResponseWriter, r *http.Request, format string, args ...any) {
+	err := InternalServerErrorError(fmt.Sprintf(format, args...))
+	Respond(w, r, err)
+}
+
+// InternalServerErrorHandler returns an http.Handler that responds with a 500 Internal Server Error.
+// InternalServerErrorHandler는 500 Internal Server Error 오류로 응답하는 http.Handler를 반환합니다.
+func InternalServerErrorHandler(opts ...Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		InternalServerError(w, r, opts...)
+	})
+}
+
 // NotImplemented responds with a 501 Not Implemented error.
 // 구현되지 않음: 서버가 요청을 수행하는 데 필요한 기능을 지원하지 않습니다.
-func NotImplemented(w http.ResponseWriter, r *http.Request, message ...string) {
-	err := New(http.StatusNotImplemented, joinMessages(http.StatusText(http.StatusNotImplemented), message))
+func NotImplemented(w http.ResponseWriter, r *http.Request, opts ...Option) {
+	err := NotImplementedError(opts...)
 	Respond(w, r, err)
 }
 
+// NotImplementedError creates the HttpError struct for 501 Not Implemented.
+// NotImplementedError는 501 Not Implemented HttpError를 생성합니다.
+func NotImplementedError(opts ...Option) *HttpError {
+	return newWithOptions(http.StatusNotImplemented, opts)
+}
+
+// NotImplementedf responds with a 501 Not Implemented error, formatting the message according to a format specifier.
+// NotImplementedf는 형식 지정자에 따라 메시지를 구성하여 501 Not Implemented 오류로 응답합니다.
+func NotImplementedf(w http.ResponseWriter, r *http.Request, format string, args ...any) {
+	err := NotImplementedError(fmt.Sprintf(format, args...))
+	Respond(w, r, err)
+}
+
+// NotImplementedHandler returns an http.Handler that responds with a 501 Not Implemented error.
+// NotImplementedHandler는 501 Not Implemented 오류로 응답하는 http.Handler를 반환합니다.
+func NotImplementedHandler(opts ...Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		NotImplemented(w, r, opts...)
+	})
+}
+
 // BadGateway responds with a 502 Bad Gateway error.
 // 잘못된 게이트웨이: 서버가 게이트웨이 또는 프록시 역할을 하는 동안 업스트림 서버로부터 잘못된 응답을 받았습니다.
-func BadGateway(w http.ResponseWriter, r *http.Request, message ...string) {
-	err := New(http.StatusBadGateway, joinMessages(http.StatusText(http.StatusBadGateway), message))
+func BadGateway(w http.ResponseWriter, r *http.Request, opts ...Option) {
+	err := BadGatewayError(opts...)
 	Respond(w, r, err)
 }
 
+// BadGatewayError creates the HttpError struct for 502 Bad Gateway.
+// BadGatewayError는 502 Bad Gateway HttpError를 생성합니다.
+func BadGatewayError(opts ...Option) *HttpError {
+	return newWithOptions(http.StatusBadGateway, opts)
+}
+
+// BadGatewayf responds with a 502 Bad Gateway error, formatting the message according to a format specifier.
+// BadGatewayf는 형식 지정자에 따라 메시지를 구성하여 502 Bad Gateway 오류로 응답합니다.
+func BadGatewayf(w http.ResponseWriter, r *http.Request, format string, args ...any) {
+	err := BadGatewayError(fmt.Sprintf(format, args...))
+	Respond(w, r, err)
+}
+
+// BadGatewayHandler returns an http.Handler that responds with a 502 Bad Gateway error.
+// BadGatewayHandler는 502 Bad Gateway 오류로 응답하는 http.Handler를 반환합니다.
+func BadGatewayHandler(opts ...Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		BadGateway(w, r, opts...)
+	})
+}
+
 // ServiceUnavailable responds with a 503 Service Unavailable error.
 // 서비스 사용 불가: 서버가 일시적으로 요청을 처리할 수 없습니다.
-func ServiceUnavailable(w http.ResponseWriter, r *http.Request, message ...string) {
-	err := New(http.StatusServiceUnavailable, joinMessages(http.StatusText(http.StatusServiceUnavailable), message))
+func ServiceUnavailable(w http.ResponseWriter, r *http.Request, opts ...Option) {
+	err := ServiceUnavailableError(opts...)
+	Respond(w, r, err)
+}
+
+// ServiceUnavailableError creates the HttpError struct for 503 Service Unavailable.
+// ServiceUnavailableError는 503 Service Unavailable HttpError를 생성합니다.
+func ServiceUnavailableError(opts ...Option) *HttpError {
+	return newWithOptions(http.StatusServiceUnavailable, opts)
+}
+
+// ServiceUnavailablef responds with a 503 Service Unavailable error, formatting the message according to a format specifier.
+// ServiceUnavailablef는 형식 지정자에 따라 메시지를 구성하여 503 Service Unavailable 오류로 응답합니다.
+func ServiceUnavailablef(w http.ResponseWriter, r *http.Request, format string, args ...any) {
+	err := ServiceUnavailableError(fmt.Sprintf(format, args...))
 	Respond(w, r, err)
 }
 
+// ServiceUnavailableHandler returns an http.Handler that responds with a 503 Service Unavailable error.
+// ServiceUnavailableHandler는 503 Service Unavailable 오류로 응답하는 http.Handler를 반환합니다.
+func ServiceUnavailableHandler(opts ...Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ServiceUnavailable(w, r, opts...)
+	})
+}
+
 // GatewayTimeout responds with a 504 Gateway Timeout error.
 // 게이트웨이 시간 초과: 서버가 게이트웨이 또는 프록시 역할을 하는 동안 업스트림 서버로부터 응답을 받지 못했습니다.
-func GatewayTimeout(w http.ResponseWriter, r *http.Request, message ...string) {
-	err := New(http.StatusGatewayTimeout, joinMessages(http.StatusText(http.StatusGatewayTimeout), message))
+func GatewayTimeout(w http.ResponseWriter, r *http.Request, opts ...Option) {
+	err := GatewayTimeoutError(opts...)
+	Respond(w, r, err)
+}
+
+// GatewayTimeoutError creates the HttpError struct for 504 Gateway Timeout.
+// GatewayTimeoutError는 504 Gateway Timeout HttpError를 생성합니다.
+func GatewayTimeoutError(opts ...Option) *HttpError {
+	return newWithOptions(http.StatusGatewayTimeout, opts)
+}
+
+// GatewayTimeoutf responds with a 504 Gateway Timeout error, formatting the message according to a format specifier.
+// GatewayTimeoutf는 형식 지정자에 따라 메시지를 구성하여 504 Gateway Timeout 오류로 응답합니다.
+func GatewayTimeoutf(w http.ResponseWriter, r *http.Request, format string, args ...any) {
+	err := GatewayTimeoutError(fmt.Sprintf(format, args...))
 	Respond(w, r, err)
 }
 
+// GatewayTimeoutHandler returns an http.Handler that responds with a 504 Gateway Timeout error.
+// GatewayTimeoutHandler는 504 Gateway Timeout 오류로 응답하는 http.Handler를 반환합니다.
+func GatewayTimeoutHandler(opts ...Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		GatewayTimeout(w, r, opts...)
+	})
+}
+
 // HTTPVersionNotSupported responds with a 505 HTTP Version Not Supported error.
 // 지원되지 않는 HTTP 버전: 서버가 요청에 사용된 HTTP 버전을 지원하지 않습니다.
-func HTTPVersionNotSupported(w http.ResponseWriter, r *http.Request, message ...string) {
-	err := New(http.StatusHTTPVersionNotSupported, joinMessages(http.StatusText(http.StatusHTTPVersionNotSupported), message))
+func HTTPVersionNotSupported(w http.ResponseWriter, r *http.Request, opts ...Option) {
+	err := HTTPVersionNotSupportedError(opts...)
 	Respond(w, r, err)
 }
 
+// HTTPVersionNotSupportedError creates the HttpError struct for 505 HTTP Version Not Supported.
+// HTTPVersionNotSupportedError는 505 HTTP Version Not Supported HttpError를 생성합니다.
+func HTTPVersionNotSupportedError(opts ...Option) *HttpError {
+	return newWithOptions(http.StatusHTTPVersionNotSupported, opts)
+}
+
+// HTTPVersionNotSupportedf responds with a 505 HTTP Version Not Supported error, formatting the message according to a format specifier.
+// HTTPVersionNotSupportedf는 형식 지정자에 따라 메시지를 구성하여 505 HTTP Version Not Supported 오류로 응답합니다.
+func HTTPVersionNotSupportedf(w http.ResponseWriter, r *http.Request, format string, args ...any) {
+	err := HTTPVersionNotSupportedError(fmt.Sprintf(format, args...))
+	Respond(w, r, err)
+}
+
+// HTTPVersionNotSupportedHandler returns an http.Handler that responds with a 505 HTTP Version Not Supported error.
+// HTTPVersionNotSupportedHandler는 505 HTTP Version Not Supported 오류로 응답하는 http.Handler를 반환합니다.
+func HTTPVersionNotSupportedHandler(opts ...Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		HTTPVersionNotSupported(w, r, opts...)
+	})
+}
+
 // VariantAlsoNegotiates responds with a 506 Variant Also Negotiates error.
 // 변형도 협상함: 서버에 내부 구성 오류가 있습니다.
-func VariantAlsoNegotiates(w http.ResponseWriter, r *http.Request, message ...string) {
-	err := New(http.StatusVariantAlsoNegotiates, joinMessages(http.StatusText(http.StatusVariantAlsoNegotiates), message))
+func VariantAlsoNegotiates(w http.ResponseWriter, r *http.Request, opts ...Option) {
+	err := VariantAlsoNegotiatesError(opts...)
 	Respond(w, r, err)
 }
 
+// VariantAlsoNegotiatesError creates the HttpError struct for 506 Variant Also Negotiates.
+// VariantAlsoNegotiatesError는 506 Variant Also Negotiates HttpError를 생성합니다.
+func VariantAlsoNegotiatesError(opts ...Option) *HttpError {
+	return newWithOptions(http.StatusVariantAlsoNegotiates, opts)
+}
+
+// VariantAlsoNegotiatesf responds with a 506 Variant Also Negotiates error, formatting the message according to a format specifier.
+// VariantAlsoNegotiatesf는 형식 지정자에 따라 메시지를 구성하여 506 Variant Also Negotiates 오류로 응답합니다.
+func VariantAlsoNegotiatesf(w http.ResponseWriter, r *http.Request, format string, args ...any) {
+	err := VariantAlsoNegotiatesError(fmt.Sprintf(format, args...))
+	Respond(w, r, err)
+}
+
+// VariantAlsoNegotiatesHandler returns an http.Handler that responds with a 506 Variant Also Negotiates error.
+// VariantAlsoNegotiatesHandler는 506 Variant Also Negotiates 오류로 응답하는 http.Handler를 반환합니다.
+func VariantAlsoNegotiatesHandler(opts ...Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		VariantAlsoNegotiates(w, r, opts...)
+	})
+}
+
 // InsufficientStorage responds with a 507 Insufficient Storage error.
 // 저장 공간 부족: 서버에 요청을 완료하는 데 필요한 저장 공간이 부족합니다.
-func InsufficientStorage(w http.ResponseWriter, r *http.Request, message ...string) {
-	err := New(http.StatusInsufficientStorage, joinMessages(http.StatusText(http.StatusInsufficientStorage), message))
+func InsufficientStorage(w http.ResponseWriter, r *http.Request, opts ...Option) {
+	err := InsufficientStorageError(opts...)
+	Respond(w, r, err)
+}
+
+// InsufficientStorageError creates the HttpError struct for 507 Insufficient Storage.
+// InsufficientStorageError는 507 Insufficient Storage HttpError를 생성합니다.
+func InsufficientStorageError(opts ...Option) *HttpError {
+	return newWithOptions(http.StatusInsufficientStorage, opts)
+}
+
+// InsufficientStoragef responds with a 507 Insufficient Storage error, formatting the message according to a format specifier.
+// InsufficientStoragef는 형식 지정자에 따라 메시지를 구성하여 507 Insufficient Storage 오류로 응답합니다.
+func InsufficientStoragef(w http.ResponseWriter, r *http.Request, format string, args ...any) {
+	err := InsufficientStorageError(fmt.Sprintf(format, args...))
 	Respond(w, r, err)
 }
 
+// InsufficientStorageHandler returns an http.Handler that responds with a 507 Insufficient Storage error.
+// InsufficientStorageHandler는 507 Insufficient Storage 오류로 응답하는 http.Handler를 반환합니다.
+func InsufficientStorageHandler(opts ...Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		InsufficientStorage(w, r, opts...)
+	})
+}
+
 // LoopDetected responds with a 508 Loop Detected error.
 // 루프 감지됨: 서버가 요청을 처리하는 동안 무한 루프를 감지했습니다.
-func LoopDetected(w http.ResponseWriter, r *http.Request, message ...string) {
-	err := New(http.StatusLoopDetected, joinMessages(http.StatusText(http.StatusLoopDetected), message))
+func LoopDetected(w http.ResponseWriter, r *http.Request, opts ...Option) {
+	err := LoopDetectedError(opts...)
 	Respond(w, r, err)
 }
 
+// LoopDetectedError creates the HttpError struct for 508 Loop Detected.
+// LoopDetectedError는 508 Loop Detected HttpError를 생성합니다.
+func LoopDetectedError(opts ...Option) *HttpError {
+	return newWithOptions(http.StatusLoopDetected, opts)
+}
+
+// LoopDetectedf responds with a 508 Loop Detected error, formatting the message according to a format specifier.
+// LoopDetectedf는 형식 지정자에 따라 메시지를 구성하여 508 Loop Detected 오류로 응답합니다.
+func LoopDetectedf(w http.ResponseWriter, r *http.Request, format string, args ...any) {
+	err := LoopDetectedError(fmt.Sprintf(format, args...))
+	Respond(w, r, err)
+}
+
+// LoopDetectedHandler returns an http.Handler that responds with a 508 Loop Detected error.
+// LoopDetectedHandler는 508 Loop Detected 오류로 응답하는 http.Handler를 반환합니다.
+func LoopDetectedHandler(opts ...Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		LoopDetected(w, r, opts...)
+	})
+}
+
 // NotExtended responds with a 510 Not Extended error.
 // 확장되지 않음: 요청을 이행하기 위해 추가 확장이 필요합니다.
-func NotExtended(w http.ResponseWriter, r *http.Request, message ...string) {
-	err := New(http.StatusNotExtended, joinMessages(http.StatusText(http.StatusNotExtended), message))
+func NotExtended(w http.ResponseWriter, r *http.Request, opts ...Option) {
+	err := NotExtendedError(opts...)
 	Respond(w, r, err)
 }
 
+// NotExtendedError creates the HttpError struct for 510 Not Extended.
+// NotExtendedError는 510 Not Extended HttpError를 생성합니다.
+func NotExtendedError(opts ...Option) *HttpError {
+	return newWithOptions(http.StatusNotExtended, opts)
+}
+
+// NotExtendedf responds with a 510 Not Extended error, formatting the message according to a format specifier.
+// NotExtendedf는 형식 지정자에 따라 메시지를 구성하여 510 Not Extended 오류로 응답합니다.
+func NotExtendedf(w http.ResponseWriter, r *http.Request, format string, args ...any) {
+	err := NotExtendedError(fmt.Sprintf(format, args...))
+	Respond(w, r, err)
+}
+
+// NotExtendedHandler returns an http.Handler that responds with a 510 Not Extended error.
+// NotExtendedHandler는 510 Not Extended 오류로 응답하는 http.Handler를 반환합니다.
+func NotExtendedHandler(opts ...Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		NotExtended(w, r, opts...)
+	})
+}
+
 // NetworkAuthenticationRequired responds with a 511 Network Authentication Required error.
 // 네트워크 인증 필요: 클라이언트는 네트워크 접근 권한을 얻기 위해 인증해야 합니다.
-func NetworkAuthenticationRequired(w http.ResponseWriter, r *http.Request, message ...string) {
-	err := New(http.StatusNetworkAuthenticationRequired, joinMessages(http.StatusText(http.StatusNetworkAuthenticationRequired), message))
+func NetworkAuthenticationRequired(w http.ResponseWriter, r *http.Request, opts ...Option) {
+	err := NetworkAuthenticationRequiredError(opts...)
+	Respond(w, r, err)
+}
+
+// NetworkAuthenticationRequiredError creates the HttpError struct for 511 Network Authentication Required.
+// NetworkAuthenticationRequiredError는 511 Network Authentication Required HttpError를 생성합니다.
+func NetworkAuthenticationRequiredError(opts ...Option) *HttpError {
+	return newWithOptions(http.StatusNetworkAuthenticationRequired, opts)
+}
+
+// NetworkAuthenticationRequiredf responds with a 511 Network Authentication Required error, formatting the message according to a format specifier.
+// NetworkAuthenticationRequiredf는 형식 지정자에 따라 메시지를 구성하여 511 Network Authentication Required 오류로 응답합니다.
+func NetworkAuthenticationRequiredf(w http.ResponseWriter, r *http.Request, format string, args ...any) {
+	err := NetworkAuthenticationRequiredError(fmt.Sprintf(format, args...))
 	Respond(w, r, err)
-}
\ No newline at end of file
+}
+
+// NetworkAuthenticationRequiredHandler returns an http.Handler that responds with a 511 Network Authentication Required error.
+// NetworkAuthenticationRequiredHandler는 511 Network Authentication Required 오류로 응답하는 http.Handler를 반환합니다.
+func NetworkAuthenticationRequiredHandler(opts ...Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		NetworkAuthenticationRequired(w, r, opts...)
+	})
+}
+
+// statusFamilies lists the generated helper family of every status, for VetNames.
+var statusFamilies = []statusFamily{
+	{http.StatusBadRequest, "BadRequest", BadRequest, BadRequestError, BadRequestf, ErrBadRequest, BadRequestHandler},
+	{http.StatusUnauthorized, "Unauthorized", Unauthorized, UnauthorizedError, Unauthorizedf, ErrUnauthorized, UnauthorizedHandler},
+	{http.StatusPaymentRequired, "PaymentRequired", PaymentRequired, PaymentRequiredError, PaymentRequiredf, ErrPaymentRequired, PaymentRequiredHandler},
+	{http.StatusForbidden, "Forbidden", Forbidden, ForbiddenError, Forbiddenf, ErrForbidden, ForbiddenHandler},
+	{http.StatusNotFound, "NotFound", NotFound, NotFoundError, NotFoundf, ErrNotFound, NotFoundHandler},
+	{http.StatusMethodNotAllowed, "MethodNotAllowed", MethodNotAllowed, MethodNotAllowedError, MethodNotAllowedf, ErrMethodNotAllowed, MethodNotAllowedHandler},
+	{http.StatusNotAcceptable, "NotAcceptable", NotAcceptable, NotAcceptableError, NotAcceptablef, ErrNotAcceptable, NotAcceptableHandler},
+	{http.StatusProxyAuthRequired, "ProxyAuthRequired", ProxyAuthRequired, ProxyAuthRequiredError, ProxyAuthRequiredf, ErrProxyAuthRequired, ProxyAuthRequiredHandler},
+	{http.StatusRequestTimeout, "RequestTimeout", RequestTimeout, RequestTimeoutError, RequestTimeoutf, ErrRequestTimeout, RequestTimeoutHandler},
+	{http.StatusConflict, "Conflict", Conflict, ConflictError, Conflictf, ErrConflict, ConflictHandler},
+	{http.StatusGone, "Gone", Gone, GoneError, Gonef, ErrGone, GoneHandler},
+	{http.StatusLengthRequired, "LengthRequired", LengthRequired, LengthRequiredError, LengthRequiredf, ErrLengthRequired, LengthRequiredHandler},
+	{http.StatusPreconditionFailed, "PreconditionFailed", PreconditionFailed, PreconditionFailedError, PreconditionFailedf, ErrPreconditionFailed, PreconditionFailedHandler},
+	{http.StatusRequestEntityTooLarge, "PayloadTooLarge", PayloadTooLarge, PayloadTooLargeError, PayloadTooLargef, ErrPayloadTooLarge, PayloadTooLargeHandler},
+	{http.StatusRequestURITooLong, "URITooLong", URITooLong, URITooLongError, URITooLongf, ErrURITooLong, URITooLongHandler},
+	{http.StatusUnsupportedMediaType, "UnsupportedMediaType", UnsupportedMediaType, UnsupportedMediaTypeError, UnsupportedMediaTypef, ErrUnsupportedMediaType, UnsupportedMediaTypeHandler},
+	{http.StatusRequestedRangeNotSatisfiable, "RangeNotSatisfiable", RangeNotSatisfiable, RangeNotSatisfiableError, RangeNotSatisfiablef, ErrRangeNotSatisfiable, RangeNotSatisfiableHandler},
+	{http.StatusExpectationFailed, "ExpectationFailed", ExpectationFailed, ExpectationFailedError, ExpectationFailedf, ErrExpectationFailed, ExpectationFailedHandler},
+	{http.StatusTeapot, "Teapot", Teapot, TeapotError, Teapotf, ErrTeapot, TeapotHandler},
+	{http.StatusMisdirectedRequest, "MisdirectedRequest", MisdirectedRequest, MisdirectedRequestError, MisdirectedRequestf, ErrMisdirectedRequest, MisdirectedRequestHandler},
+	{http.StatusUnprocessableEntity, "UnprocessableEntity", UnprocessableEntity, UnprocessableEntityError, UnprocessableEntityf, ErrUnprocessableEntity, UnprocessableEntityHandler},
+	{http.StatusLocked, "Locked", Locked, LockedError, Lockedf, ErrLocked, LockedHandler},
+	{http.StatusFailedDependency, "FailedDependency", FailedDependency, FailedDependencyError, FailedDependencyf, ErrFailedDependency, FailedDependencyHandler},
+	{http.StatusTooEarly, "TooEarly", TooEarly, TooEarlyError, TooEarlyf, ErrTooEarly, TooEarlyHandler},
+	{http.StatusUpgradeRequired, "UpgradeRequired", UpgradeRequired, UpgradeRequiredError, UpgradeRequiredf, ErrUpgradeRequired, UpgradeRequiredHandler},
+	{http.StatusPreconditionRequired, "PreconditionRequired", PreconditionRequired, PreconditionRequiredError, PreconditionRequiredf, ErrPreconditionRequired, PreconditionRequiredHandler},
+	{http.StatusTooManyRequests, "TooManyRequests", TooManyRequests, TooManyRequestsError, TooManyRequestsf, ErrTooManyRequests, TooManyRequestsHandler},
+	{http.StatusRequestHeaderFieldsTooLarge, "RequestHeaderFieldsTooLarge", RequestHeaderFieldsTooLarge, RequestHeaderFieldsTooLargeError, RequestHeaderFieldsTooLargef, ErrRequestHeaderFieldsTooLarge, RequestHeaderFieldsTooLargeHandler},
+	{http.StatusUnavailableForLegalReasons, "UnavailableForLegalReasons", UnavailableForLegalReasons, UnavailableForLegalReasonsError, UnavailableForLegalReasonsf, ErrUnavailableForLegalReasons, UnavailableForLegalReasonsHandler},
+	{499, "ClientClosedRequest", ClientClosedRequest, ClientClosedRequestError, ClientClosedRequestf, ErrClientClosedRequest, ClientClosedRequestHandler},
+	{http.StatusInternalServerError, "InternalServerError", InternalServerError, InternalServerErrorError, InternalServerErrorf, ErrInternalServerError, InternalServerErrorHandler},
+	{http.StatusNotImplemented, "NotImplemented", NotImplemented, NotImplementedError, NotImplementedf, ErrNotImplemented, NotImplementedHandler},
+	{http.StatusBadGateway, "BadGateway", BadGateway, BadGatewayError, BadGatewayf, ErrBadGateway, BadGatewayHandler},
+	{http.StatusServiceUnavailable, "ServiceUnavailable", ServiceUnavailable, ServiceUnavailableError, ServiceUnavailablef, ErrServiceUnavailable, ServiceUnavailableHandler},
+	{http.StatusGatewayTimeout, "GatewayTimeout", GatewayTimeout, GatewayTimeoutError, GatewayTimeoutf, ErrGatewayTimeout, GatewayTimeoutHandler},
+	{http.StatusHTTPVersionNotSupported, "HTTPVersionNotSupported", HTTPVersionNotSupported, HTTPVersionNotSupportedError, HTTPVersionNotSupportedf, ErrHTTPVersionNotSupported, HTTPVersionNotSupportedHandler},
+	{http.StatusVariantAlsoNegotiates, "VariantAlsoNegotiates", VariantAlsoNegotiates, VariantAlsoNegotiatesError, VariantAlsoNegotiatesf, ErrVariantAlsoNegotiates, VariantAlsoNegotiatesHandler},
+	{http.StatusInsufficientStorage, "InsufficientStorage", InsufficientStorage, InsufficientStorageError, InsufficientStoragef, ErrInsufficientStorage, InsufficientStorageHandler},
+	{http.StatusLoopDetected, "LoopDetected", LoopDetected, LoopDetectedError, LoopDetectedf, ErrLoopDetected, LoopDetectedHandler},
+	{http.StatusNotExtended, "NotExtended", NotExtended, NotExtendedError, NotExtendedf, ErrNotExtended, NotExtendedHandler},
+	{http.StatusNetworkAuthenticationRequired, "NetworkAuthenticationRequired", NetworkAuthenticationRequired, NetworkAuthenticationRequiredError, NetworkAuthenticationRequiredf, ErrNetworkAuthenticationRequired, NetworkAuthenticationRequiredHandler},
+}
+
+// koreanStatusTexts are the Korean texts of every generated status, for KoreanCatalog.
+var koreanStatusTexts = map[int]string{
+	http.StatusBadRequest:                    "잘못된 요청",
+	http.StatusUnauthorized:                  "인증 실패",
+	http.StatusPaymentRequired:               "결제 필요",
+	http.StatusForbidden:                     "접근 금지",
+	http.StatusNotFound:                      "찾을 수 없음",
+	http.StatusMethodNotAllowed:              "허용되지 않은 메소드",
+	http.StatusNotAcceptable:                 "수용할 수 없음",
+	http.StatusProxyAuthRequired:             "프록시 인증 필요",
+	http.StatusRequestTimeout:                "요청 시간 초과",
+	http.StatusConflict:                      "충돌",
+	http.StatusGone:                          "사라짐",
+	http.StatusLengthRequired:                "길이 필요",
+	http.StatusPreconditionFailed:            "사전 조건 실패",
+	http.StatusRequestEntityTooLarge:         "페이로드 너무 큼",
+	http.StatusRequestURITooLong:             "URI 너무 긺",
+	http.StatusUnsupportedMediaType:          "지원되지 않는 미디어 유형",
+	http.StatusRequestedRangeNotSatisfiable:  "범위 만족할 수 없음",
+	http.StatusExpectationFailed:             "기대 실패",
+	http.StatusTeapot:                        "나는 찻주전자",
+	http.StatusMisdirectedRequest:            "잘못 전달된 요청",
+	http.StatusUnprocessableEntity:           "처리할 수 없는 엔티티",
+	http.StatusLocked:                        "잠김",
+	http.StatusFailedDependency:              "실패한 종속성",
+	http.StatusTooEarly:                      "너무 이름",
+	http.StatusUpgradeRequired:               "업그레이드 필요",
+	http.StatusPreconditionRequired:          "사전 조건 필요",
+	http.StatusTooManyRequests:               "너무 많은 요청",
+	http.StatusRequestHeaderFieldsTooLarge:   "요청 헤더 필드 너무 큼",
+	http.StatusUnavailableForLegalReasons:    "법적 이유로 사용할 수 없음",
+	499:                                      "클라이언트 요청 종료",
+	http.StatusInternalServerError:           "내부 서버 오류",
+	http.StatusNotImplemented:                "구현되지 않음",
+	http.StatusBadGateway:                    "잘못된 게이트웨이",
+	http.StatusServiceUnavailable:            "서비스 사용 불가",
+	http.StatusGatewayTimeout:                "게이트웨이 시간 초과",
+	http.StatusHTTPVersionNotSupported:       "지원되지 않는 HTTP 버전",
+	http.StatusVariantAlsoNegotiates:         "변형도 협상함",
+	http.StatusInsufficientStorage:           "저장 공간 부족",
+	http.StatusLoopDetected:                  "루프 감지됨",
+	http.StatusNotExtended:                   "확장되지 않음",
+	http.StatusNetworkAuthenticationRequired: "네트워크 인증 필요",
+}
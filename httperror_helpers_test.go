@@ -1,6 +1,7 @@
 package httperror
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -30,7 +31,7 @@ func TestNew(t *testing.T) {
 func TestHelperFunctions(t *testing.T) {
 	testCases := []struct {
 		name           string
-		function       func(http.ResponseWriter, *http.Request, ...string)
+		function       func(http.ResponseWriter, *http.Request, ...Option)
 		expectedStatus int
 		customMessage  string
 	}{
@@ -113,3 +114,76 @@ func TestHelperFunctions(t *testing.T) {
 		})
 	}
 }
+
+// TestHelperFamilies tests the constructor, f-variant, sentinel and handler factory of every status.
+func TestHelperFamilies(t *testing.T) {
+	SetErrorHandler(nil)
+
+	for _, f := range statusFamilies {
+		t.Run(f.Name, func(t *testing.T) {
+			if err := f.Constructor("custom"); err.Status != f.Status || err.Message != "custom" {
+				t.Errorf("%sError: expected %d 'custom', got %d '%s'", f.Name, f.Status, err.Status, err.Message)
+			}
+			if !errors.Is(New(f.Status, "wrapped"), f.Sentinel) {
+				t.Errorf("expected errors.Is to match Err%s", f.Name)
+			}
+
+			// A 499 is only recorded, without a body.
+			withBody := f.Status != StatusClientClosedRequest
+
+			rr := httptest.NewRecorder()
+			f.Formatter(rr, httptest.NewRequest("GET", "/", nil), "item %d failed", 42)
+			if rr.Code != f.Status || strings.Contains(rr.Body.String(), "item 42 failed") != withBody {
+				t.Errorf("%sf: expected %d with formatted message, got %d '%s'", f.Name, f.Status, rr.Code, rr.Body.String())
+			}
+
+			rr = httptest.NewRecorder()
+			f.Handler("from handler").ServeHTTP(rr, httptest.NewRequest("GET", "/", nil))
+			if rr.Code != f.Status || strings.Contains(rr.Body.String(), "from handler") != withBody {
+				t.Errorf("%sHandler: expected %d with message, got %d '%s'", f.Name, f.Status, rr.Code, rr.Body.String())
+			}
+		})
+	}
+}
+
+// TestSentinelIs tests that sentinels only match errors with the same status.
+func TestSentinelIs(t *testing.T) {
+	err := fmt.Errorf("lookup: %w", NotFoundError("user missing"))
+	if !errors.Is(err, ErrNotFound) {
+		t.Error("expected wrapped 404 to match ErrNotFound")
+	}
+	if errors.Is(err, ErrForbidden) {
+		t.Error("expected wrapped 404 not to match ErrForbidden")
+	}
+}
+
+// TestRouterHandlers tests the handlers meant for router NotFound/MethodNotAllowed hooks.
+func TestRouterHandlers(t *testing.T) {
+	var handled []int
+	SetErrorHandler(func(w http.ResponseWriter, r *http.Request, err error) {
+		handled = append(handled, err.(*HttpError).Status)
+		DefaultErrorHandler(w, r, err)
+	})
+	defer SetErrorHandler(nil)
+
+	mux := http.NewServeMux()
+	var notFound, methodNotAllowed http.Handler = NotFoundHandler(), MethodNotAllowedHandler(WithAllow(http.MethodGet, http.MethodHead))
+	mux.Handle("/missing", notFound)
+	mux.Handle("/items", methodNotAllowed)
+
+	rr := httptest.NewRecorder()
+	mux.ServeHTTP(rr, httptest.NewRequest("GET", "/missing", nil))
+	if rr.Code != http.StatusNotFound {
+		t.Errorf("expected 404, got %d", rr.Code)
+	}
+
+	rr = httptest.NewRecorder()
+	mux.ServeHTTP(rr, httptest.NewRequest("DELETE", "/items", nil))
+	if rr.Code != http.StatusMethodNotAllowed || rr.Header().Get("Allow") != "GET, HEAD" {
+		t.Errorf("expected 405 with Allow header, got %d %q", rr.Code, rr.Header().Get("Allow"))
+	}
+
+	if !reflect.DeepEqual(handled, []int{http.StatusNotFound, http.StatusMethodNotAllowed}) {
+		t.Errorf("expected both responses to go through the configured handler, got %v", handled)
+	}
+}
@@ -3,6 +3,7 @@ package httperror
 import (
 	"encoding/json"
 	"errors"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -147,4 +148,109 @@ func TestSetErrorHandler(t *testing.T) {
 	if rr.Header().Get("Content-Type") != "application/json; charset=utf-8" {
 		t.Error("Should revert to default handler")
 	}
-}
\ No newline at end of file
+}
+
+// TestRespondNilWriterAndRequest tests that Respond tolerates background errors.
+func TestRespondNilWriterAndRequest(t *testing.T) {
+	SetErrorHandler(nil)
+	defer ResetHooks()
+
+	var events []ErrorEvent
+	AddHook(func(ev ErrorEvent) { events = append(events, ev) })
+
+	t.Run("nil request renders JSON", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		Respond(rr, nil, New(http.StatusConflict, "Conflict"))
+
+		if rr.Code != http.StatusConflict {
+			t.Errorf("expected status %d, got %d", http.StatusConflict, rr.Code)
+		}
+		if rr.Header().Get("Content-Type") != "application/json; charset=utf-8" {
+			t.Errorf("expected JSON content type, got %s", rr.Header().Get("Content-Type"))
+		}
+	})
+
+	t.Run("nil writer still emits hook events", func(t *testing.T) {
+		events = nil
+		NotFound(nil, nil, "background job failed")
+		Respond(nil, httptest.NewRequest("GET", "/", nil), errors.New("boom"))
+
+		if len(events) != 2 {
+			t.Fatalf("expected 2 hook events, got %d", len(events))
+		}
+		if events[0].HttpError.Status != http.StatusNotFound || events[0].Request != nil {
+			t.Errorf("unexpected first event: %+v", events[0])
+		}
+		if events[1].HttpError.Status != http.StatusInternalServerError || events[1].Response.Status != 0 {
+			t.Errorf("unexpected second event: %+v", events[1])
+		}
+	})
+
+	t.Run("DefaultErrorHandler with nil writer", func(t *testing.T) {
+		DefaultErrorHandler(nil, nil, errors.New("boom")) // must not panic
+	})
+}
+
+// brokenWriter is a ResponseWriter whose writes fail.
+type brokenWriter struct {
+	*httptest.ResponseRecorder
+}
+
+func (brokenWriter) Write([]byte) (int, error) {
+	return 0, errBrokenPipe
+}
+
+var errBrokenPipe = errors.New("broken pipe")
+
+// failingEncoder is an Encoder that always fails.
+type failingEncoder struct{}
+
+func (failingEncoder) ContentType() string { return "application/json" }
+
+func (failingEncoder) Encode(io.Writer, *HttpError) error { return errEncode }
+
+var errEncode = errors.New("cannot encode")
+
+// TestRespondE tests that RespondE reports encoding and write failures.
+func TestRespondE(t *testing.T) {
+	failing := NewResponder()
+	failing.SetEncoder(failingEncoder{})
+
+	testCases := []struct {
+		name     string
+		handler  ErrorHandler
+		writer   func(rr *httptest.ResponseRecorder) http.ResponseWriter
+		err      error
+		expected error
+	}{
+		{"success", nil, func(rr *httptest.ResponseRecorder) http.ResponseWriter { return rr }, NotFoundError("user not found"), nil},
+		{"cached body write failure", nil, func(rr *httptest.ResponseRecorder) http.ResponseWriter { return brokenWriter{rr} }, NotFoundError(), errBrokenPipe},
+		{"write failure", nil, func(rr *httptest.ResponseRecorder) http.ResponseWriter { return brokenWriter{rr} }, NotFoundError("user not found"), errBrokenPipe},
+		{"encoding failure", failing.HandleError, func(rr *httptest.ResponseRecorder) http.ResponseWriter { return rr }, NotFoundError(), errEncode},
+		{"custom handler write failure", func(w http.ResponseWriter, r *http.Request, err error) {
+			w.Write([]byte("custom"))
+		}, func(rr *httptest.ResponseRecorder) http.ResponseWriter { return brokenWriter{rr} }, NotFoundError(), errBrokenPipe},
+		{"nil writer", nil, func(*httptest.ResponseRecorder) http.ResponseWriter { return nil }, NotFoundError(), nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			SetErrorHandler(tc.handler)
+			defer SetErrorHandler(nil)
+
+			rr := httptest.NewRecorder()
+			err := RespondE(tc.writer(rr), httptest.NewRequest("GET", "/", nil), tc.err)
+			if !errors.Is(err, tc.expected) || (err == nil) != (tc.expected == nil) {
+				t.Errorf("expected %v, got %v", tc.expected, err)
+			}
+		})
+	}
+
+	t.Run("encoding failure status", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		failing.HandleError(rr, httptest.NewRequest("GET", "/", nil), NotFoundError())
+		if rr.Code != http.StatusNotFound || rr.Body.Len() != 0 {
+			t.Errorf("expected 404 without body, got %d %q", rr.Code, rr.Body.String())
+		}
+	})
+}
@@ -0,0 +1,90 @@
+// Package httperrorchi integrates httperror with the chi router: it routes
+// chi's NotFound and MethodNotAllowed responses through httperror.Respond and
+// adapts HttpErrors to chi's render package.
+// It lives in its own module to keep the chi dependency out of httperror.
+//
+//	r := chi.NewRouter()
+//	httperrorchi.Install(r)
+package httperrorchi
+
+import (
+	"net/http"
+
+	"github.com/DevNewbie1826/httperror"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+)
+
+// methods are the methods probed to build the Allow header of 405 responses.
+var methods = []string{
+	http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch,
+	http.MethodDelete, http.MethodConnect, http.MethodOptions, http.MethodTrace,
+}
+
+// Install makes router respond to unmatched routes and methods through
+// httperror.Respond, with a 404 Not Found and a 405 Method Not Allowed
+// carrying an Allow header respectively.
+// Install은 라우터가 일치하지 않는 경로와 메서드에 대해 각각 404와 Allow 헤더를 포함한 405를
+// httperror.Respond로 응답하도록 설정합니다.
+func Install(router chi.Router) {
+	router.NotFound(NotFound)
+	router.MethodNotAllowed(MethodNotAllowed(router))
+}
+
+// NotFound responds with a 404 Not Found through httperror.Respond.
+// NotFound는 httperror.Respond를 통해 404 Not Found로 응답합니다.
+func NotFound(w http.ResponseWriter, r *http.Request) {
+	httperror.Respond(w, r, httperror.NotFoundError())
+}
+
+// MethodNotAllowed returns a handler responding with a 405 Method Not Allowed
+// through httperror.Respond. The Allow header lists the methods routes has for
+// the request path.
+// MethodNotAllowed는 httperror.Respond를 통해 405 Method Not Allowed로 응답하는 핸들러를 반환합니다.
+// Allow 헤더에는 요청 경로에 대해 routes가 허용하는 메서드가 나열됩니다.
+func MethodNotAllowed(routes chi.Routes) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var opts []httperror.Option
+		if allowed := allowedMethods(routes, r.URL.Path); len(allowed) > 0 {
+			opts = append(opts, httperror.WithAllow(allowed...))
+		}
+		httperror.Respond(w, r, httperror.MethodNotAllowedError(opts...))
+	}
+}
+
+// allowedMethods returns the methods routes matches for path.
+func allowedMethods(routes chi.Routes, path string) []string {
+	var allowed []string
+	for _, m := range methods {
+		if routes.Match(chi.NewRouteContext(), m, path) {
+			allowed = append(allowed, m)
+		}
+	}
+	return allowed
+}
+
+// ErrResponse adapts an HttpError to render.Renderer, so handlers using chi's
+// render package can return errors with render.Render. The error is rendered
+// by render with its status and headers.
+// ErrResponse는 HttpError를 render.Renderer로 변환하여 chi의 render 패키지를 사용하는 핸들러가
+// render.Render로 오류를 반환할 수 있게 합니다.
+type ErrResponse struct {
+	*httperror.HttpError
+}
+
+// Err wraps e for use with render.Render.
+// Err는 render.Render에서 사용할 수 있도록 e를 감쌉니다.
+func Err(e *httperror.HttpError) render.Renderer {
+	return ErrResponse{e}
+}
+
+// Render implements render.Renderer by setting the response status and headers.
+func (e ErrResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	for key, values := range e.Header {
+		for _, v := range values {
+			w.Header().Add(key, v)
+		}
+	}
+	render.Status(r, e.Status)
+	return nil
+}
@@ -0,0 +1,73 @@
+package httperrorchi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DevNewbie1826/httperror"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+)
+
+// TestInstall tests that unmatched routes and methods are responded by httperror.
+func TestInstall(t *testing.T) {
+	r := chi.NewRouter()
+	Install(r)
+	r.Get("/users", func(w http.ResponseWriter, r *http.Request) {})
+	r.Post("/users", func(w http.ResponseWriter, r *http.Request) {})
+
+	testCases := []struct {
+		name           string
+		method         string
+		path           string
+		expectedStatus int
+		expectedAllow  string
+	}{
+		{"not found", "GET", "/missing", http.StatusNotFound, ""},
+		{"method not allowed", "DELETE", "/users", http.StatusMethodNotAllowed, "GET, POST"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, httptest.NewRequest(tc.method, tc.path, nil))
+
+			if rr.Code != tc.expectedStatus {
+				t.Errorf("expected status %d, got %d", tc.expectedStatus, rr.Code)
+			}
+			if got := rr.Header().Get("Allow"); got != tc.expectedAllow {
+				t.Errorf("expected Allow %q, got %q", tc.expectedAllow, got)
+			}
+			var body httperror.HttpError
+			if err := json.NewDecoder(rr.Body).Decode(&body); err != nil {
+				t.Fatalf("could not decode response body: %v", err)
+			}
+			if body.Status != tc.expectedStatus {
+				t.Errorf("expected body status %d, got %d", tc.expectedStatus, body.Status)
+			}
+		})
+	}
+}
+
+// TestErr tests rendering an HttpError with chi's render package.
+func TestErr(t *testing.T) {
+	rr := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/", nil)
+	e := httperror.ConflictError("already taken", httperror.WithCode("email_taken"), httperror.WithHeader("Retry-After", "5"))
+	if err := render.Render(rr, req, Err(e)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if rr.Code != http.StatusConflict || rr.Header().Get("Retry-After") != "5" {
+		t.Errorf("unexpected response: %d %v", rr.Code, rr.Header())
+	}
+	var body httperror.HttpError
+	if err := json.NewDecoder(rr.Body).Decode(&body); err != nil {
+		t.Fatalf("could not decode response body: %v", err)
+	}
+	if body.Code != "email_taken" || body.Message != "already taken" {
+		t.Errorf("unexpected body: %+v", body)
+	}
+}
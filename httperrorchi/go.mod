@@ -0,0 +1,13 @@
+module github.com/DevNewbie1826/httperror/httperrorchi
+
+go 1.23
+
+replace github.com/DevNewbie1826/httperror => ../
+
+require (
+	github.com/DevNewbie1826/httperror v0.0.0-00010101000000-000000000000
+	github.com/go-chi/chi/v5 v5.3.2
+	github.com/go-chi/render v1.0.3
+)
+
+require github.com/ajg/form v1.5.1 // indirect
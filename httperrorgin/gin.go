@@ -0,0 +1,52 @@
+// Package httperrorgin integrates httperror with the gin web framework: its
+// middleware renders the errors handlers attach to the gin context through
+// httperror.Respond, which gin's default error handling does not do.
+// It lives in its own module to keep the gin dependency out of httperror.
+//
+//	r := gin.New()
+//	r.Use(httperrorgin.Middleware())
+package httperrorgin
+
+import (
+	"errors"
+
+	"github.com/DevNewbie1826/httperror"
+	"github.com/gin-gonic/gin"
+)
+
+// Middleware returns a gin middleware that, after the handlers have run,
+// renders the errors collected in c.Errors through httperror.Respond unless a
+// response has already been written. A single error is responded as is;
+// several errors are joined, so the most severe one is rendered according to
+// httperror.SetJoinStrategy.
+// Middleware는 핸들러 실행 후 c.Errors에 수집된 오류를 httperror.Respond로 렌더링하는 gin 미들웨어를 반환합니다.
+// 응답이 이미 작성된 경우에는 렌더링하지 않습니다. 여러 오류는 결합되어 httperror.SetJoinStrategy에 따라
+// 가장 심각한 오류가 렌더링됩니다.
+func Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		if len(c.Errors) == 0 || c.Writer.Written() {
+			return
+		}
+		errs := make([]error, len(c.Errors))
+		for i, e := range c.Errors {
+			errs[i] = e.Err
+		}
+		err := errs[0]
+		if len(errs) > 1 {
+			err = errors.Join(errs...)
+		}
+		httperror.Respond(c.Writer, c.Request, err)
+	}
+}
+
+// Abort stops the handler chain and responds with e through httperror.Respond.
+// The error is also attached to c.Errors for logging middlewares.
+// Abort는 핸들러 체인을 중단하고 httperror.Respond로 e를 응답합니다.
+// 로깅 미들웨어를 위해 오류는 c.Errors에도 추가됩니다.
+func Abort(c *gin.Context, e *httperror.HttpError) {
+	c.Abort()
+	c.Error(e)
+	httperror.Respond(c.Writer, c.Request, e)
+}
@@ -0,0 +1,66 @@
+package httperrorgin
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DevNewbie1826/httperror"
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+// TestMiddleware tests that errors attached to the gin context are rendered.
+func TestMiddleware(t *testing.T) {
+	testCases := []struct {
+		name           string
+		handler        gin.HandlerFunc
+		expectedStatus int
+	}{
+		{"no error", func(c *gin.Context) { c.Status(http.StatusOK) }, http.StatusOK},
+		{"single error", func(c *gin.Context) { c.Error(httperror.NotFoundError()) }, http.StatusNotFound},
+		{"most severe", func(c *gin.Context) {
+			c.Error(httperror.BadRequestError())
+			c.Error(httperror.ServiceUnavailableError())
+		}, http.StatusServiceUnavailable},
+		{"plain error", func(c *gin.Context) { c.Error(errors.New("boom")) }, http.StatusInternalServerError},
+		{"already written", func(c *gin.Context) {
+			c.String(http.StatusAccepted, "queued")
+			c.Error(httperror.ConflictError())
+		}, http.StatusAccepted},
+		{"abort", func(c *gin.Context) { Abort(c, httperror.ForbiddenError("no access")) }, http.StatusForbidden},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := gin.New()
+			r.Use(Middleware())
+			r.GET("/", tc.handler, func(c *gin.Context) {
+				if c.IsAborted() {
+					t.Error("expected the chain to stop after Abort")
+				}
+			})
+
+			rr := httptest.NewRecorder()
+			r.ServeHTTP(rr, httptest.NewRequest("GET", "/", nil))
+			if rr.Code != tc.expectedStatus {
+				t.Errorf("expected status %d, got %d", tc.expectedStatus, rr.Code)
+			}
+			if tc.expectedStatus < 400 {
+				return
+			}
+			var body httperror.HttpError
+			if err := json.NewDecoder(rr.Body).Decode(&body); err != nil {
+				t.Fatalf("could not decode response body: %v", err)
+			}
+			if body.Status != tc.expectedStatus {
+				t.Errorf("expected body status %d, got %d", tc.expectedStatus, body.Status)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Package gateway adapts grpc-gateway's error handling to httperror, so gateway
+// deployments emit the same JSON/HTML error shape as plain HTTP endpoints.
+//
+//	mux := runtime.NewServeMux(
+//		runtime.WithErrorHandler(gateway.ErrorHandler),
+//		runtime.WithRoutingErrorHandler(gateway.RoutingErrorHandler),
+//	)
+package gateway
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/DevNewbie1826/httperror"
+	"github.com/DevNewbie1826/httperror/httperrorgrpc"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+)
+
+// ErrorHandler is a runtime.ErrorHandlerFunc rendering gRPC errors through
+// httperror.Respond. The gRPC status code is mapped to the corresponding HTTP
+// status; the marshaler is ignored in favor of httperror's content negotiation.
+// ErrorHandler는 gRPC 오류를 httperror.Respond로 렌더링하는 runtime.ErrorHandlerFunc입니다.
+func ErrorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	var statusErr *runtime.HTTPStatusError
+	if errors.As(err, &statusErr) {
+		// The error may be shared, e.g. a sentinel, so its status is changed on a copy.
+		httpErr := httperrorgrpc.FromGRPCStatus(statusErr.Err).Clone()
+		httpErr.Status = statusErr.HTTPStatus
+		httperror.Respond(w, r, httpErr)
+		return
+	}
+	httperror.Respond(w, r, httperrorgrpc.FromGRPCStatus(err))
+}
+
+// RoutingErrorHandler is a runtime.RoutingErrorHandlerFunc rendering routing
+// errors (404, 405, ...) through httperror.Respond. runtime.ServeMux does not
+// expose its routes, so 405 responses carry no Allow header; see
+// RoutingErrorHandlerWithAllow.
+// RoutingErrorHandler는 라우팅 오류(404, 405 등)를 httperror.Respond로 렌더링하는 runtime.RoutingErrorHandlerFunc입니다.
+// runtime.ServeMux는 경로 목록을 제공하지 않으므로 405 응답에 Allow 헤더가 없습니다(RoutingErrorHandlerWithAllow 참고).
+func RoutingErrorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, httpStatus int) {
+	httperror.Respond(w, r, httperror.New(httpStatus, httperror.StatusText(httpStatus)))
+}
+
+// RoutingErrorHandlerWithAllow returns a RoutingErrorHandler whose 405
+// responses list the methods returned by allow for the request in the Allow
+// header, e.g. from the routes the mux was registered with.
+// RoutingErrorHandlerWithAllow는 405 응답의 Allow 헤더에 allow가 요청에 대해 반환하는 메서드를 나열하는
+// RoutingErrorHandler를 반환합니다. 예를 들어 mux에 등록한 경로로부터 메서드를 구할 수 있습니다.
+func RoutingErrorHandlerWithAllow(allow func(r *http.Request) []string) runtime.RoutingErrorHandlerFunc {
+	return func(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, httpStatus int) {
+		httpErr := httperror.New(httpStatus, httperror.StatusText(httpStatus))
+		if httpStatus == http.StatusMethodNotAllowed {
+			if methods := allow(r); len(methods) > 0 {
+				httpErr = httpErr.With(httperror.WithAllow(methods...))
+			}
+		}
+		httperror.Respond(w, r, httpErr)
+	}
+}
@@ -0,0 +1,116 @@
+package gateway
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/DevNewbie1826/httperror"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestErrorHandler tests that gateway errors are rendered in httperror's shape.
+func TestErrorHandler(t *testing.T) {
+	mux := runtime.NewServeMux(
+		runtime.WithErrorHandler(ErrorHandler),
+		runtime.WithRoutingErrorHandler(RoutingErrorHandler),
+	)
+
+	t.Run("gRPC status", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", "/v1/users/1", nil)
+		ErrorHandler(req.Context(), mux, &runtime.JSONPb{}, rr, req, status.Error(codes.NotFound, "user missing"))
+
+		if rr.Code != http.StatusNotFound {
+			t.Errorf("expected status %d, got %d", http.StatusNotFound, rr.Code)
+		}
+		var body httperror.HttpError
+		if err := json.NewDecoder(rr.Body).Decode(&body); err != nil {
+			t.Fatalf("could not decode response body: %v", err)
+		}
+		if body.Status != http.StatusNotFound || body.Message != "user missing" {
+			t.Errorf("unexpected body: %+v", body)
+		}
+	})
+
+	t.Run("HTTP status error", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req := httptest.NewRequest("DELETE", "/v1/users", nil)
+		err := &runtime.HTTPStatusError{HTTPStatus: http.StatusMethodNotAllowed, Err: status.Error(codes.Unimplemented, "Method Not Allowed")}
+		ErrorHandler(req.Context(), mux, &runtime.JSONPb{}, rr, req, err)
+
+		if rr.Code != http.StatusMethodNotAllowed {
+			t.Errorf("expected status %d, got %d", http.StatusMethodNotAllowed, rr.Code)
+		}
+	})
+
+	t.Run("routing error", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, httptest.NewRequest("GET", "/unknown", nil))
+
+		if rr.Code != http.StatusNotFound {
+			t.Errorf("expected status %d, got %d", http.StatusNotFound, rr.Code)
+		}
+		if rr.Header().Get("Content-Type") != "application/json; charset=utf-8" {
+			t.Errorf("expected httperror's JSON content type, got %q", rr.Header().Get("Content-Type"))
+		}
+	})
+}
+
+// TestErrorHandlerKeepsSharedErrors tests that rendering an HTTP status error does not modify the wrapped error.
+func TestErrorHandlerKeepsSharedErrors(t *testing.T) {
+	rr := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/v1/users/1", nil)
+	ErrorHandler(req.Context(), runtime.NewServeMux(), &runtime.JSONPb{}, rr, req, &runtime.HTTPStatusError{HTTPStatus: http.StatusGone, Err: httperror.ErrNotFound})
+
+	if rr.Code != http.StatusGone {
+		t.Errorf("expected status %d, got %d", http.StatusGone, rr.Code)
+	}
+	if httperror.ErrNotFound.Status != http.StatusNotFound {
+		t.Errorf("expected the shared error to be left untouched, got status %d", httperror.ErrNotFound.Status)
+	}
+}
+
+// TestRoutingErrorHandlerAllow tests listing the methods of a path on 405 responses.
+func TestRoutingErrorHandlerAllow(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {}
+	allow := func(r *http.Request) []string {
+		if strings.HasPrefix(r.URL.Path, "/v1/users/") {
+			return []string{"GET", "POST"}
+		}
+		return nil
+	}
+
+	testCases := []struct {
+		name          string
+		handler       runtime.RoutingErrorHandlerFunc
+		expectedAllow string
+	}{
+		{"with allow", RoutingErrorHandlerWithAllow(allow), "GET, POST"},
+		{"without allow", RoutingErrorHandler, ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mux := runtime.NewServeMux(runtime.WithRoutingErrorHandler(tc.handler))
+			for _, m := range []string{"GET", "POST"} {
+				if err := mux.HandlePath(m, "/v1/users/{id}", handler); err != nil {
+					t.Fatal(err)
+				}
+			}
+			rr := httptest.NewRecorder()
+			mux.ServeHTTP(rr, httptest.NewRequest("DELETE", "/v1/users/7", nil))
+
+			if rr.Code != http.StatusMethodNotAllowed {
+				t.Errorf("expected status %d, got %d", http.StatusMethodNotAllowed, rr.Code)
+			}
+			if got := rr.Header().Get("Allow"); got != tc.expectedAllow {
+				t.Errorf("expected Allow %q, got %q", tc.expectedAllow, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+module github.com/DevNewbie1826/httperror/httperrorgrpc
+
+go 1.26.0
+
+replace github.com/DevNewbie1826/httperror => ../
+
+require (
+	github.com/DevNewbie1826/httperror v0.0.0-00010101000000-000000000000
+	github.com/grpc-ecosystem/grpc-gateway/v2 v2.31.0
+	google.golang.org/grpc v1.84.0
+)
+
+require (
+	golang.org/x/net v0.59.0 // indirect
+	golang.org/x/sys v0.48.0 // indirect
+	golang.org/x/text v0.42.0 // indirect
+	google.golang.org/genproto/googleapis/api v0.0.0-20260921155816-b14227669459 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20260918162117-cecb64721679 // indirect
+	google.golang.org/protobuf v1.36.12 // indirect
+)
@@ -0,0 +1,95 @@
+// Package httperrorgrpc converts between httperror.HttpError and gRPC statuses,
+// so mixed gRPC/REST stacks share one error vocabulary.
+// It lives in its own module to keep the gRPC dependency out of httperror.
+//
+// For the same reason HttpError has no GRPCStatus method, so status.FromError
+// does not recognize it. Convert HttpErrors with Error, or install
+// UnaryServerInterceptor and StreamServerInterceptor so the HttpErrors
+// returned by handlers reach clients with their gRPC code:
+//
+//	srv := grpc.NewServer(
+//		grpc.ChainUnaryInterceptor(httperrorgrpc.UnaryServerInterceptor),
+//		grpc.ChainStreamInterceptor(httperrorgrpc.StreamServerInterceptor),
+//	)
+package httperrorgrpc
+
+import (
+	"context"
+
+	"github.com/DevNewbie1826/httperror"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// FromGRPCStatus converts an error carrying a gRPC status into an HttpError.
+// The status code is mapped to the corresponding HTTP status and the status
+// message becomes the error message. Errors that already are HttpErrors are
+// returned as is, errors without a gRPC status are resolved like
+// httperror.Respond does (see httperror.Resolve), and nil results in nil.
+// FromGRPCStatus는 gRPC 상태를 담은 오류를 HttpError로 변환합니다. gRPC 상태가 없는 오류는
+// httperror.Respond처럼 변환되며(httperror.Resolve 참고), nil이면 nil을 반환합니다.
+func FromGRPCStatus(err error) *httperror.HttpError {
+	if err == nil {
+		return nil
+	}
+	if httpErr, ok := httperror.FromError(err); ok {
+		return httpErr
+	}
+
+	s, ok := status.FromError(err)
+	if !ok {
+		return httperror.Resolve(err)
+	}
+	msg := s.Message()
+	if msg == "" {
+		msg = s.Code().String()
+	}
+	return httperror.New(httperror.HTTPStatusFromGRPCCode(uint32(s.Code())), msg)
+}
+
+// GRPCStatus converts an HttpError into a gRPC status with the corresponding code.
+// GRPCStatus는 HttpError를 대응하는 코드의 gRPC 상태로 변환합니다.
+func GRPCStatus(e *httperror.HttpError) *status.Status {
+	return status.New(codes.Code(e.GRPCCode()), e.Message)
+}
+
+// UnaryServerInterceptor is a grpc.UnaryServerInterceptor converting the
+// HttpErrors returned by handlers, or wrapped in their errors, into gRPC
+// statuses with the corresponding codes, see Error. HttpError cannot
+// implement GRPCStatus itself without making httperror depend on gRPC.
+// UnaryServerInterceptor는 핸들러가 반환하거나 오류에 감싼 HttpError를 대응하는 코드의 gRPC 상태로 변환하는
+// grpc.UnaryServerInterceptor입니다(Error 참고). HttpError가 직접 GRPCStatus를 구현하면 httperror가 gRPC에 의존하게 됩니다.
+func UnaryServerInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	resp, err := handler(ctx, req)
+	return resp, statusError(err)
+}
+
+// StreamServerInterceptor is the grpc.StreamServerInterceptor counterpart of
+// UnaryServerInterceptor.
+// StreamServerInterceptor는 UnaryServerInterceptor에 대응하는 grpc.StreamServerInterceptor입니다.
+func StreamServerInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return statusError(handler(srv, ss))
+}
+
+// statusError converts err into a gRPC status error if it wraps an HttpError
+// and carries no gRPC status.
+func statusError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if e, ok := httperror.FromError(err); ok {
+		return Error(e)
+	}
+	return err
+}
+
+// Error converts an HttpError into an error that gRPC servers return to their
+// clients with the corresponding status code.
+// Error는 HttpError를 gRPC 서버가 대응하는 상태 코드로 반환하는 오류로 변환합니다.
+func Error(e *httperror.HttpError) error {
+	return GRPCStatus(e).Err()
+}
@@ -0,0 +1,104 @@
+package httperrorgrpc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/DevNewbie1826/httperror"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestFromGRPCStatus tests the conversion of gRPC errors into HttpErrors.
+func TestFromGRPCStatus(t *testing.T) {
+	testCases := []struct {
+		name           string
+		err            error
+		expectedStatus int
+		expectedMsg    string
+	}{
+		{"not found", status.Error(codes.NotFound, "user missing"), http.StatusNotFound, "user missing"},
+		{"unavailable", status.Error(codes.Unavailable, "backend down"), http.StatusServiceUnavailable, "backend down"},
+		{"empty message", status.Error(codes.PermissionDenied, ""), http.StatusForbidden, "PermissionDenied"},
+		{"wrapped HttpError", fmt.Errorf("call: %w", httperror.ConflictError("taken")), http.StatusConflict, "taken"},
+		{"plain error", fmt.Errorf("dial tcp 10.0.0.7:5432: refused"), http.StatusInternalServerError, "Internal Server Error"},
+		{"deadline", fmt.Errorf("call: %w", context.DeadlineExceeded), http.StatusGatewayTimeout, "Gateway Timeout"},
+		{"canceled", context.Canceled, http.StatusRequestTimeout, "Request Timeout"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := FromGRPCStatus(tc.err)
+			if got.Status != tc.expectedStatus || got.Message != tc.expectedMsg {
+				t.Errorf("expected %d %q, got %d %q", tc.expectedStatus, tc.expectedMsg, got.Status, got.Message)
+			}
+		})
+	}
+
+	if FromGRPCStatus(nil) != nil {
+		t.Error("expected nil for a nil error")
+	}
+}
+
+// TestGRPCStatus tests the conversion of HttpErrors into gRPC statuses.
+func TestGRPCStatus(t *testing.T) {
+	s := GRPCStatus(httperror.TooManyRequestsError("slow down"))
+	if s.Code() != codes.ResourceExhausted || s.Message() != "slow down" {
+		t.Errorf("unexpected status: %v", s)
+	}
+
+	// HttpError has no GRPCStatus method, so it must go through Error.
+	if s, ok := status.FromError(httperror.NotFoundError()); ok || s.Code() != codes.Unknown {
+		t.Errorf("expected a bare HttpError to be Unknown, got %v", s)
+	}
+
+	err := Error(httperror.NotFoundError())
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("expected NotFound, got %v", status.Code(err))
+	}
+
+	// Round trip.
+	if got := FromGRPCStatus(err); got.Status != http.StatusNotFound {
+		t.Errorf("expected round trip to 404, got %d", got.Status)
+	}
+}
+
+// TestServerInterceptors tests that HttpErrors returned by handlers reach clients with their gRPC code.
+func TestServerInterceptors(t *testing.T) {
+	testCases := []struct {
+		name         string
+		err          error
+		expectedCode codes.Code
+		expectedMsg  string
+	}{
+		{"HttpError", httperror.NotFoundError("user missing"), codes.NotFound, "user missing"},
+		{"wrapped HttpError", fmt.Errorf("loading user: %w", httperror.TooManyRequestsError()), codes.ResourceExhausted, "Too Many Requests"},
+		{"status", status.Error(codes.Aborted, "retry"), codes.Aborted, "retry"},
+		{"plain error", fmt.Errorf("boom"), codes.Unknown, "boom"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := UnaryServerInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req any) (any, error) {
+				return nil, tc.err
+			})
+			if s := status.Convert(err); s.Code() != tc.expectedCode || s.Message() != tc.expectedMsg {
+				t.Errorf("unary: expected %v %q, got %v %q", tc.expectedCode, tc.expectedMsg, s.Code(), s.Message())
+			}
+
+			err = StreamServerInterceptor(nil, nil, &grpc.StreamServerInfo{}, func(srv any, ss grpc.ServerStream) error {
+				return tc.err
+			})
+			if s := status.Convert(err); s.Code() != tc.expectedCode || s.Message() != tc.expectedMsg {
+				t.Errorf("stream: expected %v %q, got %v %q", tc.expectedCode, tc.expectedMsg, s.Code(), s.Message())
+			}
+		})
+	}
+
+	if _, err := UnaryServerInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req any) (any, error) {
+		return "ok", nil
+	}); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
@@ -0,0 +1,30 @@
+// Package httperrorotel adds the IDs of the OpenTelemetry trace and span
+// active in the request context to error responses, so users can paste them
+// into support tickets and engineers can jump straight to the trace. It lives
+// in its own module to keep the OpenTelemetry dependency out of httperror.
+//
+//	import _ "github.com/DevNewbie1826/httperror/httperrorotel"
+//
+// Use httperror.WithTraceIDs(false) to leave them out of a Responder's responses.
+package httperrorotel
+
+import (
+	"context"
+
+	"github.com/DevNewbie1826/httperror"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func init() {
+	httperror.RegisterTraceFunc(TraceIDs)
+}
+
+// TraceIDs returns the IDs of the span in ctx and of its trace, or empty
+// strings if ctx carries no valid span context.
+func TraceIDs(ctx context.Context) (traceID, spanID string) {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return "", ""
+	}
+	return sc.TraceID().String(), sc.SpanID().String()
+}
@@ -0,0 +1,39 @@
+package httperrorotel
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DevNewbie1826/httperror"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TestTraceIDs tests that error responses carry the IDs of the active span.
+func TestTraceIDs(t *testing.T) {
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	sc := trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: spanID, TraceFlags: trace.FlagsSampled})
+
+	testCases := []struct {
+		name         string
+		span         bool
+		expectedBody string
+	}{
+		{"span", true, `{"status":503,"message":"Service Unavailable","details":{"span_id":"00f067aa0ba902b7","trace_id":"4bf92f3577b34da6a3ce929d0e0e4736"}}`},
+		{"no span", false, `{"status":503,"message":"Service Unavailable"}`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/", nil)
+			if tc.span {
+				req = req.WithContext(trace.ContextWithSpanContext(req.Context(), sc))
+			}
+			rr := httptest.NewRecorder()
+			httperror.ServiceUnavailable(rr, req)
+
+			if got := rr.Body.String(); got != tc.expectedBody+"\n" {
+				t.Errorf("expected body %s, got %s", tc.expectedBody, got)
+			}
+		})
+	}
+}
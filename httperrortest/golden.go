@@ -0,0 +1,96 @@
+package httperrortest
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/DevNewbie1826/httperror"
+)
+
+// update is the -update flag rewriting the golden files instead of comparing them.
+var update = flag.Bool("update", false, "rewrite the golden files of httperrortest.AssertGolden")
+
+// DefaultGoldenEncoders are the encoders AssertGolden uses when none are given.
+// DefaultGoldenEncoders는 인코더가 주어지지 않았을 때 AssertGolden이 사용하는 인코더입니다.
+var DefaultGoldenEncoders = map[string]httperror.Encoder{
+	"json": httperror.JSONEncoder{},
+	"html": httperror.HTMLEncoder{},
+}
+
+// AssertGolden renders the default error of every status listed by
+// httperror.Statuses through rs with each encoder, and compares the responses
+// with the golden files in dir, named "<status>.<encoder name>.golden". The
+// configuration of rs, such as its envelope, field names and templates, is
+// kept; only the encoder is fixed. Running the tests with -update writes the
+// golden files instead, which locks the public error format against
+// accidental changes. A nil rs means httperror.DefaultResponder and a nil
+// encoders map means DefaultGoldenEncoders.
+// AssertGolden은 httperror.Statuses가 나열하는 모든 상태 코드의 기본 오류를 rs를 통해 각 인코더로 렌더링하고,
+// dir의 골든 파일("<상태 코드>.<인코더 이름>.golden")과 비교합니다. 봉투, 필드 이름, 템플릿 같은 rs의 설정은
+// 유지되며 인코더만 고정됩니다. -update 플래그로 테스트를 실행하면 골든 파일을 새로 작성하며, 이를 통해
+// 공개 오류 형식이 실수로 변경되는 것을 막을 수 있습니다. rs가 nil이면 httperror.DefaultResponder를,
+// encoders가 nil이면 DefaultGoldenEncoders를 사용합니다.
+func AssertGolden(t testing.TB, dir string, rs *httperror.Responder, encoders map[string]httperror.Encoder) {
+	t.Helper()
+	if rs == nil {
+		rs = httperror.DefaultResponder()
+	}
+	if encoders == nil {
+		encoders = DefaultGoldenEncoders
+	}
+	names := make([]string, 0, len(encoders))
+	for name := range encoders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	if *update {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			t.Fatalf("could not create golden directory: %v", err)
+			return
+		}
+	}
+
+	for _, status := range httperror.Statuses() {
+		for _, name := range names {
+			got := renderGolden(rs, encoders[name], status)
+			path := filepath.Join(dir, fmt.Sprintf("%d.%s.golden", status, name))
+
+			if *update {
+				if err := os.WriteFile(path, got, 0o644); err != nil {
+					t.Errorf("could not write golden file: %v", err)
+				}
+				continue
+			}
+
+			want, err := os.ReadFile(path)
+			if err != nil {
+				t.Errorf("could not read golden file (run the tests with -update to create it): %v", err)
+				continue
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("%s: response differs from the golden file\ngot:\n%s\nwant:\n%s", path, got, want)
+			}
+		}
+	}
+}
+
+// renderGolden renders the default error of status through a clone of rs
+// fixed to enc, as the status line, the Content-Type and the body.
+func renderGolden(rs *httperror.Responder, enc httperror.Encoder, status int) []byte {
+	c := rs.Clone()
+	c.SetEncoder(enc)
+	rr := httptest.NewRecorder()
+	c.HandleError(rr, httptest.NewRequest("GET", "/", nil), httperror.New(status, ""))
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "%d\nContent-Type: %s\n\n", rr.Code, rr.Header().Get("Content-Type"))
+	b.Write(rr.Body.Bytes())
+	return b.Bytes()
+}
@@ -0,0 +1,73 @@
+package httperrortest
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/DevNewbie1826/httperror"
+)
+
+// TestAssertGolden tests writing, matching and detecting changed golden files.
+func TestAssertGolden(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "golden")
+
+	rec := &recordingTB{TB: t}
+	AssertGolden(rec, dir, nil, nil)
+	if len(rec.failures) == 0 {
+		t.Error("expected missing golden files to fail")
+	}
+
+	*update = true
+	AssertGolden(t, dir, nil, nil)
+	*update = false
+
+	rec = &recordingTB{TB: t}
+	AssertGolden(rec, dir, nil, nil)
+	if len(rec.failures) != 0 {
+		t.Errorf("expected the golden files to match, got %v", rec.failures)
+	}
+
+	path := filepath.Join(dir, "404.json.golden")
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "404\nContent-Type: application/json; charset=utf-8\n\n{\"status\":404,\"message\":\"Not Found\"}\n" {
+		t.Errorf("unexpected golden file: %q", data)
+	}
+	if err := os.WriteFile(path, []byte("changed"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	rec = &recordingTB{TB: t}
+	AssertGolden(rec, dir, nil, nil)
+	if len(rec.failures) != 1 {
+		t.Errorf("expected the changed golden file to fail, got %v", rec.failures)
+	}
+}
+
+// TestAssertGoldenResponder tests that golden files are rendered with the Responder's configuration.
+func TestAssertGoldenResponder(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "golden")
+	rs := httperror.NewResponder(httperror.WithEnvelope("error"), httperror.WithFieldNames(map[string]string{"message": "detail"}))
+
+	*update = true
+	AssertGolden(t, dir, rs, map[string]httperror.Encoder{"json": httperror.JSONEncoder{}})
+	*update = false
+
+	data, err := os.ReadFile(filepath.Join(dir, "404.json.golden"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasSuffix(string(data), "{\"error\":{\"status\":404,\"detail\":\"Not Found\"}}\n") {
+		t.Errorf("expected the configured envelope and field names, got %q", data)
+	}
+
+	rec := &recordingTB{TB: t}
+	AssertGolden(rec, dir, httperror.NewResponder(), map[string]httperror.Encoder{"json": httperror.JSONEncoder{}})
+	if len(rec.failures) == 0 {
+		t.Error("expected a differently configured Responder to fail")
+	}
+}
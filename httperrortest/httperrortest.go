@@ -0,0 +1,58 @@
+// Package httperrortest provides assertions for testing the error responses
+// of handlers built on httperror.
+//
+//	rr := httptest.NewRecorder()
+//	handler.ServeHTTP(rr, httptest.NewRequest("GET", "/users/7", nil))
+//	httperrortest.AssertStatus(t, rr, http.StatusNotFound)
+//	httperrortest.AssertErrorBody(t, rr, "user_not_found", "user not found")
+package httperrortest
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DevNewbie1826/httperror"
+)
+
+// AssertStatus reports an error if the recorded response does not have the given status.
+// AssertStatus는 기록된 응답의 상태 코드가 주어진 값과 다르면 오류를 보고합니다.
+func AssertStatus(t testing.TB, rr *httptest.ResponseRecorder, want int) {
+	t.Helper()
+	if rr.Code != want {
+		t.Errorf("expected status %d, got %d (body %q)", want, rr.Code, rr.Body.String())
+	}
+}
+
+// AssertErrorBody reports an error if the recorded error body does not have
+// the given code and message. An empty wantCode expects no code.
+// AssertErrorBody는 기록된 오류 본문의 코드와 메시지가 주어진 값과 다르면 오류를 보고합니다.
+// wantCode가 비어 있으면 코드가 없어야 합니다.
+func AssertErrorBody(t testing.TB, rr *httptest.ResponseRecorder, wantCode, wantMsg string) {
+	t.Helper()
+	e := DecodeError(t, rr)
+	if e == nil {
+		return
+	}
+	if e.Code != wantCode {
+		t.Errorf("expected error code %q, got %q", wantCode, e.Code)
+	}
+	if e.Message != wantMsg {
+		t.Errorf("expected error message %q, got %q", wantMsg, e.Message)
+	}
+}
+
+// DecodeError decodes the recorded JSON error body, failing the test if it
+// cannot be decoded. The body of the recorder is left unread.
+// DecodeError는 기록된 JSON 오류 본문을 디코딩하며, 디코딩할 수 없으면 테스트를 실패시킵니다.
+// 레코더의 본문은 읽지 않은 상태로 유지됩니다.
+func DecodeError(t testing.TB, rr *httptest.ResponseRecorder) *httperror.HttpError {
+	t.Helper()
+	var e httperror.HttpError
+	if err := json.NewDecoder(bytes.NewReader(rr.Body.Bytes())).Decode(&e); err != nil {
+		t.Fatalf("could not decode error body %q: %v", rr.Body.String(), err)
+		return nil
+	}
+	return &e
+}
@@ -0,0 +1,61 @@
+package httperrortest
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DevNewbie1826/httperror"
+)
+
+// recordingTB records the failures reported through it.
+type recordingTB struct {
+	testing.TB
+	failures []string
+}
+
+func (r *recordingTB) Helper() {}
+
+func (r *recordingTB) Errorf(format string, args ...any) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+func (r *recordingTB) Fatalf(format string, args ...any) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+// TestAssertions tests which responses the assertions accept.
+func TestAssertions(t *testing.T) {
+	notFound := httptest.NewRecorder()
+	httperror.NotFound(notFound, httptest.NewRequest("GET", "/", nil), "user not found", httperror.WithCode("user_not_found"))
+
+	plain := httptest.NewRecorder()
+	http.Error(plain, "not found", http.StatusNotFound)
+
+	testCases := []struct {
+		name     string
+		assert   func(t testing.TB)
+		failures int
+	}{
+		{"status", func(t testing.TB) { AssertStatus(t, notFound, http.StatusNotFound) }, 0},
+		{"wrong status", func(t testing.TB) { AssertStatus(t, notFound, http.StatusForbidden) }, 1},
+		{"body", func(t testing.TB) { AssertErrorBody(t, notFound, "user_not_found", "user not found") }, 0},
+		{"wrong code and message", func(t testing.TB) { AssertErrorBody(t, notFound, "", "gone") }, 2},
+		{"undecodable body", func(t testing.TB) { AssertErrorBody(t, plain, "", "not found") }, 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := &recordingTB{TB: t}
+			tc.assert(rec)
+			if len(rec.failures) != tc.failures {
+				t.Errorf("expected %d failures, got %v", tc.failures, rec.failures)
+			}
+		})
+	}
+
+	if e := DecodeError(t, notFound); e.Status != http.StatusNotFound || notFound.Body.Len() == 0 {
+		t.Errorf("expected the body to be decoded and kept, got %+v", e)
+	}
+}
@@ -0,0 +1,10 @@
+module github.com/DevNewbie1826/httperror/httperrortext
+
+go 1.26.0
+
+replace github.com/DevNewbie1826/httperror => ../
+
+require (
+	github.com/DevNewbie1826/httperror v0.0.0-00010101000000-000000000000
+	golang.org/x/text v0.42.0
+)
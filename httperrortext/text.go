@@ -0,0 +1,122 @@
+// Package httperrortext plugs golang.org/x/text/message into httperror as its
+// translation backend, so teams already managing strings with x/text keep
+// error messages in the same catalogs, with plural and variable support.
+// It lives in its own module to keep the x/text dependency out of httperror.
+//
+//	httperror.SetTranslator(httperrortext.New(catalog.DefaultCatalog))
+//	httperror.UnprocessableEntity(w, r, "%d items failed", httperror.WithMessageArgs(n))
+package httperrortext
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/DevNewbie1826/httperror"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/message/catalog"
+)
+
+// Translator is an httperror.Translator formatting messages with x/text
+// printers. Messages are looked up by key in the catalog of the printer, so
+// plural forms and variables declared there apply to the message arguments.
+// Translator는 x/text 프린터로 메시지를 서식화하는 httperror.Translator입니다.
+// 메시지는 프린터의 카탈로그에서 키로 조회되므로 카탈로그에 선언된 복수형과 변수가 메시지 인자에 적용됩니다.
+type Translator struct {
+	cat     catalog.Catalog
+	langs   []string
+	printer func(lang string) *message.Printer
+
+	mu       sync.Mutex
+	printers map[string]*message.Printer
+}
+
+var _ httperror.Translator = (*Translator)(nil)
+
+// New returns a Translator backed by cat, supporting the languages of cat.
+// New는 cat의 언어를 지원하는 cat 기반 Translator를 반환합니다.
+func New(cat catalog.Catalog) *Translator {
+	tags := cat.Languages()
+	langs := make([]string, len(tags))
+	for i, tag := range tags {
+		langs[i] = tag.String()
+	}
+	return &Translator{
+		cat:   cat,
+		langs: langs,
+		printer: func(lang string) *message.Printer {
+			return message.NewPrinter(language.Make(lang), message.Catalog(cat))
+		},
+	}
+}
+
+// FromPrinters returns a Translator using the given printer of each language
+// tag, for applications that already build their printers. cat is the
+// catalog of the printers, in which messages are looked up.
+// FromPrinters는 언어 태그별로 주어진 프린터를 사용하는 Translator를 반환합니다. 이미 프린터를 생성해 둔 애플리케이션에 사용합니다.
+// cat은 메시지를 조회할 프린터의 카탈로그입니다.
+func FromPrinters(cat catalog.Catalog, printers map[string]*message.Printer) *Translator {
+	t := &Translator{cat: cat, printers: make(map[string]*message.Printer, len(printers))}
+	for lang, p := range printers {
+		t.langs = append(t.langs, lang)
+		t.printers[lang] = p
+	}
+	return t
+}
+
+// Languages implements httperror.Translator.
+func (t *Translator) Languages() []string {
+	return t.langs
+}
+
+// Translate implements httperror.Translator. It returns false for messages
+// missing from the catalog, and formats the translation only when args are
+// given, so messages containing "%" are kept as they are.
+func (t *Translator) Translate(lang, msg string, args ...any) (string, bool) {
+	p := t.lookup(lang)
+	if p == nil {
+		return "", false
+	}
+	r := &renderer{args: args}
+	if err := t.cat.Context(language.Make(lang), r).Execute(msg); err != nil {
+		return "", false
+	}
+	if len(args) == 0 {
+		return r.b.String(), true
+	}
+	return p.Sprintf(msg, args...), true
+}
+
+// renderer collects the unformatted text of a catalog message, selected
+// according to the arguments.
+type renderer struct {
+	args []any
+	b    strings.Builder
+}
+
+func (r *renderer) Render(s string) {
+	r.b.WriteString(s)
+}
+
+func (r *renderer) Arg(i int) any {
+	if i < 1 || i > len(r.args) {
+		return nil
+	}
+	return r.args[i-1]
+}
+
+// lookup returns the printer of lang, creating and caching it if needed.
+func (t *Translator) lookup(lang string) *message.Printer {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if p, ok := t.printers[lang]; ok || t.printer == nil {
+		return p
+	}
+	if t.printers == nil {
+		t.printers = make(map[string]*message.Printer)
+	}
+	p := t.printer(lang)
+	t.printers[lang] = p
+	return p
+}
@@ -0,0 +1,105 @@
+package httperrortext
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DevNewbie1826/httperror"
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/message/catalog"
+)
+
+// newCatalog builds a catalog with a plural English message and its Korean translation.
+func newCatalog(t *testing.T) catalog.Catalog {
+	cat := catalog.NewBuilder()
+	if err := cat.Set(language.English, "%d items failed",
+		plural.Selectf(1, "%d", "=1", "one item failed", "other", "%[1]d items failed")); err != nil {
+		t.Fatal(err)
+	}
+	if err := cat.SetString(language.Korean, "%d items failed", "%d개 항목 실패"); err != nil {
+		t.Fatal(err)
+	}
+	if err := cat.SetString(language.Korean, "Not Found", "찾을 수 없음"); err != nil {
+		t.Fatal(err)
+	}
+	if err := cat.SetString(language.Korean, "discount too high", "할인은 100% 미만이어야 합니다"); err != nil {
+		t.Fatal(err)
+	}
+	return cat
+}
+
+// TestTranslator tests translating responses through x/text catalogs and printers.
+func TestTranslator(t *testing.T) {
+	testCases := []struct {
+		name           string
+		translator     *Translator
+		acceptLanguage string
+		err            *httperror.HttpError
+		expected       string
+	}{
+		{"plural one", New(newCatalog(t)), "en", httperror.UnprocessableEntityError("%d items failed", httperror.WithMessageArgs(1)), "one item failed"},
+		{"plural other", New(newCatalog(t)), "en-US", httperror.UnprocessableEntityError("%d items failed", httperror.WithMessageArgs(3)), "3 items failed"},
+		{"variable", New(newCatalog(t)), "ko", httperror.UnprocessableEntityError("%d items failed", httperror.WithMessageArgs(3)), "3개 항목 실패"},
+		{"status text", New(newCatalog(t)), "ko-KR", httperror.NotFoundError(), "찾을 수 없음"},
+		{"missing message", New(newCatalog(t)), "ko", httperror.NotFoundError("order 7 missing"), "order 7 missing"},
+		{"unsupported language", New(newCatalog(t)), "ja", httperror.NotFoundError(), "Not Found"},
+		{"percent in missing message", New(newCatalog(t)), "ko", httperror.BadRequestError("discount must be below 100%"), "discount must be below 100%"},
+		{"percent in translation", New(newCatalog(t)), "ko", httperror.BadRequestError("discount too high"), "할인은 100% 미만이어야 합니다"},
+		{"printers", FromPrinters(newCatalog(t), map[string]*message.Printer{
+			"ko": message.NewPrinter(language.Korean, message.Catalog(newCatalog(t))),
+		}), "ko", httperror.NotFoundError(), "찾을 수 없음"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			httperror.SetTranslator(tc.translator)
+			defer httperror.SetTranslator(nil)
+
+			req := httptest.NewRequest("GET", "/", nil)
+			req.Header.Set("Accept-Language", tc.acceptLanguage)
+			rr := httptest.NewRecorder()
+			httperror.Respond(rr, req, tc.err)
+
+			var body httperror.HttpError
+			if err := json.NewDecoder(rr.Body).Decode(&body); err != nil {
+				t.Fatalf("could not decode response body: %v", err)
+			}
+			if body.Message != tc.expected {
+				t.Errorf("expected message %q, got %q", tc.expected, body.Message)
+			}
+		})
+	}
+}
+
+// TestTranslate tests looking up messages in the catalog.
+func TestTranslate(t *testing.T) {
+	tr := New(newCatalog(t))
+
+	testCases := []struct {
+		name       string
+		lang       string
+		msg        string
+		args       []any
+		expected   string
+		expectedOK bool
+	}{
+		{"translated", "ko", "Not Found", nil, "찾을 수 없음", true},
+		{"regional tag", "ko-KR", "Not Found", nil, "찾을 수 없음", true},
+		{"formatted", "ko", "%d items failed", []any{3}, "3개 항목 실패", true},
+		{"unformatted translation", "ko", "discount too high", nil, "할인은 100% 미만이어야 합니다", true},
+		{"missing message", "ko", "discount must be below 100%", nil, "", false},
+		{"unsupported language", "ja", "Not Found", nil, "", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := tr.Translate(tc.lang, tc.msg, tc.args...)
+			if got != tc.expected || ok != tc.expectedOK {
+				t.Errorf("expected (%q, %v), got (%q, %v)", tc.expected, tc.expectedOK, got, ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,10 @@
+module github.com/DevNewbie1826/httperror/httperroryaml
+
+go 1.22
+
+replace github.com/DevNewbie1826/httperror => ../
+
+require (
+	github.com/DevNewbie1826/httperror v0.0.0-00010101000000-000000000000
+	gopkg.in/yaml.v3 v3.0.1
+)
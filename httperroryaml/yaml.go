@@ -0,0 +1,20 @@
+// Package httperroryaml registers a decoder for ".yaml" and ".yml" files with
+// httperror.LoadConfig, so error presentation can be configured in YAML.
+// It lives in its own module to keep the YAML dependency out of httperror.
+//
+//	import _ "github.com/DevNewbie1826/httperror/httperroryaml"
+//
+//	if err := httperror.LoadConfig("errors.yaml"); err != nil {
+//		log.Fatal(err)
+//	}
+package httperroryaml
+
+import (
+	"github.com/DevNewbie1826/httperror"
+	"gopkg.in/yaml.v3"
+)
+
+func init() {
+	httperror.RegisterConfigDecoder(".yaml", yaml.Unmarshal)
+	httperror.RegisterConfigDecoder(".yml", yaml.Unmarshal)
+}
@@ -0,0 +1,50 @@
+package httperroryaml
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/DevNewbie1826/httperror"
+)
+
+// TestLoadConfig tests configuring error presentation from a YAML file.
+func TestLoadConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "errors.yaml")
+	data := `mode: release
+envelope: ""
+messages:
+  404: No such page
+status_docs:
+  404: https://docs.example.com/errors/404
+language: ko
+catalogs:
+  ko:
+    No such page: 페이지가 없습니다
+`
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := httperror.ReadConfig(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer httperror.ResetDocs()
+	defer httperror.ResetCatalogs()
+	rs := httperror.NewResponder()
+	cfg.Apply(rs)
+
+	rr := httptest.NewRecorder()
+	rs.HandleError(rr, httptest.NewRequest("GET", "/", nil), httperror.NotFoundError())
+
+	var body httperror.HttpError
+	if err := json.NewDecoder(rr.Body).Decode(&body); err != nil {
+		t.Fatalf("could not decode response body: %v", err)
+	}
+	if body.Message != "페이지가 없습니다" || body.Type != "https://docs.example.com/errors/404" {
+		t.Errorf("unexpected body %+v", body)
+	}
+}
@@ -0,0 +1,232 @@
+package httperror
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// Catalog translates messages into one language. Keys are the untranslated
+// messages: the default status texts such as "Not Found", or the custom
+// messages given to the helpers, which thereby act as message keys.
+// Catalog는 메시지를 한 언어로 번역합니다. 키는 번역 전 메시지로, "Not Found" 같은 기본 상태 텍스트이거나
+// 헬퍼에 전달한 사용자 정의 메시지이며 이 메시지가 곧 메시지 키가 됩니다.
+type Catalog map[string]string
+
+// catalogs holds an immutable snapshot of the registered catalogs by
+// lowercase language tag, replaced as a whole under catalogsMu.
+var (
+	catalogsMu sync.Mutex
+	catalogs   atomic.Pointer[map[string]Catalog]
+)
+
+// RegisterCatalog registers translations for a language tag such as "ko" or
+// "pt-BR". Registering several catalogs for the same language merges them, the
+// later entries winning. Once a catalog is registered, error messages are
+// translated into the language negotiated from the request's Accept-Language
+// header before being encoded; messages without a translation are kept.
+// RegisterCatalog는 "ko", "pt-BR" 같은 언어 태그에 대한 번역을 등록합니다. 같은 언어에 여러 번 등록하면
+// 병합되며 나중 항목이 우선합니다. 카탈로그가 등록되면 오류 메시지는 인코딩 전에 요청의 Accept-Language
+// 헤더로 결정된 언어로 번역되며, 번역이 없는 메시지는 그대로 유지됩니다.
+func RegisterCatalog(lang string, c Catalog) {
+	lang = strings.ToLower(lang)
+	catalogsMu.Lock()
+	defer catalogsMu.Unlock()
+
+	next := make(map[string]Catalog)
+	if cs := catalogs.Load(); cs != nil {
+		for k, v := range *cs {
+			next[k] = v
+		}
+	}
+	merged := make(Catalog, len(next[lang])+len(c))
+	for k, v := range next[lang] {
+		merged[k] = v
+	}
+	for k, v := range c {
+		merged[k] = v
+	}
+	next[lang] = merged
+	catalogs.Store(&next)
+}
+
+// ResetCatalogs removes all registered catalogs.
+// ResetCatalogs는 등록된 모든 카탈로그를 제거합니다.
+func ResetCatalogs() {
+	catalogsMu.Lock()
+	defer catalogsMu.Unlock()
+	catalogs.Store(nil)
+}
+
+// Translator translates messages, used as keys, into the languages it supports.
+// The catalogs registered with RegisterCatalog form the default Translator;
+// SetTranslator plugs another backend, such as golang.org/x/text catalogs.
+// Translator는 키로 사용되는 메시지를 지원하는 언어로 번역합니다. RegisterCatalog로 등록된 카탈로그가
+// 기본 Translator이며, SetTranslator로 golang.org/x/text 카탈로그 같은 다른 백엔드를 연결할 수 있습니다.
+type Translator interface {
+	// Languages returns the language tags messages can be translated into.
+	Languages() []string
+	// Translate returns the translation of message into lang, formatted with
+	// args, or false if there is no translation.
+	Translate(lang, message string, args ...any) (string, bool)
+}
+
+// currentTranslator stores the Translator set with SetTranslator.
+// A nil value means the registered catalogs.
+var currentTranslator atomic.Pointer[Translator]
+
+// SetTranslator sets the backend used to translate error messages, replacing
+// the registered catalogs. If nil is provided, the catalogs are used again.
+// SetTranslator는 오류 메시지 번역에 사용할 백엔드를 설정하며 등록된 카탈로그를 대체합니다.
+// nil이 제공되면 다시 카탈로그를 사용합니다.
+func SetTranslator(t Translator) {
+	if t == nil {
+		currentTranslator.Store(nil)
+		return
+	}
+	currentTranslator.Store(&t)
+}
+
+// translator returns the configured Translator.
+func translator() Translator {
+	if t := currentTranslator.Load(); t != nil {
+		return *t
+	}
+	return catalogTranslator{}
+}
+
+// Translate returns the translation of message into lang by the configured
+// Translator, formatted with args (see WithMessageArgs). With the registered
+// catalogs, a regional tag such as "ko-KR" falls back to the catalog of its
+// base language.
+// Translate는 설정된 Translator로 message를 lang으로 번역하고 args로 서식화한 결과를 반환합니다.
+// 등록된 카탈로그를 사용할 때 "ko-KR" 같은 지역 태그는 기본 언어의 카탈로그를 사용합니다.
+func Translate(lang, message string, args ...any) (string, bool) {
+	return translator().Translate(lang, message, args...)
+}
+
+// catalogTranslator is the Translator backed by the registered catalogs.
+type catalogTranslator struct{}
+
+func (catalogTranslator) Languages() []string {
+	cs := catalogs.Load()
+	if cs == nil {
+		return nil
+	}
+	langs := make([]string, 0, len(*cs))
+	for lang := range *cs {
+		langs = append(langs, lang)
+	}
+	return langs
+}
+
+func (catalogTranslator) Translate(lang, message string, args ...any) (string, bool) {
+	c, ok := catalogFor(strings.ToLower(lang))
+	if !ok {
+		return "", false
+	}
+	translated, ok := c[message]
+	if ok && len(args) > 0 {
+		translated = fmt.Sprintf(translated, args...)
+	}
+	return translated, ok
+}
+
+// catalogFor returns the catalog of a lowercase language tag or of its base language.
+func catalogFor(lang string) (Catalog, bool) {
+	cs := catalogs.Load()
+	if cs == nil {
+		return nil, false
+	}
+	if c, ok := (*cs)[lang]; ok {
+		return c, true
+	}
+	if i := strings.IndexByte(lang, '-'); i > 0 {
+		c, ok := (*cs)[lang[:i]]
+		return c, ok
+	}
+	return nil, false
+}
+
+// negotiateLanguage returns the language supported by the translator that
+// matches the Accept-Language header with the highest quality, falling back
+// from a regional tag to its base language, or "" if there is none.
+func negotiateLanguage(r *http.Request, t Translator) string {
+	if r == nil {
+		return ""
+	}
+	header := r.Header.Get("Accept-Language")
+	if header == "" {
+		return ""
+	}
+
+	type candidate struct {
+		lang string
+		q    float64
+	}
+	var candidates []candidate
+	for _, part := range strings.Split(header, ",") {
+		lang, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		q := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if f, err := strconv.ParseFloat(v, 64); err == nil {
+				q = f
+			}
+		}
+		if lang != "" && lang != "*" && q > 0 {
+			candidates = append(candidates, candidate{strings.ToLower(lang), q})
+		}
+	}
+	if len(candidates) == 0 {
+		return ""
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].q > candidates[j].q })
+
+	supported := make(map[string]string)
+	for _, lang := range t.Languages() {
+		supported[strings.ToLower(lang)] = lang
+	}
+	for _, c := range candidates {
+		if lang, ok := supported[c.lang]; ok {
+			return lang
+		}
+		base, _, _ := strings.Cut(c.lang, "-")
+		if lang, ok := supported[base]; ok {
+			return lang
+		}
+	}
+	return ""
+}
+
+// localize returns a copy of e with its message translated into the language
+// negotiated for r, or fallback if none is, and that language recorded, or e
+// itself when there is nothing to translate. Messages with arguments are
+// formatted even when no translation applies.
+func localize(r *http.Request, e *HttpError, fallback string) *HttpError {
+	t := translator()
+	translated, ok := "", false
+	lang := negotiateLanguage(r, t)
+	if lang == "" {
+		lang = fallback
+	}
+	if lang != "" {
+		translated, ok = t.Translate(lang, e.Message, e.args...)
+	}
+	if !ok {
+		lang = ""
+		if len(e.args) > 0 {
+			translated, ok = fmt.Sprintf(e.Message, e.args...), true
+		}
+	}
+	if !ok || translated == e.Message && lang == e.language {
+		return e
+	}
+	localized := *e
+	localized.Message = translated
+	localized.language = lang
+	return &localized
+}
@@ -0,0 +1,161 @@
+package httperror
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestLocalization tests translating messages negotiated from Accept-Language.
+func TestLocalization(t *testing.T) {
+	RegisterCatalog("ko", Catalog{"Not Found": "찾을 수 없음", "user not found": "사용자를 찾을 수 없습니다"})
+	RegisterCatalog("ko", Catalog{"Forbidden": "금지됨"})
+	RegisterCatalog("fr", Catalog{"Not Found": "Introuvable"})
+	defer ResetCatalogs()
+
+	testCases := []struct {
+		name             string
+		acceptLanguage   string
+		err              error
+		expected         string
+		expectedLanguage string
+	}{
+		{"no header", "", NotFoundError(), "Not Found", ""},
+		{"status text", "ko", NotFoundError(), "찾을 수 없음", "ko"},
+		{"merged catalog", "ko", ForbiddenError(), "금지됨", "ko"},
+		{"custom message", "ko", NotFoundError("user not found"), "사용자를 찾을 수 없습니다", "ko"},
+		{"regional tag", "ko-KR,ko;q=0.9", NotFoundError(), "찾을 수 없음", "ko"},
+		{"quality order", "de, fr;q=0.5, ko;q=0.8", NotFoundError(), "찾을 수 없음", "ko"},
+		{"refused language", "ko;q=0, fr", NotFoundError(), "Introuvable", "fr"},
+		{"untranslated message", "ko", NotFoundError("order 7 missing"), "order 7 missing", ""},
+		{"unknown language", "ja", NotFoundError(), "Not Found", ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/", nil)
+			if tc.acceptLanguage != "" {
+				req.Header.Set("Accept-Language", tc.acceptLanguage)
+			}
+			rr := httptest.NewRecorder()
+			Respond(rr, req, tc.err)
+
+			var body HttpError
+			if err := json.NewDecoder(rr.Body).Decode(&body); err != nil {
+				t.Fatalf("could not decode response body: %v", err)
+			}
+			if body.Message != tc.expected {
+				t.Errorf("expected message %q, got %q", tc.expected, body.Message)
+			}
+			if got := rr.Header().Get("Content-Language"); got != tc.expectedLanguage {
+				t.Errorf("expected Content-Language %q, got %q", tc.expectedLanguage, got)
+			}
+		})
+	}
+
+	if ErrNotFound.Message != "Not Found" {
+		t.Errorf("expected shared errors not to be modified, got %q", ErrNotFound.Message)
+	}
+}
+
+// TestContentLanguage tests exposing the language of the message to encoders and clients.
+func TestContentLanguage(t *testing.T) {
+	RegisterBuiltinCatalogs()
+	defer ResetCatalogs()
+
+	testCases := []struct {
+		name             string
+		opts             []ResponderOption
+		acceptLanguage   string
+		err              error
+		expectedLanguage string
+		expectedBody     string
+	}{
+		{"negotiated", nil, "ko", NotFoundError(), "ko", `<div class="http-error" lang="ko">찾을 수 없음</div>`},
+		{"same text", nil, "en", NotFoundError(), "en", `<div class="http-error" lang="en">Not Found</div>`},
+		{"fallback", []ResponderOption{WithLanguage("ko")}, "", NotFoundError(), "ko", `<div class="http-error" lang="ko">찾을 수 없음</div>`},
+		{"untranslated", nil, "ko", NotFoundError("order 7 missing"), "", `<div class="http-error">order 7 missing</div>`},
+		{"upstream header", nil, "", NotFoundError(WithHeader("Content-Language", "de")), "", `<div class="http-error">Not Found</div>`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/", nil)
+			req.Header.Set("Accept", "text/html")
+			if tc.acceptLanguage != "" {
+				req.Header.Set("Accept-Language", tc.acceptLanguage)
+			}
+			rr := httptest.NewRecorder()
+			NewResponder(tc.opts...).HandleError(rr, req, tc.err)
+
+			if got := rr.Header().Get("Content-Language"); got != tc.expectedLanguage {
+				t.Errorf("expected Content-Language %q, got %q", tc.expectedLanguage, got)
+			}
+			if got := rr.Body.String(); got != tc.expectedBody {
+				t.Errorf("expected body %q, got %q", tc.expectedBody, got)
+			}
+		})
+	}
+}
+
+// mapTranslator is a Translator backed by a map of language to messages.
+type mapTranslator map[string]map[string]string
+
+func (m mapTranslator) Languages() []string {
+	langs := make([]string, 0, len(m))
+	for lang := range m {
+		langs = append(langs, lang)
+	}
+	return langs
+}
+
+func (m mapTranslator) Translate(lang, message string, args ...any) (string, bool) {
+	translated, ok := m[lang][message]
+	if ok && len(args) > 0 {
+		translated = fmt.Sprintf(translated, args...)
+	}
+	return translated, ok
+}
+
+// TestTranslator tests message arguments and plugging a custom Translator.
+func TestTranslator(t *testing.T) {
+	RegisterCatalog("ko", Catalog{"%d items failed": "%d개 항목 실패"})
+	defer ResetCatalogs()
+
+	testCases := []struct {
+		name           string
+		translator     Translator
+		acceptLanguage string
+		expected       string
+	}{
+		{"catalog with args", nil, "ko", "3개 항목 실패"},
+		{"untranslated args", nil, "ja", "3 items failed"},
+		{"custom translator", mapTranslator{"de": {"%d items failed": "%d Elemente fehlgeschlagen"}}, "de-AT", "3 Elemente fehlgeschlagen"},
+		{"custom translator replaces catalogs", mapTranslator{"de": {}}, "ko", "3 items failed"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			SetTranslator(tc.translator)
+			defer SetTranslator(nil)
+
+			req := httptest.NewRequest("GET", "/", nil)
+			req.Header.Set("Accept-Language", tc.acceptLanguage)
+			rr := httptest.NewRecorder()
+			err := UnprocessableEntityError("%d items failed", WithMessageArgs(3))
+			Respond(rr, req, err)
+
+			var body HttpError
+			if err := json.NewDecoder(rr.Body).Decode(&body); err != nil {
+				t.Fatalf("could not decode response body: %v", err)
+			}
+			if body.Message != tc.expected {
+				t.Errorf("expected message %q, got %q", tc.expected, body.Message)
+			}
+			if err.Message != "%d items failed" {
+				t.Errorf("expected the key to be kept, got %q", err.Message)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package httperror
+
+import "net/http"
+
+// IdempotencyKeyHeader is the header carrying the idempotency key of a request.
+// IdempotencyKeyHeader는 요청의 멱등성 키를 담는 헤더입니다.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// WithIdempotencyKeyEcho sets whether the Idempotency-Key header of the
+// request is reflected in the error response headers and in the details
+// ("idempotency_key"), helping clients correlate failed retries with their
+// original attempts. It is off by default.
+// WithIdempotencyKeyEcho는 요청의 Idempotency-Key 헤더를 오류 응답 헤더와 상세 정보("idempotency_key")에
+// 반영할지 설정하여, 클라이언트가 실패한 재시도를 원래 시도와 연관 지을 수 있게 합니다. 기본으로 꺼져 있습니다.
+func WithIdempotencyKeyEcho(enabled bool) ResponderOption {
+	return func(cfg *responderConfig) { cfg.echoIdempotencyKey = enabled }
+}
+
+// withIdempotencyKey returns a copy of e carrying the idempotency key of the
+// request in its headers and details, or e itself if there is none.
+func (cfg *responderConfig) withIdempotencyKey(r *http.Request, e *HttpError) *HttpError {
+	if !cfg.echoIdempotencyKey || r == nil {
+		return e
+	}
+	key := r.Header.Get(IdempotencyKeyHeader)
+	if key == "" {
+		return e
+	}
+	c := *e
+	c.Header = e.Header.Clone()
+	if c.Header == nil {
+		c.Header = make(http.Header)
+	}
+	c.Header.Set(IdempotencyKeyHeader, key)
+	c.Details = make(map[string]any, len(e.Details)+1)
+	for k, v := range e.Details {
+		c.Details[k] = v
+	}
+	c.Details["idempotency_key"] = key
+	return &c
+}
@@ -0,0 +1,42 @@
+package httperror
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+// TestIdempotencyKeyEcho tests reflecting the Idempotency-Key of requests in errors.
+func TestIdempotencyKeyEcho(t *testing.T) {
+	testCases := []struct {
+		name           string
+		opts           []ResponderOption
+		key            string
+		expectedHeader string
+		expectedBody   string
+	}{
+		{"echoed", []ResponderOption{WithIdempotencyKeyEcho(true)}, "8e03978e-40d5-43e8", "8e03978e-40d5-43e8", `{"status":409,"message":"Conflict","details":{"idempotency_key":"8e03978e-40d5-43e8"}}`},
+		{"no key", []ResponderOption{WithIdempotencyKeyEcho(true)}, "", "", `{"status":409,"message":"Conflict"}`},
+		{"disabled", nil, "8e03978e-40d5-43e8", "", `{"status":409,"message":"Conflict"}`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", "/payments", nil)
+			if tc.key != "" {
+				req.Header.Set(IdempotencyKeyHeader, tc.key)
+			}
+			rr := httptest.NewRecorder()
+			shared := ConflictError()
+			NewResponder(tc.opts...).HandleError(rr, req, shared)
+
+			if got := rr.Header().Get(IdempotencyKeyHeader); got != tc.expectedHeader {
+				t.Errorf("expected header %q, got %q", tc.expectedHeader, got)
+			}
+			if got := rr.Body.String(); got != tc.expectedBody+"\n" {
+				t.Errorf("expected body %s, got %s", tc.expectedBody, got)
+			}
+			if shared.Header != nil || shared.Details != nil {
+				t.Errorf("expected the shared error to be left untouched, got %+v", shared)
+			}
+		})
+	}
+}
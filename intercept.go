@@ -0,0 +1,114 @@
+package httperror
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// maxInterceptedBody is how much of a suppressed upstream body is kept as the error cause.
+const maxInterceptedBody = 1024
+
+// Intercept is a middleware giving nginx error_page semantics to Go services:
+// when the wrapped handler (or reverse proxy) writes a 4xx or 5xx response
+// whose body is not structured (neither JSON nor XML) and was not rendered by
+// this package, such as its HTML fragments, the body is suppressed
+// and the error is rendered again through Respond with the configured handler.
+// Headers set by the handler, such as Retry-After or WWW-Authenticate, are kept;
+// the beginning of the suppressed body is kept as the cause of the error, for hooks and logs.
+// Intercept는 Go 서비스에 nginx error_page와 같은 동작을 제공하는 미들웨어입니다.
+// 감싼 핸들러(또는 리버스 프록시)가 구조화되지 않은(JSON이나 XML이 아닌) 본문으로 4xx/5xx 응답을 작성하면,
+// 이 패키지가 렌더링한 HTML 조각 등이 아닌 한
+// 본문을 버리고 설정된 핸들러로 Respond를 통해 오류를 다시 렌더링합니다.
+// 핸들러가 설정한 헤더(Retry-After 등)는 유지됩니다.
+func Intercept(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		iw := &interceptWriter{ResponseWriter: w}
+		next.ServeHTTP(iw, r)
+		if !iw.intercepted {
+			return
+		}
+
+		h := w.Header()
+		for _, key := range bodyHeaders {
+			h.Del(key)
+		}
+		e := New(iw.status, StatusText(iw.status))
+		e.cause = fmt.Errorf("upstream responded %d: %s", iw.status, strings.TrimSpace(string(iw.body)))
+		Respond(w, r, e)
+	})
+}
+
+// interceptWriter holds back error responses with unstructured bodies.
+type interceptWriter struct {
+	http.ResponseWriter
+	wroteHeader bool
+	intercepted bool
+	// rendered is set when the response is rendered by this package.
+	rendered bool
+	status   int
+	body     []byte
+}
+
+func (iw *interceptWriter) WriteHeader(status int) {
+	if iw.wroteHeader {
+		return
+	}
+	if status >= 100 && status < 200 {
+		// Informational responses may precede the final one.
+		iw.ResponseWriter.WriteHeader(status)
+		return
+	}
+	iw.wroteHeader = true
+	iw.status = status
+	if status >= 400 && !iw.rendered && !structuredContentType(iw.Header().Get("Content-Type")) {
+		iw.intercepted = true
+		return
+	}
+	iw.ResponseWriter.WriteHeader(status)
+}
+
+func (iw *interceptWriter) Write(p []byte) (int, error) {
+	if !iw.wroteHeader {
+		iw.WriteHeader(http.StatusOK)
+	}
+	if iw.intercepted {
+		if room := maxInterceptedBody - len(iw.body); room > 0 {
+			iw.body = append(iw.body, p[:min(room, len(p))]...)
+		}
+		return len(p), nil
+	}
+	return iw.ResponseWriter.Write(p)
+}
+
+// markRendered implements renderedMarker, so the responses of Respond pass through.
+func (iw *interceptWriter) markRendered() {
+	iw.rendered = true
+}
+
+// Flush implements http.Flusher when the underlying writer supports it.
+// Intercepted responses are not flushed, as they are rendered later.
+func (iw *interceptWriter) Flush() {
+	if iw.intercepted {
+		return
+	}
+	if f, ok := iw.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Unwrap returns the underlying ResponseWriter, for use by http.ResponseController.
+func (iw *interceptWriter) Unwrap() http.ResponseWriter {
+	return iw.ResponseWriter
+}
+
+// structuredContentType reports whether a Content-Type denotes a structured (JSON or XML) body.
+func structuredContentType(contentType string) bool {
+	ct := strings.ToLower(contentType)
+	if i := strings.IndexByte(ct, ';'); i >= 0 {
+		ct = ct[:i]
+	}
+	ct = strings.TrimSpace(ct)
+	return strings.HasSuffix(ct, "/json") || strings.HasSuffix(ct, "+json") ||
+		strings.HasSuffix(ct, "/xml") || strings.HasSuffix(ct, "+xml")
+}
@@ -0,0 +1,149 @@
+package httperror
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// TestIntercept tests rewriting unstructured error responses.
+func TestIntercept(t *testing.T) {
+	testCases := []struct {
+		name            string
+		handler         http.HandlerFunc
+		expectedStatus  int
+		expectRewritten bool
+		expectedBody    string
+	}{
+		{
+			name: "plain text error",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, "upstream exploded", http.StatusBadGateway)
+			},
+			expectedStatus:  http.StatusBadGateway,
+			expectRewritten: true,
+		},
+		{
+			name: "error without body",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+			},
+			expectedStatus:  http.StatusNotFound,
+			expectRewritten: true,
+		},
+		{
+			name: "structured error",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/problem+json")
+				w.WriteHeader(http.StatusConflict)
+				fmt.Fprint(w, `{"title":"conflict"}`)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   `{"title":"conflict"}`,
+		},
+		{
+			name: "success",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, "hello")
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "hello",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			Intercept(tc.handler).ServeHTTP(rr, httptest.NewRequest("GET", "/", nil))
+
+			if rr.Code != tc.expectedStatus {
+				t.Errorf("expected status %d, got %d", tc.expectedStatus, rr.Code)
+			}
+			if !tc.expectRewritten {
+				if rr.Body.String() != tc.expectedBody {
+					t.Errorf("expected body %q, got %q", tc.expectedBody, rr.Body.String())
+				}
+				return
+			}
+			if ct := rr.Header().Get("Content-Type"); ct != "application/json; charset=utf-8" {
+				t.Errorf("expected re-rendered JSON, got Content-Type %q", ct)
+			}
+			var body HttpError
+			if err := json.NewDecoder(rr.Body).Decode(&body); err != nil {
+				t.Fatalf("could not decode response body: %v", err)
+			}
+			if body.Status != tc.expectedStatus || body.Message != http.StatusText(tc.expectedStatus) {
+				t.Errorf("unexpected body: %+v", body)
+			}
+		})
+	}
+}
+
+// TestInterceptKeepsHeadersAndCause tests that handler headers are kept and the body becomes the cause.
+func TestInterceptKeepsHeadersAndCause(t *testing.T) {
+	var got ErrorEvent
+	AddHook(func(ev ErrorEvent) { got = ev })
+	defer ResetHooks()
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "30")
+		http.Error(w, "maintenance "+strings.Repeat("x", 2*maxInterceptedBody), http.StatusServiceUnavailable)
+	})
+	rr := httptest.NewRecorder()
+	Intercept(handler).ServeHTTP(rr, httptest.NewRequest("GET", "/", nil))
+
+	if rr.Header().Get("Retry-After") != "30" || rr.Header().Get("X-Content-Type-Options") != "nosniff" {
+		t.Errorf("expected handler headers to be kept, got %v", rr.Header())
+	}
+	cause := errors.Unwrap(got.Err)
+	if cause == nil || !strings.Contains(cause.Error(), "upstream responded 503: maintenance") {
+		t.Fatalf("expected the suppressed body as cause, got %v", cause)
+	}
+	if len(cause.Error()) > maxInterceptedBody+64 {
+		t.Errorf("expected the cause to be truncated, got %d bytes", len(cause.Error()))
+	}
+}
+
+// TestInterceptPassesRenderedErrors tests that errors rendered by this package are not rendered again.
+func TestInterceptPassesRenderedErrors(t *testing.T) {
+	hooks := 0
+	AddHook(func(ErrorEvent) { hooks++ })
+	defer ResetHooks()
+
+	testCases := []struct {
+		name    string
+		accept  string
+		respond func(w http.ResponseWriter, r *http.Request)
+	}{
+		{"respond html", "text/html", func(w http.ResponseWriter, r *http.Request) {
+			NotFound(w, r, WithMessage("no such user"), WithDetail("id", "7"))
+		}},
+		{"responder html", "text/html", func(w http.ResponseWriter, r *http.Request) {
+			NewResponder().HandleError(w, r, NotFoundError(WithMessage("no such user"), WithDetail("id", "7")))
+		}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			hooks = 0
+			req := httptest.NewRequest("GET", "/", nil)
+			req.Header.Set("Accept", tc.accept)
+			rr := httptest.NewRecorder()
+			Intercept(http.HandlerFunc(tc.respond)).ServeHTTP(rr, req)
+
+			if rr.Code != http.StatusNotFound {
+				t.Errorf("expected status 404, got %d", rr.Code)
+			}
+			if body := rr.Body.String(); !strings.Contains(body, "no such user") || !strings.Contains(body, "<dt>id</dt>") {
+				t.Errorf("expected the rendered body to pass through, got %q", body)
+			}
+			if hooks > 1 {
+				t.Errorf("expected the error to be handled once, hooks ran %d times", hooks)
+			}
+		})
+	}
+}
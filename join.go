@@ -0,0 +1,81 @@
+package httperror
+
+import (
+	"errors"
+	"sync/atomic"
+)
+
+// JoinStrategy selects which member of a joined error (errors.Join or
+// fmt.Errorf with several %w verbs) determines the response.
+// JoinStrategy는 결합된 오류(errors.Join 등)의 어떤 구성 오류가 응답을 결정할지 선택합니다.
+type JoinStrategy int
+
+const (
+	// JoinMostSevere picks the member with the most severe status class
+	// (5xx over 4xx); the first one wins within a class.
+	JoinMostSevere JoinStrategy = iota
+	// JoinFirst picks the first member that resolves to an HttpError.
+	JoinFirst
+)
+
+var (
+	joinStrategy atomic.Int64
+	joinDetails  atomic.Bool
+)
+
+// SetJoinStrategy sets how joined errors are resolved. The default is JoinMostSevere.
+// Members that resolve to neither an HttpError nor a mapped error are ignored;
+// if no member resolves, the joined error results in a 500.
+// SetJoinStrategy는 결합된 오류의 처리 방식을 설정합니다. 기본값은 JoinMostSevere입니다.
+// 변환할 수 없는 구성 오류는 무시되며, 변환되는 구성 오류가 없으면 500으로 처리됩니다.
+func SetJoinStrategy(s JoinStrategy) {
+	joinStrategy.Store(int64(s))
+}
+
+// SetJoinDetails controls whether all resolved members of a joined error are
+// serialized under the "errors" key of the details.
+// SetJoinDetails는 결합된 오류의 모든 구성 오류를 details의 "errors" 키에 포함할지 설정합니다.
+func SetJoinDetails(enabled bool) {
+	joinDetails.Store(enabled)
+}
+
+// resolveJoined resolves an error wrapping several errors, possibly further
+// down its chain, by picking one of its members according to the configured strategy.
+func resolveJoined(err error) (*HttpError, bool) {
+	var joined interface{ Unwrap() []error }
+	if !errors.As(err, &joined) {
+		return nil, false
+	}
+
+	var members []*HttpError
+	for _, member := range joined.Unwrap() {
+		if e, ok := resolveError(member); ok {
+			members = append(members, e)
+		}
+	}
+	if len(members) == 0 {
+		return nil, false
+	}
+
+	chosen := members[0]
+	if JoinStrategy(joinStrategy.Load()) == JoinMostSevere {
+		for _, m := range members[1:] {
+			if m.Status/100 > chosen.Status/100 {
+				chosen = m
+			}
+		}
+	}
+
+	e := *chosen
+	e.cause = err
+	e.originalStatus = chosen.originalStatus
+	if joinDetails.Load() {
+		details := make(map[string]any, len(chosen.Details)+1)
+		for k, v := range chosen.Details {
+			details[k] = v
+		}
+		details["errors"] = members
+		e.Details = details
+	}
+	return &e, true
+}
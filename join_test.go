@@ -0,0 +1,73 @@
+package httperror
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestJoinedErrors tests the resolution of joined errors.
+func TestJoinedErrors(t *testing.T) {
+	defer SetJoinStrategy(JoinMostSevere)
+
+	notFound := NotFoundError("user missing")
+	conflict := ConflictError("email taken")
+	unavailable := ServiceUnavailableError("search down")
+
+	testCases := []struct {
+		name           string
+		strategy       JoinStrategy
+		err            error
+		expectedStatus int
+		expectedMsg    string
+	}{
+		{"most severe class", JoinMostSevere, errors.Join(notFound, unavailable), http.StatusServiceUnavailable, "search down"},
+		{"first within class", JoinMostSevere, errors.Join(notFound, conflict), http.StatusNotFound, "user missing"},
+		{"first", JoinFirst, errors.Join(errors.New("plain"), conflict, unavailable), http.StatusConflict, "email taken"},
+		{"nested and wrapped", JoinMostSevere, fmt.Errorf("save: %w", errors.Join(errors.New("plain"), fmt.Errorf("a: %w, b: %w", notFound, unavailable))), http.StatusServiceUnavailable, "search down"},
+		{"unresolvable members", JoinMostSevere, errors.Join(errors.New("a"), errors.New("b")), http.StatusInternalServerError, "Internal Server Error"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			SetJoinStrategy(tc.strategy)
+			got := toHttpError(tc.err)
+			if got.Status != tc.expectedStatus || got.Message != tc.expectedMsg {
+				t.Errorf("expected %d %q, got %d %q", tc.expectedStatus, tc.expectedMsg, got.Status, got.Message)
+			}
+		})
+	}
+}
+
+// TestJoinDetails tests that all member messages can be serialized.
+func TestJoinDetails(t *testing.T) {
+	SetErrorHandler(nil)
+	SetJoinDetails(true)
+	defer SetJoinDetails(false)
+
+	err := errors.Join(BadRequestError("name is required"), UnprocessableEntityError("age must be positive"))
+	rr := httptest.NewRecorder()
+	Respond(rr, httptest.NewRequest("POST", "/", nil), err)
+
+	var body struct {
+		Status  int `json:"status"`
+		Details struct {
+			Errors []HttpError `json:"errors"`
+		} `json:"details"`
+	}
+	if err := json.NewDecoder(rr.Body).Decode(&body); err != nil {
+		t.Fatalf("could not decode response body: %v", err)
+	}
+	if body.Status != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, body.Status)
+	}
+	if len(body.Details.Errors) != 2 || body.Details.Errors[1].Message != "age must be positive" {
+		t.Errorf("unexpected member errors: %+v", body.Details.Errors)
+	}
+	if !errors.Is(toHttpError(err), ErrUnprocessableEntity) {
+		t.Error("expected the resolved error to unwrap to the joined members")
+	}
+}
@@ -0,0 +1,128 @@
+package httperror
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// journalEntry is an error responded by Respond, as recorded in the journal.
+type journalEntry struct {
+	Time   time.Time
+	Status int
+	Code   string
+	// Route is the method and the route pattern set with WithRoute, e.g.
+	// "GET /v1/users/{id}", or "" if the pattern is unknown.
+	Route       string
+	Fingerprint string
+	Message     string
+}
+
+// journal keeps the most recent errors in a fixed-size ring. journalEnabled
+// is set while its size is positive, so Respond skips the lock otherwise.
+var (
+	journal = struct {
+		mu      sync.Mutex
+		entries []journalEntry
+		next    int
+		full    bool
+	}{}
+	journalEnabled atomic.Bool
+)
+
+// SetJournalSize sets how many of the most recent errors are kept in memory
+// for TaxonomyReport and RecentErrors, e.g. 1024. The journal is disabled by
+// default, which a size of 0 restores. Changing the size discards the recorded
+// errors. Errors are grouped by the route pattern set with WithRoute, never by
+// the request path.
+// SetJournalSize는 TaxonomyReport와 RecentErrors를 위해 메모리에 보관할 최근 오류의 수(예: 1024)를 설정합니다.
+// 저널은 기본적으로 비활성화되어 있으며 0을 전달하면 다시 비활성화됩니다. 크기를 변경하면 기록된 오류는 삭제됩니다.
+// 오류는 요청 경로가 아니라 WithRoute로 설정된 경로 패턴으로 분류됩니다.
+func SetJournalSize(size int) {
+	if size < 0 {
+		size = 0
+	}
+	journal.mu.Lock()
+	defer journal.mu.Unlock()
+	journal.entries = make([]journalEntry, size)
+	journal.next = 0
+	journal.full = false
+	journalEnabled.Store(size > 0)
+}
+
+// journalError records the error of ev in the journal.
+func journalError(ev ErrorEvent) {
+	if !journalEnabled.Load() || ev.HttpError.Status < 400 {
+		return
+	}
+	entry := journalEntry{
+		Time:        ev.Time,
+		Status:      ev.HttpError.Status,
+		Code:        ev.HttpError.Code,
+		Fingerprint: fingerprint(ev.Err, ev.HttpError),
+		// The message is served by RecentErrorsHandler and TaxonomyHandler,
+		// so it is scrubbed like the responses of the DefaultResponder.
+		Message: redactString(ev.HttpError.Message, defaultResponder.config().redactors),
+	}
+	if ev.Request != nil {
+		if route := Route(ev.Request.Context()); route != "" {
+			entry.Route = ev.Request.Method + " " + route
+		}
+	}
+
+	journal.mu.Lock()
+	defer journal.mu.Unlock()
+	if len(journal.entries) == 0 {
+		return
+	}
+	journal.entries[journal.next] = entry
+	journal.next = (journal.next + 1) % len(journal.entries)
+	if journal.next == 0 {
+		journal.full = true
+	}
+}
+
+// journalSince returns the recorded errors that occurred at or after since, oldest first.
+func journalSince(since time.Time) []journalEntry {
+	journal.mu.Lock()
+	defer journal.mu.Unlock()
+
+	var ordered []journalEntry
+	if journal.full {
+		ordered = append(ordered, journal.entries[journal.next:]...)
+	}
+	ordered = append(ordered, journal.entries[:journal.next]...)
+
+	entries := ordered[:0]
+	for _, e := range ordered {
+		if !e.Time.Before(since) {
+			entries = append(entries, e)
+		}
+	}
+	return entries
+}
+
+// fingerprint groups errors sharing a status, a code and an underlying error type,
+// so repeated occurrences of the same failure are counted together.
+func fingerprint(err error, httpErr *HttpError) string {
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%d|%s|%T", httpErr.Status, httpErr.Code, rootCause(err))
+	return fmt.Sprintf("%016x", h.Sum64())
+}
+
+// rootCause returns the innermost error of err's single-error chain.
+func rootCause(err error) error {
+	for {
+		u, ok := err.(interface{ Unwrap() error })
+		if !ok {
+			return err
+		}
+		next := u.Unwrap()
+		if next == nil {
+			return err
+		}
+		err = next
+	}
+}
@@ -0,0 +1,326 @@
+package httperror
+
+import (
+	"encoding/json"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+	"unicode/utf8"
+)
+
+// MarshalJSON implements json.Marshaler without reflection for the fields of
+// the error. The output is identical to what encoding/json produces.
+// MarshalJSON은 오류 필드를 리플렉션 없이 직렬화하는 json.Marshaler 구현입니다. 출력은 encoding/json과 동일합니다.
+func (e *HttpError) MarshalJSON() ([]byte, error) {
+	return e.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of e to b and returns the extended
+// buffer. Detail values of common types (strings, numbers, booleans, slices
+// and maps of them) are encoded directly; others go through encoding/json.
+// AppendJSON은 e의 JSON 인코딩을 b에 추가하여 확장된 버퍼를 반환합니다. 자주 쓰이는 타입의 상세 값
+// (문자열, 숫자, 불리언 및 이들의 슬라이스와 맵)은 직접 인코딩되며, 그 외의 값은 encoding/json을 사용합니다.
+func (e *HttpError) AppendJSON(b []byte) ([]byte, error) {
+	return e.appendJSON(b, nil)
+}
+
+// appendJSON appends the JSON encoding of e to b, with the field names and
+// detail keys of st, or the default ones if st is nil.
+func (e *HttpError) appendJSON(b []byte, st *jsonStyle) ([]byte, error) {
+	names := &defaultJSONNames
+	if st != nil {
+		names = &st.names
+	}
+
+	b = append(b, '{')
+	b = appendJSONKey(b, names.Status)
+	b = strconv.AppendInt(b, int64(e.Status), 10)
+	if e.Code != "" {
+		b = append(b, ',')
+		b = appendJSONKey(b, names.Code)
+		b = appendJSONString(b, e.Code)
+	}
+	if e.Type != "" {
+		b = append(b, ',')
+		b = appendJSONKey(b, names.Type)
+		b = appendJSONString(b, e.Type)
+	}
+	b = append(b, ',')
+	b = appendJSONKey(b, names.Message)
+	b = appendJSONString(b, e.Message)
+	if len(e.Details) > 0 {
+		b = append(b, ',')
+		b = appendJSONKey(b, names.Details)
+		var err error
+		if b, err = appendJSONMap(b, e.Details, st); err != nil {
+			return nil, err
+		}
+	}
+	if len(e.Links) > 0 {
+		b = append(b, ',')
+		b = appendJSONKey(b, names.Links)
+		b = appendJSONStrings(b, e.Links)
+	}
+	if e.Data != nil {
+		b = append(b, ',')
+		b = appendJSONKey(b, names.Data)
+		var err error
+		if b, err = appendJSONValue(b, e.Data, nil); err != nil {
+			return nil, err
+		}
+	}
+	return append(b, '}'), nil
+}
+
+// appendJSONKey appends the object key name and its colon to b.
+func appendJSONKey(b []byte, name string) []byte {
+	return append(appendJSONString(b, name), ':')
+}
+
+// MarshalJSON implements json.Marshaler, keeping the RetryAfter field that
+// the promoted HttpError.MarshalJSON would otherwise drop.
+func (e *RateLimitError) MarshalJSON() ([]byte, error) {
+	b, err := e.HttpError.AppendJSON(nil)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b[:len(b)-1], `,"RetryAfter":`...)
+	b = strconv.AppendInt(b, int64(e.RetryAfter), 10)
+	return append(b, '}'), nil
+}
+
+// appendJSONValue appends the JSON encoding of v to b. The keys of maps of
+// details and the members of joined errors follow st, if not nil.
+func appendJSONValue(b []byte, v any, st *jsonStyle) ([]byte, error) {
+	switch v := v.(type) {
+	case nil:
+		return append(b, "null"...), nil
+	case string:
+		return appendJSONString(b, v), nil
+	case bool:
+		return strconv.AppendBool(b, v), nil
+	case int:
+		return strconv.AppendInt(b, int64(v), 10), nil
+	case int8:
+		return strconv.AppendInt(b, int64(v), 10), nil
+	case int16:
+		return strconv.AppendInt(b, int64(v), 10), nil
+	case int32:
+		return strconv.AppendInt(b, int64(v), 10), nil
+	case int64:
+		return strconv.AppendInt(b, v, 10), nil
+	case uint:
+		return strconv.AppendUint(b, uint64(v), 10), nil
+	case uint8:
+		return strconv.AppendUint(b, uint64(v), 10), nil
+	case uint16:
+		return strconv.AppendUint(b, uint64(v), 10), nil
+	case uint32:
+		return strconv.AppendUint(b, uint64(v), 10), nil
+	case uint64:
+		return strconv.AppendUint(b, v, 10), nil
+	case float32:
+		return appendJSONFloat(b, float64(v), 32)
+	case float64:
+		return appendJSONFloat(b, v, 64)
+	case time.Duration:
+		return strconv.AppendInt(b, int64(v), 10), nil
+	case []string:
+		if v == nil {
+			return append(b, "null"...), nil
+		}
+		b = append(b, '[')
+		for i, s := range v {
+			if i > 0 {
+				b = append(b, ',')
+			}
+			b = appendJSONString(b, s)
+		}
+		return append(b, ']'), nil
+	case []any:
+		if v == nil {
+			return append(b, "null"...), nil
+		}
+		b = append(b, '[')
+		for i, item := range v {
+			if i > 0 {
+				b = append(b, ',')
+			}
+			var err error
+			if b, err = appendJSONValue(b, item, st); err != nil {
+				return nil, err
+			}
+		}
+		return append(b, ']'), nil
+	case []*HttpError:
+		if v == nil {
+			return append(b, "null"...), nil
+		}
+		b = append(b, '[')
+		for i, member := range v {
+			if i > 0 {
+				b = append(b, ',')
+			}
+			if member == nil {
+				b = append(b, "null"...)
+				continue
+			}
+			var err error
+			if b, err = member.appendJSON(b, st); err != nil {
+				return nil, err
+			}
+		}
+		return append(b, ']'), nil
+	case map[string]any:
+		if v == nil {
+			return append(b, "null"...), nil
+		}
+		return appendJSONMap(b, v, st)
+	case map[string]string:
+		if v == nil {
+			return append(b, "null"...), nil
+		}
+		return appendJSONStrings(b, v), nil
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, data...), nil
+}
+
+// appendJSONMap appends the JSON encoding of m to b, with sorted keys like
+// encoding/json. The keys are converted to camel case if st asks for it.
+func appendJSONMap(b []byte, m map[string]any, st *jsonStyle) ([]byte, error) {
+	if st != nil && st.camel {
+		return appendCamelJSONMap(b, m, st)
+	}
+	b = append(b, '{')
+	for i, k := range sortedDetailKeys(m) {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		b = appendJSONKey(b, k)
+		var err error
+		if b, err = appendJSONValue(b, m[k], st); err != nil {
+			return nil, err
+		}
+	}
+	return append(b, '}'), nil
+}
+
+// appendJSONStrings appends the JSON encoding of m to b, with sorted keys.
+func appendJSONStrings(b []byte, m map[string]string) []byte {
+	b = append(b, '{')
+	for i, k := range sortedKeys(m) {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		b = appendJSONString(b, k)
+		b = append(b, ':')
+		b = appendJSONString(b, m[k])
+	}
+	return append(b, '}')
+}
+
+// sortedKeys returns the keys of m in ascending order.
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// sortedDetailKeys returns the keys of m in ascending order.
+func sortedDetailKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// appendJSONFloat appends f formatted like encoding/json does.
+func appendJSONFloat(b []byte, f float64, bits int) ([]byte, error) {
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		return nil, &json.UnsupportedValueError{Str: strconv.FormatFloat(f, 'g', -1, bits)}
+	}
+
+	format := byte('f')
+	if abs := math.Abs(f); abs != 0 {
+		if bits == 64 && (abs < 1e-6 || abs >= 1e21) || bits == 32 && (float32(abs) < 1e-6 || float32(abs) >= 1e21) {
+			format = 'e'
+		}
+	}
+	b = strconv.AppendFloat(b, f, format, -1, bits)
+	if format == 'e' {
+		// Clean up e-09 to e-9, like encoding/json.
+		if n := len(b); n >= 4 && b[n-4] == 'e' && b[n-3] == '-' && b[n-2] == '0' {
+			b[n-2] = b[n-1]
+			b = b[:n-1]
+		}
+	}
+	return b, nil
+}
+
+// appendJSONString appends s as a JSON string, escaped like encoding/json
+// does by default: HTML characters, U+2028 and U+2029 are escaped and invalid
+// UTF-8 is replaced by U+FFFD.
+func appendJSONString(b []byte, s string) []byte {
+	const hex = "0123456789abcdef"
+
+	b = append(b, '"')
+	start := 0
+	for i := 0; i < len(s); {
+		if c := s[i]; c < utf8.RuneSelf {
+			if c >= 0x20 && c != '"' && c != '\\' && c != '<' && c != '>' && c != '&' {
+				i++
+				continue
+			}
+			b = append(b, s[start:i]...)
+			switch c {
+			case '"', '\\':
+				b = append(b, '\\', c)
+			case '\b':
+				b = append(b, '\\', 'b')
+			case '\f':
+				b = append(b, '\\', 'f')
+			case '\n':
+				b = append(b, '\\', 'n')
+			case '\r':
+				b = append(b, '\\', 'r')
+			case '\t':
+				b = append(b, '\\', 't')
+			default:
+				b = append(b, '\\', 'u', '0', '0', hex[c>>4], hex[c&0xF])
+			}
+			i++
+			start = i
+			continue
+		}
+
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			b = append(b, s[start:i]...)
+			b = append(b, "\ufffd"...)
+			i += size
+			start = i
+			continue
+		}
+		if r == '\u2028' || r == '\u2029' {
+			b = append(b, s[start:i]...)
+			b = append(b, '\\', 'u', '2', '0', '2', hex[r&0xF])
+			i += size
+			start = i
+			continue
+		}
+		i += size
+	}
+	b = append(b, s[start:]...)
+	return append(b, '"')
+}
@@ -0,0 +1,105 @@
+package httperror
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+// reflectedError has the fields of HttpError without its MarshalJSON method,
+// so encoding/json encodes it through reflection.
+type reflectedError HttpError
+
+// assertSameJSON checks that e marshals like encoding/json does.
+func assertSameJSON(t *testing.T, e *HttpError) {
+	t.Helper()
+	expected, expectedErr := json.Marshal((*reflectedError)(e))
+	got, err := e.AppendJSON(nil)
+	if (err != nil) != (expectedErr != nil) {
+		t.Fatalf("expected error %v, got %v", expectedErr, err)
+	}
+	if !bytes.Equal(got, expected) {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+}
+
+// TestAppendJSON tests that AppendJSON matches encoding/json.
+func TestAppendJSON(t *testing.T) {
+	type point struct {
+		X, Y int
+	}
+
+	testCases := []struct {
+		name string
+		err  *HttpError
+	}{
+		{"minimal", New(404, "")},
+		{"code", BadRequestError("bad", WithCode("invalid_input"))},
+		{"escaping", New(400, "<a href=\"x\">&</a>\n\t\\ \x01 \b\f \u2028 \u2029 \xff 한글")},
+		{"scalars", NotFoundError(WithDetail("int", -7), WithDetail("uint8", uint8(200)), WithDetail("bool", true), WithDetail("nil", nil), WithDetail("duration", time.Second))},
+		{"floats", NotFoundError(WithDetail("a", 1.5), WithDetail("b", 1e21), WithDetail("c", 1e-7), WithDetail("d", float32(3.14)), WithDetail("e", 0.0), WithDetail("f", -2.5e-10))},
+		{"collections", NotFoundError(WithDetail("strings", []string{"a", "<b>"}), WithDetail("nil strings", []string(nil)), WithDetail("anys", []any{1, "x", map[string]any{"z": 1, "a": []any{}}}), WithDetail("labels", map[string]string{"b": "2", "a": "1"}))},
+		{"links", NotFoundError(WithLink("self", "/users/7"), WithLink("documentation", "https://example.com/?a=1&b=<2>"))},
+		{"data", TooManyRequestsError(WithData(quota{Limit: 1, Used: 2, Reset: "<soon>"}))},
+		{"raw data", TooManyRequestsError(WithData(json.RawMessage(`{"a": [1, 2]}`)))},
+		{"fallback", NotFoundError(WithDetail("point", point{1, 2}), WithDetail("ints", []int{1, 2}))},
+		{"unsupported value", NotFoundError(WithDetail("nan", math.NaN()))},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assertSameJSON(t, tc.err)
+		})
+	}
+}
+
+// TestRateLimitErrorJSON tests that RateLimitError keeps its RetryAfter field.
+func TestRateLimitErrorJSON(t *testing.T) {
+	e := &RateLimitError{HttpError: TooManyRequestsError(), RetryAfter: 3 * time.Second}
+	type reflectedRateLimitError struct {
+		*reflectedError
+		RetryAfter time.Duration
+	}
+
+	expected, _ := json.Marshal(reflectedRateLimitError{(*reflectedError)(e.HttpError), e.RetryAfter})
+	got, err := json.Marshal(e)
+	if err != nil || !bytes.Equal(got, expected) {
+		t.Errorf("expected %s, got %s (%v)", expected, got, err)
+	}
+}
+
+// FuzzAppendJSON checks that AppendJSON matches encoding/json and round-trips.
+func FuzzAppendJSON(f *testing.F) {
+	f.Add(404, "", "Not Found", "key", "value", 1.5, int64(3), true)
+	f.Add(500, "internal", "<b>&</b>", "\u2028", "\xff\xfe", 1e-9, int64(-1), false)
+	f.Fuzz(func(t *testing.T, status int, code, message, key, value string, number float64, integer int64, flag bool) {
+		if math.IsInf(number, 0) || math.IsNaN(number) {
+			return
+		}
+		e := &HttpError{Status: status, Code: code, Message: message, Details: map[string]any{
+			key: value, "number": number, "integer": integer, "flag": flag, "list": []any{value, number},
+		}}
+		assertSameJSON(t, e)
+
+		var decoded HttpError
+		if err := json.Unmarshal(mustAppendJSON(t, e), &decoded); err != nil {
+			t.Fatalf("could not decode %s: %v", mustAppendJSON(t, e), err)
+		}
+		if decoded.Status != e.Status || utf8.ValidString(code) && decoded.Code != e.Code {
+			t.Errorf("expected %d %q after round-trip, got %d %q", e.Status, e.Code, decoded.Status, decoded.Code)
+		}
+	})
+}
+
+// mustAppendJSON returns the JSON encoding of e.
+func mustAppendJSON(t *testing.T, e *HttpError) []byte {
+	t.Helper()
+	b, err := e.AppendJSON(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
@@ -0,0 +1,103 @@
+package httperror
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// JSONMappers returns the opt-in mapping set for JSON decode errors.
+// json.SyntaxError and json.UnmarshalTypeError become 400 Bad Request
+// responses whose details carry the byte offset and, when known, the
+// offending field and expected type. Errors returned by DecodeJSON are mapped
+// by default; these mappers extend the mapping to any decode error, such as
+// those of json.Unmarshal.
+// JSONMappers는 JSON 디코딩 오류를 위한 선택적 매퍼 집합을 반환합니다.
+// json.SyntaxError, json.UnmarshalTypeError는 바이트 오프셋과 문제가 된 필드를 details에 포함한
+// 400 응답으로 변환됩니다. DecodeJSON이 반환한 오류는 기본적으로 변환되며, 이 매퍼는 json.Unmarshal 등의
+// 모든 디코딩 오류로 변환을 확장합니다.
+func JSONMappers() []Mapper {
+	return []Mapper{mapJSONDecode}
+}
+
+// RegisterJSONMappers registers the mappers returned by JSONMappers.
+// RegisterJSONMappers는 JSONMappers가 반환하는 매퍼들을 등록합니다.
+func RegisterJSONMappers() {
+	for _, m := range JSONMappers() {
+		RegisterMapper(m)
+	}
+}
+
+// DecodeJSON decodes the JSON value read from r into v, e.g. a request body.
+// Its errors are responded as 400 Bad Request by default: malformed JSON,
+// values of the wrong type, and a body that is empty or ends early. Other
+// sources of io.EOF and io.ErrUnexpectedEOF, such as a truncated upstream
+// read, are not mapped.
+// DecodeJSON은 r(예: 요청 본문)에서 읽은 JSON 값을 v로 디코딩합니다. 반환된 오류는 기본적으로
+// 400 Bad Request로 응답됩니다: 잘못된 JSON, 잘못된 타입의 값, 비어 있거나 중간에 끝난 본문.
+// 잘린 업스트림 읽기 등 다른 곳에서 발생한 io.EOF와 io.ErrUnexpectedEOF는 변환되지 않습니다.
+func DecodeJSON(r io.Reader, v any) error {
+	if err := json.NewDecoder(r).Decode(v); err != nil {
+		return &jsonDecodeError{err}
+	}
+	return nil
+}
+
+// jsonDecodeError marks an error returned by DecodeJSON.
+type jsonDecodeError struct {
+	err error
+}
+
+func (e *jsonDecodeError) Error() string { return e.err.Error() }
+
+func (e *jsonDecodeError) Unwrap() error { return e.err }
+
+// mapJSONDecode maps the errors of DecodeJSON and any JSON syntax or type error.
+func mapJSONDecode(err error) (*HttpError, bool) {
+	if e, ok := mapDecodeJSONError(err); ok {
+		return e, true
+	}
+	return mapJSONSyntaxError(err)
+}
+
+// mapDecodeJSONError maps the errors returned by DecodeJSON. It is applied by default.
+func mapDecodeJSONError(err error) (*HttpError, bool) {
+	var decodeErr *jsonDecodeError
+	if !errors.As(err, &decodeErr) {
+		return nil, false
+	}
+	switch {
+	case decodeErr.err == io.EOF:
+		return BadRequestError("Request body is empty"), true
+	case errors.Is(decodeErr.err, io.ErrUnexpectedEOF):
+		return BadRequestError("Request body contains incomplete JSON"), true
+	}
+	return mapJSONSyntaxError(decodeErr.err)
+}
+
+// mapJSONSyntaxError maps json.SyntaxError and json.UnmarshalTypeError.
+func mapJSONSyntaxError(err error) (*HttpError, bool) {
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+
+	switch {
+	case errors.As(err, &syntaxErr):
+		e := BadRequestError(fmt.Sprintf("Request body contains malformed JSON (at byte %d)", syntaxErr.Offset))
+		e.Details = map[string]any{
+			"offset": syntaxErr.Offset,
+			"error":  syntaxErr.Error(),
+		}
+		return e, true
+	case errors.As(err, &typeErr):
+		e := BadRequestError(fmt.Sprintf("Request body contains an invalid value for field %q (at byte %d)", typeErr.Field, typeErr.Offset))
+		e.Details = map[string]any{
+			"offset":   typeErr.Offset,
+			"field":    typeErr.Field,
+			"value":    typeErr.Value,
+			"expected": typeErr.Type.String(),
+		}
+		return e, true
+	}
+	return nil, false
+}
@@ -0,0 +1,115 @@
+package httperror
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// TestJSONMappers tests the translation of JSON decode errors.
+func TestJSONMappers(t *testing.T) {
+	var target struct {
+		Age int `json:"age"`
+	}
+	decode := func(body string) error {
+		return json.NewDecoder(strings.NewReader(body)).Decode(&target)
+	}
+
+	t.Run("syntax error", func(t *testing.T) {
+		httpErr, ok := applyMappers(JSONMappers(), decode(`{"age": 1,}`))
+		if !ok || httpErr.Status != http.StatusBadRequest {
+			t.Fatalf("expected a 400, got %+v", httpErr)
+		}
+		if httpErr.Details["offset"] != int64(11) {
+			t.Errorf("expected offset 11, got %v", httpErr.Details["offset"])
+		}
+	})
+
+	t.Run("type error", func(t *testing.T) {
+		httpErr, ok := applyMappers(JSONMappers(), fmt.Errorf("decode: %w", decode(`{"age": "ten"}`)))
+		if !ok || httpErr.Status != http.StatusBadRequest {
+			t.Fatalf("expected a 400, got %+v", httpErr)
+		}
+		if httpErr.Details["field"] != "age" || httpErr.Details["expected"] != "int" || httpErr.Details["value"] != "string" {
+			t.Errorf("unexpected details: %v", httpErr.Details)
+		}
+		if !strings.Contains(httpErr.Message, `"age"`) {
+			t.Errorf("expected message to name the field, got %q", httpErr.Message)
+		}
+	})
+
+	t.Run("unexpected EOF", func(t *testing.T) {
+		err := fmt.Errorf("decode: %w", DecodeJSON(strings.NewReader(`{"age": `), &target))
+		httpErr, ok := applyMappers(JSONMappers(), err)
+		if !ok || httpErr.Status != http.StatusBadRequest || httpErr.Message != "Request body contains incomplete JSON" {
+			t.Fatalf("expected a 400, got %+v", httpErr)
+		}
+	})
+
+	t.Run("unexpected EOF outside decoding", func(t *testing.T) {
+		if httpErr, ok := applyMappers(JSONMappers(), decode(`{"age": `)); ok {
+			t.Errorf("expected a decode without DecodeJSON not to be mapped, got %+v", httpErr)
+		}
+		_, err := io.ReadFull(strings.NewReader("abc"), make([]byte, 8))
+		if httpErr, ok := applyMappers(JSONMappers(), fmt.Errorf("reading upstream: %w", err)); ok {
+			t.Errorf("expected a truncated read not to be mapped, got %+v", httpErr)
+		}
+	})
+
+	t.Run("DecodeJSON", func(t *testing.T) {
+		if err := DecodeJSON(strings.NewReader(`{"age": 3}`), &target); err != nil || target.Age != 3 {
+			t.Errorf("expected age 3, got %d, %v", target.Age, err)
+		}
+		httpErr, ok := applyMappers(JSONMappers(), DecodeJSON(strings.NewReader(`{"age": "ten"}`), &target))
+		if !ok || httpErr.Details["field"] != "age" {
+			t.Errorf("expected the type error to be mapped, got %+v", httpErr)
+		}
+	})
+
+	t.Run("DecodeJSON errors by default", func(t *testing.T) {
+		testCases := []struct {
+			name            string
+			body            string
+			expectedMessage string
+		}{
+			{"empty body", ``, "Request body is empty"},
+			{"incomplete", `{"age": `, "Request body contains incomplete JSON"},
+			{"malformed", `{"age": 1,}`, "Request body contains malformed JSON (at byte 11)"},
+		}
+		for _, tc := range testCases {
+			httpErr := toHttpError(fmt.Errorf("decode: %w", DecodeJSON(strings.NewReader(tc.body), &target)))
+			if httpErr.Status != http.StatusBadRequest || httpErr.Message != tc.expectedMessage {
+				t.Errorf("%s: expected 400 %q, got %d %q", tc.name, tc.expectedMessage, httpErr.Status, httpErr.Message)
+			}
+		}
+		if got := toHttpError(io.EOF); got.Status != http.StatusInternalServerError {
+			t.Errorf("expected a plain io.EOF to stay 500, got %d", got.Status)
+		}
+
+		limited := http.MaxBytesReader(httptest.NewRecorder(), io.NopCloser(strings.NewReader(`{"age": 12345}`)), 4)
+		if got := toHttpError(DecodeJSON(limited, &target)); got.Status != http.StatusRequestEntityTooLarge {
+			t.Errorf("expected an oversized body to be 413, got %d", got.Status)
+		}
+	})
+
+	t.Run("details are rendered", func(t *testing.T) {
+		SetErrorHandler(nil)
+		RegisterJSONMappers()
+		defer ResetMappers()
+
+		rr := httptest.NewRecorder()
+		Respond(rr, httptest.NewRequest("POST", "/", nil), decode(`{"age": true}`))
+
+		var body HttpError
+		if err := json.NewDecoder(rr.Body).Decode(&body); err != nil {
+			t.Fatalf("could not decode response body: %v", err)
+		}
+		if body.Status != http.StatusBadRequest || body.Details["field"] != "age" {
+			t.Errorf("unexpected body: %+v", body)
+		}
+	})
+}
@@ -0,0 +1,55 @@
+package httperror
+
+import (
+	"context"
+	"sync"
+)
+
+// lastErrorKey is the context key of the lastErrorHolder.
+type lastErrorKey struct{}
+
+// lastErrorHolder stores the last error responded for a request.
+type lastErrorHolder struct {
+	mu  sync.Mutex
+	err *HttpError
+}
+
+// TrackErrors returns a context in which Respond records the errors it renders,
+// so middlewares running after the handler (access logs, metrics emitters) can
+// read what was sent with LastError instead of re-parsing the response body.
+// If ctx already tracks errors it is returned unchanged, so several middlewares
+// can share the same record.
+// TrackErrors는 Respond가 렌더링한 오류를 기록하는 컨텍스트를 반환합니다.
+// 핸들러 이후에 실행되는 미들웨어(접근 로그, 메트릭 등)는 응답 본문을 다시 파싱하지 않고
+// LastError로 전송된 오류를 읽을 수 있습니다. ctx가 이미 오류를 추적 중이면 그대로 반환합니다.
+func TrackErrors(ctx context.Context) context.Context {
+	if _, ok := ctx.Value(lastErrorKey{}).(*lastErrorHolder); ok {
+		return ctx
+	}
+	return context.WithValue(ctx, lastErrorKey{}, &lastErrorHolder{})
+}
+
+// LastError returns the last error rendered by Respond for a request whose
+// context was prepared with TrackErrors, or nil if none was rendered.
+// LastError는 TrackErrors로 준비된 요청에 대해 Respond가 마지막으로 렌더링한 오류를 반환합니다.
+// 렌더링된 오류가 없으면 nil을 반환합니다.
+func LastError(ctx context.Context) *HttpError {
+	h, ok := ctx.Value(lastErrorKey{}).(*lastErrorHolder)
+	if !ok {
+		return nil
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.err
+}
+
+// recordLastError stores httpErr in the request context, if it tracks errors.
+func recordLastError(ctx context.Context, httpErr *HttpError) {
+	h, ok := ctx.Value(lastErrorKey{}).(*lastErrorHolder)
+	if !ok {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.err = httpErr
+}
@@ -0,0 +1,46 @@
+package httperror
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestLastError tests that middlewares can read the rendered error from the context.
+func TestLastError(t *testing.T) {
+	SetErrorHandler(nil)
+
+	var logged *HttpError
+	accessLog := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := TrackErrors(r.Context())
+			next.ServeHTTP(w, r.WithContext(ctx))
+			logged = LastError(ctx)
+		})
+	}
+
+	handler := accessLog(accessLog(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		NotFound(w, r, WithCode("user_not_found"))
+	})))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/users/1", nil))
+
+	if logged == nil || logged.Status != http.StatusNotFound || logged.Code != "user_not_found" {
+		t.Errorf("expected the rendered 404 to be recorded, got %+v", logged)
+	}
+
+	t.Run("no error rendered", func(t *testing.T) {
+		if err := LastError(TrackErrors(context.Background())); err != nil {
+			t.Errorf("expected nil, got %+v", err)
+		}
+	})
+
+	t.Run("untracked context", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", "/", nil)
+		Respond(rr, req, NotFoundError())
+		if err := LastError(req.Context()); err != nil {
+			t.Errorf("expected nil, got %+v", err)
+		}
+	})
+}
@@ -0,0 +1,144 @@
+package httperror
+
+import (
+	"fmt"
+	"net/http"
+	"runtime"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// hookNanos accumulates the time spent running hooks, for LoadTest.
+var hookNanos atomic.Int64
+
+// LoadTestConfig configures LoadTest. Zero values select the defaults.
+// LoadTestConfig는 LoadTest를 설정합니다. 0 값은 기본값을 사용합니다.
+type LoadTestConfig struct {
+	// Concurrency is the number of concurrent workers. Defaults to GOMAXPROCS.
+	Concurrency int
+	// Requests is the total number of errors to respond. Defaults to 10000.
+	Requests int
+	// Errors are the errors passed to Respond, used round-robin. Defaults to a 404 HttpError.
+	Errors []error
+	// Accept are the Accept headers of the requests, used round-robin. Defaults to "application/json".
+	Accept []string
+}
+
+// LoadTestReport holds the results of LoadTest.
+// LoadTestReport는 LoadTest의 결과를 담습니다.
+type LoadTestReport struct {
+	Requests     int
+	Concurrency  int
+	Duration     time.Duration
+	AllocsPerOp  float64
+	BytesPerOp   float64
+	P50          time.Duration
+	P99          time.Duration
+	Max          time.Duration
+	HookOverhead time.Duration // mean time spent in hooks per request
+}
+
+// String formats the report on a single line.
+func (r LoadTestReport) String() string {
+	return fmt.Sprintf("%d requests (concurrency %d) in %v: %.1f allocs/op, %.0f B/op, p50 %v, p99 %v, max %v, hooks %v/op",
+		r.Requests, r.Concurrency, r.Duration, r.AllocsPerOp, r.BytesPerOp, r.P50, r.P99, r.Max, r.HookOverhead)
+}
+
+// LoadTest drives the full error pipeline (resolution, negotiation, counters,
+// reporter, hooks and rendering) as configured globally, and reports allocations,
+// latency percentiles and hook overhead. Teams can use it in tests or benchmarks
+// to check their configuration against latency budgets before production.
+// Allocations and hook overhead are process-wide, so other concurrent traffic skews them.
+// LoadTest는 전역으로 설정된 전체 오류 파이프라인(변환, 협상, 카운터, 보고기, 훅, 렌더링)을 실행하고
+// 할당량, 지연 시간 백분위수, 훅 오버헤드를 보고합니다. 운영 전에 설정이 지연 시간 예산을 충족하는지 검증할 수 있습니다.
+func LoadTest(cfg LoadTestConfig) LoadTestReport {
+	if cfg.Concurrency <= 0 {
+		cfg.Concurrency = runtime.GOMAXPROCS(0)
+	}
+	if cfg.Requests <= 0 {
+		cfg.Requests = 10000
+	}
+	if len(cfg.Errors) == 0 {
+		cfg.Errors = []error{NotFoundError()}
+	}
+	if len(cfg.Accept) == 0 {
+		cfg.Accept = []string{"application/json"}
+	}
+
+	reqs := make([]*http.Request, len(cfg.Accept))
+	for i, accept := range cfg.Accept {
+		req, _ := http.NewRequest(http.MethodGet, "/loadtest", nil)
+		req.Header.Set("Accept", accept)
+		reqs[i] = req
+	}
+
+	latencies := make([]time.Duration, cfg.Requests)
+	var next atomic.Int64
+	var wg sync.WaitGroup
+
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	hooksBefore := hookNanos.Load()
+	start := time.Now()
+
+	for range cfg.Concurrency {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			w := &discardWriter{header: make(http.Header)}
+			for {
+				i := int(next.Add(1)) - 1
+				if i >= cfg.Requests {
+					return
+				}
+				w.reset()
+				t := time.Now()
+				Respond(w, reqs[i%len(reqs)], cfg.Errors[i%len(cfg.Errors)])
+				latencies[i] = time.Since(t)
+			}
+		}()
+	}
+	wg.Wait()
+
+	elapsed := time.Since(start)
+	hookTotal := hookNanos.Load() - hooksBefore
+	runtime.ReadMemStats(&after)
+
+	slices.Sort(latencies)
+	n := float64(cfg.Requests)
+	return LoadTestReport{
+		Requests:     cfg.Requests,
+		Concurrency:  cfg.Concurrency,
+		Duration:     elapsed,
+		AllocsPerOp:  float64(after.Mallocs-before.Mallocs) / n,
+		BytesPerOp:   float64(after.TotalAlloc-before.TotalAlloc) / n,
+		P50:          percentile(latencies, 0.50),
+		P99:          percentile(latencies, 0.99),
+		Max:          latencies[len(latencies)-1],
+		HookOverhead: time.Duration(float64(hookTotal) / n),
+	}
+}
+
+// percentile returns the p-th percentile of the sorted durations.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	i := int(float64(len(sorted)-1) * p)
+	return sorted[i]
+}
+
+// discardWriter is a reusable ResponseWriter that discards the body.
+type discardWriter struct {
+	header http.Header
+	status int
+}
+
+func (d *discardWriter) Header() http.Header         { return d.header }
+func (d *discardWriter) WriteHeader(status int)      { d.status = status }
+func (d *discardWriter) Write(p []byte) (int, error) { return len(p), nil }
+
+func (d *discardWriter) reset() {
+	clear(d.header)
+	d.status = 0
+}
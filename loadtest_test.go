@@ -0,0 +1,50 @@
+package httperror
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// TestLoadTest tests that LoadTest drives the pipeline and reports its costs.
+func TestLoadTest(t *testing.T) {
+	SetErrorHandler(nil)
+	defer ResetHooks()
+
+	var calls int64
+	AddHook(func(ev ErrorEvent) {
+		calls++
+		time.Sleep(10 * time.Microsecond)
+	})
+
+	report := LoadTest(LoadTestConfig{
+		Concurrency: 1,
+		Requests:    200,
+		Errors:      []error{NotFoundError(), errors.New("boom")},
+		Accept:      []string{"application/json", "text/html"},
+	})
+
+	if calls != 200 {
+		t.Errorf("expected 200 hook calls, got %d", calls)
+	}
+	if report.Requests != 200 || report.Concurrency != 1 {
+		t.Errorf("unexpected report: %v", report)
+	}
+	if report.AllocsPerOp <= 0 {
+		t.Errorf("expected allocations to be measured, got %v", report.AllocsPerOp)
+	}
+	if report.HookOverhead < 10*time.Microsecond {
+		t.Errorf("expected hook overhead of at least 10µs, got %v", report.HookOverhead)
+	}
+	if report.P50 > report.P99 || report.P99 > report.Max {
+		t.Errorf("expected p50 <= p99 <= max, got %v", report)
+	}
+}
+
+// BenchmarkRespond measures the error pipeline with the default configuration.
+func BenchmarkRespond(b *testing.B) {
+	SetErrorHandler(nil)
+	b.ReportAllocs()
+	report := LoadTest(LoadTestConfig{Requests: b.N})
+	b.ReportMetric(float64(report.P99.Nanoseconds()), "p99-ns")
+}
@@ -0,0 +1,26 @@
+package httperror
+
+import (
+	"log/slog"
+	"sync/atomic"
+)
+
+// currentLogger stores the logger used for internal diagnostics.
+// A nil value means slog.Default().
+var currentLogger atomic.Pointer[slog.Logger]
+
+// SetLogger sets the logger used to record internal diagnostics such as
+// reporter panics or hook violations. If nil is provided, slog.Default() is used.
+// SetLogger는 보고기 패닉, 훅 위반 등 내부 진단 정보를 기록할 로거를 설정합니다.
+// nil이 제공되면 slog.Default()를 사용합니다.
+func SetLogger(l *slog.Logger) {
+	currentLogger.Store(l)
+}
+
+// logger returns the configured logger.
+func logger() *slog.Logger {
+	if l := currentLogger.Load(); l != nil {
+		return l
+	}
+	return slog.Default()
+}
@@ -0,0 +1,57 @@
+package httperror
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+)
+
+// LongPollConfig configures LongPoll. Zero values select the defaults.
+// LongPollConfig는 LongPoll을 설정합니다. 0 값은 기본값을 사용합니다.
+type LongPollConfig struct {
+	// Timeout is how long to wait for data. Defaults to 30s.
+	Timeout time.Duration
+	// TimeoutStatus is the status responded when the wait times out without data,
+	// typically 204 No Content or 304 Not Modified. Defaults to 204.
+	TimeoutStatus int
+}
+
+// LongPoll runs wait for a long-poll (comet) endpoint with a context that
+// expires after the configured timeout. wait writes the response itself when
+// data arrives and returns nil.
+//
+// When the wait times out without data, the TimeoutStatus is written directly
+// without a body, as an expected outcome rather than an error: it is not
+// counted, hooked, reported or recorded as LastError. Any other
+// error is a genuine failure and is responded as usual: a client that went away
+// gets the status set by SetCanceledStatus, and a server-side deadline on the
+// request results in 504.
+// LongPoll은 롱 폴링(comet) 엔드포인트를 위해 설정된 시간 후 만료되는 컨텍스트로 wait를 실행합니다.
+// 데이터가 도착하면 wait가 직접 응답을 작성하고 nil을 반환합니다.
+// 데이터 없이 대기 시간이 만료되면 오류가 아닌 정상 결과로서 TimeoutStatus를 본문 없이 직접 작성하며,
+// 이는 집계, 훅, 보고, LastError 기록의 대상이 되지 않습니다.
+// 그 밖의 오류는 실제 실패로 간주되어 평소처럼 응답됩니다.
+func LongPoll(w http.ResponseWriter, r *http.Request, cfg LongPollConfig, wait func(ctx context.Context) error) {
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 30 * time.Second
+	}
+	if cfg.TimeoutStatus == 0 {
+		cfg.TimeoutStatus = http.StatusNoContent
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), cfg.Timeout)
+	defer cancel()
+
+	err := wait(ctx)
+	if err == nil {
+		return
+	}
+	// Only the poll's own timeout is an empty result; the request context
+	// ending is a failure mapped by Respond.
+	if errors.Is(err, context.DeadlineExceeded) && r.Context().Err() == nil {
+		w.WriteHeader(cfg.TimeoutStatus)
+		return
+	}
+	Respond(w, r, err)
+}
@@ -0,0 +1,128 @@
+package httperror
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// TestLongPoll tests the responses of long-poll endpoints.
+func TestLongPoll(t *testing.T) {
+	waitForever := func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+
+	testCases := []struct {
+		name           string
+		cfg            LongPollConfig
+		ctx            func() (context.Context, context.CancelFunc)
+		wait           func(ctx context.Context) error
+		expectedStatus int
+		expectBody     bool
+	}{
+		{
+			name:           "data",
+			wait:           func(context.Context) error { return nil },
+			expectedStatus: http.StatusOK,
+			expectBody:     true,
+		},
+		{
+			name:           "timeout",
+			cfg:            LongPollConfig{Timeout: time.Millisecond},
+			wait:           waitForever,
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:           "timeout not modified",
+			cfg:            LongPollConfig{Timeout: time.Millisecond, TimeoutStatus: http.StatusNotModified},
+			wait:           waitForever,
+			expectedStatus: http.StatusNotModified,
+		},
+		{
+			name:           "genuine failure",
+			wait:           func(context.Context) error { return ServiceUnavailableError() },
+			expectedStatus: http.StatusServiceUnavailable,
+			expectBody:     true,
+		},
+		{
+			name: "client gone",
+			ctx: func() (context.Context, context.CancelFunc) {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+				return ctx, cancel
+			},
+			wait:           waitForever,
+			expectedStatus: http.StatusRequestTimeout,
+			expectBody:     true,
+		},
+		{
+			name: "request deadline",
+			cfg:  LongPollConfig{Timeout: time.Hour},
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithTimeout(context.Background(), time.Millisecond)
+			},
+			wait:           waitForever,
+			expectedStatus: http.StatusGatewayTimeout,
+			expectBody:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/events", nil)
+			if tc.ctx != nil {
+				ctx, cancel := tc.ctx()
+				defer cancel()
+				req = req.WithContext(ctx)
+			}
+			rr := httptest.NewRecorder()
+			LongPoll(rr, req, tc.cfg, func(ctx context.Context) error {
+				err := tc.wait(ctx)
+				if err == nil {
+					fmt.Fprint(rr, "data")
+				}
+				return err
+			})
+
+			if rr.Code != tc.expectedStatus {
+				t.Errorf("expected status %d, got %d", tc.expectedStatus, rr.Code)
+			}
+			if hasBody := rr.Body.Len() > 0; hasBody != tc.expectBody {
+				t.Errorf("expected body %v, got %q", tc.expectBody, rr.Body.String())
+			}
+		})
+	}
+}
+
+// TestLongPollNotCounted tests that timed out polls are not accounted as errors.
+func TestLongPollNotCounted(t *testing.T) {
+	var hooked int
+	AddHook(func(ErrorEvent) { hooked++ })
+	defer ResetHooks()
+
+	before := errorTotal.Value()
+	req := httptest.NewRequest("GET", "/", nil)
+	req = req.WithContext(TrackErrors(req.Context()))
+	rr := httptest.NewRecorder()
+	LongPoll(rr, req, LongPollConfig{Timeout: time.Millisecond}, func(ctx context.Context) error {
+		<-ctx.Done()
+		return fmt.Errorf("waiting for events: %w", ctx.Err())
+	})
+
+	if rr.Code != http.StatusNoContent {
+		t.Errorf("expected status %d, got %d", http.StatusNoContent, rr.Code)
+	}
+	if got := errorTotal.Value(); got != before {
+		t.Errorf("expected error total to stay %d, got %d", before, got)
+	}
+	if hooked != 0 {
+		t.Errorf("expected no hook calls, got %d", hooked)
+	}
+	if err := LastError(req.Context()); err != nil {
+		t.Errorf("expected no last error, got %v", err)
+	}
+}
@@ -0,0 +1,59 @@
+package httperror
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Mapper translates an arbitrary error into an HttpError.
+// It returns false if it does not handle the error.
+// Mapper는 임의의 오류를 HttpError로 변환합니다. 처리하지 않는 오류에 대해서는 false를 반환합니다.
+type Mapper func(err error) (*HttpError, bool)
+
+// mappers holds an immutable snapshot of the registered mappers. Registration
+// replaces the snapshot under mappersMu, so readers never observe a partial update.
+var (
+	mappersMu sync.Mutex
+	mappers   atomic.Pointer[[]Mapper]
+)
+
+// RegisterMapper registers a mapper used by Respond and DefaultErrorHandler to translate
+// errors that are not HttpErrors (e.g. ErrQuotaExceeded → 429) before falling back to 500.
+// Mappers are tried in registration order and the first match wins.
+// RegisterMapper는 HttpError가 아닌 오류(예: ErrQuotaExceeded → 429)를 500으로 처리하기 전에
+// 변환하는 매퍼를 등록합니다. 매퍼는 등록 순서대로 시도되며 처음 일치한 결과가 사용됩니다.
+func RegisterMapper(m Mapper) {
+	if m == nil {
+		return
+	}
+	mappersMu.Lock()
+	defer mappersMu.Unlock()
+	var next []Mapper
+	if ms := mappers.Load(); ms != nil {
+		next = append(next, *ms...)
+	}
+	next = append(next, m)
+	mappers.Store(&next)
+}
+
+// ResetMappers removes all registered mappers.
+// ResetMappers는 등록된 모든 매퍼를 제거합니다.
+func ResetMappers() {
+	mappersMu.Lock()
+	defer mappersMu.Unlock()
+	mappers.Store(nil)
+}
+
+// mapError runs the registered mappers against err.
+func mapError(err error) (*HttpError, bool) {
+	ms := mappers.Load()
+	if ms == nil {
+		return nil, false
+	}
+	for _, m := range *ms {
+		if httpErr, ok := m(err); ok && httpErr != nil {
+			return httpErr, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,55 @@
+package httperror
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestRegisterMapper tests that registered mappers translate domain errors.
+func TestRegisterMapper(t *testing.T) {
+	SetErrorHandler(nil)
+	defer ResetMappers()
+
+	errQuota := errors.New("quota exceeded")
+	errDuplicate := errors.New("duplicate")
+
+	RegisterMapper(func(err error) (*HttpError, bool) {
+		if errors.Is(err, errQuota) {
+			return TooManyRequestsError("quota exceeded"), true
+		}
+		return nil, false
+	})
+	RegisterMapper(func(err error) (*HttpError, bool) {
+		if errors.Is(err, errDuplicate) {
+			return ConflictError(), true
+		}
+		return nil, false
+	})
+	RegisterMapper(func(err error) (*HttpError, bool) {
+		return BadRequestError(), errors.Is(err, errDuplicate) // shadowed by the previous mapper
+	})
+
+	testCases := []struct {
+		name           string
+		err            error
+		expectedStatus int
+	}{
+		{"first mapper", fmt.Errorf("charge: %w", errQuota), http.StatusTooManyRequests},
+		{"first match wins", errDuplicate, http.StatusConflict},
+		{"unmapped", errors.New("unknown"), http.StatusInternalServerError},
+		{"HttpError bypasses mappers", NotFoundError(), http.StatusNotFound},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			Respond(rr, httptest.NewRequest("GET", "/", nil), tc.err)
+			if rr.Code != tc.expectedStatus {
+				t.Errorf("expected status %d, got %d", tc.expectedStatus, rr.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package httperror
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// jsonNames are the names of the members of the JSON encoding of an HttpError.
+type jsonNames struct {
+	Status, Code, Type, Message, Details, Links, Data string
+}
+
+// defaultJSONNames are the names given by the struct tags of HttpError.
+var defaultJSONNames = jsonNames{"status", "code", "type", "message", "details", "links", "data"}
+
+// resolveJSONNames returns the default names with the renames applied, keyed
+// by default name. It fails on unknown fields and duplicate or empty names.
+func resolveJSONNames(renames map[string]string) (jsonNames, error) {
+	names := defaultJSONNames
+	fields := map[string]*string{
+		"status": &names.Status, "code": &names.Code, "type": &names.Type, "message": &names.Message,
+		"details": &names.Details, "links": &names.Links, "data": &names.Data,
+	}
+	for field, name := range renames {
+		p, ok := fields[field]
+		if !ok {
+			return names, fmt.Errorf("unknown field %q", field)
+		}
+		if name == "" {
+			return names, fmt.Errorf("empty name for field %q", field)
+		}
+		*p = name
+	}
+
+	seen := make(map[string]bool, len(fields))
+	for _, p := range fields {
+		if seen[*p] {
+			return names, fmt.Errorf("duplicate field name %q", *p)
+		}
+		seen[*p] = true
+	}
+	return names, nil
+}
+
+// KeyCase is the case of the keys of the details produced by this package,
+// which are in snake case, e.g. "deprecated_since".
+// KeyCase는 이 패키지가 생성하는 상세 정보 키의 표기법으로, 기본은 스네이크 표기법(예: "deprecated_since")입니다.
+type KeyCase int
+
+const (
+	// SnakeCase keeps keys as they are, e.g. "deprecated_since".
+	SnakeCase KeyCase = iota
+	// CamelCase converts keys to camel case, e.g. "deprecatedSince".
+	CamelCase
+)
+
+// WithFieldNames renames the members of JSON error bodies, keyed by their
+// default name ("status", "code", "type", "message", "details", "links" and
+// "data"), e.g. {"message": "detail", "status": "code", "code": "reason"},
+// to follow existing API guidelines. It panics on unknown fields and on names
+// that would collide.
+// WithFieldNames는 기존 API 가이드라인을 따르도록 JSON 오류 본문의 멤버 이름을 기본 이름("status", "code",
+// "type", "message", "details", "links", "data") 기준으로 변경합니다(예: {"message": "detail", "status": "code",
+// "code": "reason"}). 알 수 없는 필드나 충돌하는 이름이 주어지면 패닉을 일으킵니다.
+func WithFieldNames(renames map[string]string) ResponderOption {
+	names, err := resolveJSONNames(renames)
+	if err != nil {
+		panic("httperror: WithFieldNames: " + err.Error())
+	}
+	return func(cfg *responderConfig) {
+		cfg.jsonNames = nil
+		if names != defaultJSONNames {
+			cfg.jsonNames = &names
+		}
+	}
+}
+
+// WithKeyCase sets the case of the detail keys of JSON error bodies,
+// including those of nested objects and joined members.
+// WithKeyCase는 중첩 객체와 결합된 구성 오류를 포함한 JSON 오류 본문의 상세 정보 키 표기법을 설정합니다.
+func WithKeyCase(c KeyCase) ResponderOption {
+	return func(cfg *responderConfig) { cfg.keyCase = c }
+}
+
+// jsonStyle is how a Responder shapes JSON error bodies.
+type jsonStyle struct {
+	names    jsonNames
+	camel    bool
+	envelope string
+}
+
+// jsonStyle returns the JSON style of the configuration, or nil if it is the default.
+func (cfg *responderConfig) jsonStyle() *jsonStyle {
+	if cfg.jsonNames == nil && cfg.keyCase == SnakeCase && cfg.envelope == "" {
+		return nil
+	}
+	st := &jsonStyle{names: defaultJSONNames, camel: cfg.keyCase == CamelCase, envelope: cfg.envelope}
+	if cfg.jsonNames != nil {
+		st.names = *cfg.jsonNames
+	}
+	return st
+}
+
+// appendCamelJSONMap appends m to b like appendJSONMap, with its keys in camel case.
+func appendCamelJSONMap(b []byte, m map[string]any, st *jsonStyle) ([]byte, error) {
+	type entry struct {
+		key   string
+		value any
+	}
+	entries := make([]entry, 0, len(m))
+	for k, v := range m {
+		entries = append(entries, entry{camelCase(k), v})
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].key < entries[j].key })
+
+	b = append(b, '{')
+	for i, en := range entries {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		b = appendJSONKey(b, en.key)
+		var err error
+		if b, err = appendJSONValue(b, en.value, st); err != nil {
+			return nil, err
+		}
+	}
+	return append(b, '}'), nil
+}
+
+// camelCase converts a snake or kebab case key to camel case, e.g.
+// "deprecated_since" to "deprecatedSince".
+func camelCase(key string) string {
+	if !strings.ContainsAny(key, "_-") {
+		return key
+	}
+	var b strings.Builder
+	b.Grow(len(key))
+	upper := false
+	for _, r := range key {
+		switch {
+		case r == '_' || r == '-':
+			upper = b.Len() > 0
+		case upper:
+			b.WriteString(strings.ToUpper(string(r)))
+			upper = false
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// styledJSONEncoder encodes errors as JSON shaped by a Responder's options,
+// see WithEnvelope, WithFieldNames and WithKeyCase.
+type styledJSONEncoder struct {
+	style *jsonStyle
+}
+
+// ContentType implements Encoder.
+func (styledJSONEncoder) ContentType() string {
+	return JSONEncoder{}.ContentType()
+}
+
+// Encode implements Encoder.
+func (enc styledJSONEncoder) Encode(w io.Writer, e *HttpError) error {
+	var b []byte
+	if buf, ok := w.(*bytes.Buffer); ok {
+		b = buf.AvailableBuffer()
+	}
+	if enc.style.envelope != "" {
+		b = append(b, '{')
+		b = appendJSONKey(b, enc.style.envelope)
+	}
+	b, err := e.appendJSON(b, enc.style)
+	if err != nil {
+		return err
+	}
+	if enc.style.envelope != "" {
+		b = append(b, '}')
+	}
+	_, err = w.Write(append(b, '\n'))
+	return err
+}
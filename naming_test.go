@@ -0,0 +1,91 @@
+package httperror
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// TestJSONNaming tests renaming fields and converting the case of detail keys.
+func TestJSONNaming(t *testing.T) {
+	err := ConflictError(
+		WithMessage("Version conflict"),
+		WithCode("version_conflict"),
+		WithDetail("current_version", 3),
+		WithDetail("nested_object", map[string]any{"max_size": 10}),
+		WithData(map[string]any{"user_id": 7}),
+	)
+
+	testCases := []struct {
+		name         string
+		opts         []ResponderOption
+		err          error
+		expectedBody string
+	}{
+		{"default", nil, err, `{"status":409,"code":"version_conflict","message":"Version conflict","details":{"current_version":3,"nested_object":{"max_size":10}},"data":{"user_id":7}}`},
+		{"renamed", []ResponderOption{WithFieldNames(map[string]string{"message": "detail", "status": "code", "code": "reason"})}, err, `{"code":409,"reason":"version_conflict","detail":"Version conflict","details":{"current_version":3,"nested_object":{"max_size":10}},"data":{"user_id":7}}`},
+		{"camel case", []ResponderOption{WithKeyCase(CamelCase)}, err, `{"status":409,"code":"version_conflict","message":"Version conflict","details":{"currentVersion":3,"nestedObject":{"maxSize":10}},"data":{"user_id":7}}`},
+		{"renamed in envelope", []ResponderOption{WithEnvelope("error"), WithFieldNames(map[string]string{"message": "detail"})}, NotFoundError(), `{"error":{"status":404,"detail":"Not Found"}}`},
+		{"reset", []ResponderOption{WithFieldNames(map[string]string{"message": "detail"}), WithFieldNames(nil), WithKeyCase(SnakeCase)}, NotFoundError(), `{"status":404,"message":"Not Found"}`},
+		{"joined members", []ResponderOption{WithFieldNames(map[string]string{"message": "detail"}), WithKeyCase(CamelCase)}, Errors{
+			BadRequestError(WithMessage("Invalid name"), WithDetail("field_name", "name")),
+			BadRequestError(WithMessage("Invalid age")),
+		}, `{"status":400,"detail":"Bad Request","details":{"errors":[{"status":400,"detail":"Invalid name","details":{"fieldName":"name"}},{"status":400,"detail":"Invalid age"}]}}`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rs := NewResponder(tc.opts...)
+			rr := httptest.NewRecorder()
+			rs.HandleError(rr, httptest.NewRequest("GET", "/", nil), tc.err)
+
+			if got := strings.TrimSuffix(rr.Body.String(), "\n"); got != tc.expectedBody {
+				t.Errorf("expected body %s, got %s", tc.expectedBody, got)
+			}
+		})
+	}
+}
+
+// TestWithFieldNamesInvalid tests that invalid renames panic.
+func TestWithFieldNamesInvalid(t *testing.T) {
+	testCases := []struct {
+		name    string
+		renames map[string]string
+	}{
+		{"unknown field", map[string]string{"title": "detail"}},
+		{"empty name", map[string]string{"message": ""}},
+		{"collision", map[string]string{"message": "status"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("expected a panic")
+				}
+			}()
+			WithFieldNames(tc.renames)
+		})
+	}
+}
+
+// TestCamelCase tests converting keys to camel case.
+func TestCamelCase(t *testing.T) {
+	testCases := []struct {
+		key      string
+		expected string
+	}{
+		{"retry_after", "retryAfter"},
+		{"deprecated-since", "deprecatedSince"},
+		{"already", "already"},
+		{"alreadyCamel", "alreadyCamel"},
+		{"_leading", "leading"},
+		{"a__b", "aB"},
+	}
+
+	for _, tc := range testCases {
+		if got := camelCase(tc.key); got != tc.expected {
+			t.Errorf("camelCase(%q): expected %q, got %q", tc.key, tc.expected, got)
+		}
+	}
+}
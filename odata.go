@@ -0,0 +1,73 @@
+package httperror
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// odataError is an error or an error detail of the OData error envelope.
+type odataError struct {
+	Code       string         `json:"code"`
+	Message    string         `json:"message"`
+	Target     string         `json:"target,omitempty"`
+	Details    []odataError   `json:"details,omitempty"`
+	InnerError map[string]any `json:"innererror,omitempty"`
+}
+
+// ODataEncoder encodes errors in the OData v4 JSON error envelope
+// ({"error":{"code","message","target","details","innererror"}}), for
+// services consumed by Excel, Power BI and other OData clients. The code is
+// the error's Code or the name of its status, e.g. "NotFound"; the target is
+// the "target" or "field" detail; the members of a joined error (see
+// SetJoinDetails) become the details, and the other details the innererror.
+// ODataEncoder는 오류를 OData v4 JSON 오류 형식({"error":{"code","message","target","details","innererror"}})으로
+// 인코딩하여 Excel, Power BI 등 OData 클라이언트가 사용하는 서비스에서 사용할 수 있게 합니다. code는 오류의 Code 또는
+// 상태 이름(예: "NotFound")이고, target은 "target" 또는 "field" 상세 정보입니다. 결합된 오류의 구성 오류는 details가
+// 되며(SetJoinDetails 참고), 그 외의 상세 정보는 innererror가 됩니다.
+type ODataEncoder struct{}
+
+// ContentType implements Encoder.
+func (ODataEncoder) ContentType() string {
+	return "application/json; charset=utf-8"
+}
+
+// Encode implements Encoder.
+func (ODataEncoder) Encode(w io.Writer, e *HttpError) error {
+	body := odataErrorOf(e)
+	if members, ok := e.Details["errors"].([]*HttpError); ok {
+		for _, m := range members {
+			detail := odataErrorOf(m)
+			detail.InnerError = nil
+			body.Details = append(body.Details, detail)
+		}
+	}
+	return json.NewEncoder(w).Encode(struct {
+		Error odataError `json:"error"`
+	}{body})
+}
+
+// odataErrorOf returns the OData error describing e, without details.
+func odataErrorOf(e *HttpError) odataError {
+	oe := odataError{Code: e.Code, Message: e.Message}
+	if oe.Code == "" {
+		oe.Code = statusName(e.Status)
+	}
+
+	targetKey := ""
+	for _, k := range []string{"target", "field"} {
+		if target, ok := e.Details[k].(string); ok {
+			oe.Target, targetKey = target, k
+			break
+		}
+	}
+	for k, v := range e.Details {
+		if k == targetKey || k == "errors" {
+			continue
+		}
+		if oe.InnerError == nil {
+			oe.InnerError = make(map[string]any)
+		}
+		oe.InnerError[k] = v
+	}
+	return oe
+}
@@ -0,0 +1,60 @@
+package httperror
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+// TestODataEncoder tests the OData v4 error envelope.
+func TestODataEncoder(t *testing.T) {
+	SetJoinDetails(true)
+	defer SetJoinDetails(false)
+
+	testCases := []struct {
+		name     string
+		err      error
+		expected string
+	}{
+		{
+			"status only",
+			NotFoundError(),
+			`{"error":{"code":"NotFound","message":"Not Found"}}`,
+		},
+		{
+			"target and innererror",
+			BadRequestError("age must be a number", WithCode("InvalidValue"), WithDetail("field", "age"), WithDetail("offset", 12)),
+			`{"error":{"code":"InvalidValue","message":"age must be a number","target":"age","innererror":{"offset":12}}}`,
+		},
+		{
+			"joined errors",
+			errors.Join(
+				UnprocessableEntityError("name is required", WithDetail("target", "name")),
+				UnprocessableEntityError("email is invalid", WithCode("InvalidEmail"), WithDetail("target", "email")),
+			),
+			`{"error":{"code":"UnprocessableEntity","message":"name is required","target":"name","details":[` +
+				`{"code":"UnprocessableEntity","message":"name is required","target":"name"},` +
+				`{"code":"InvalidEmail","message":"email is invalid","target":"email"}]}}`,
+		},
+	}
+
+	rs := NewResponder()
+	rs.SetEncoder(ODataEncoder{})
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			rs.HandleError(rr, httptest.NewRequest("GET", "/", nil), tc.err)
+
+			var got, expected any
+			if err := json.Unmarshal(rr.Body.Bytes(), &got); err != nil {
+				t.Fatalf("could not decode response body: %v", err)
+			}
+			json.Unmarshal([]byte(tc.expected), &expected)
+			if !reflect.DeepEqual(got, expected) {
+				t.Errorf("expected %s, got %s", tc.expected, rr.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package httperror
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// OpenAPIComponents returns OpenAPI 3 components describing the error
+// responses of the given statuses, or of every status listed by Statuses if
+// none are given: an "HttpError" schema and one response object per status,
+// named after its helper (e.g. "NotFound"), with the default error as example.
+// The examples are rendered by the encoder set on the DefaultResponder, styled
+// like its responses, so the documentation follows the configured envelope and
+// field names; when the result is not the plain JSONEncoder,
+// the response schemas are inferred from the examples. The result marshals to
+// JSON and is meant to be merged into the "components" of an OpenAPI document.
+// OpenAPIComponents는 주어진 상태 코드(없으면 Statuses가 나열하는 모든 상태 코드)의 오류 응답을 설명하는
+// OpenAPI 3 컴포넌트를 반환합니다. "HttpError" 스키마와 헬퍼 이름(예: "NotFound")으로 명명된 상태 코드별
+// 응답 객체로 구성되며, 기본 오류를 예시로 포함합니다. 예시는 DefaultResponder에 설정된 인코더로 렌더링되므로
+// 문서가 설정된 응답 형식을 따르며, 인코더가 JSONEncoder가 아니면 응답 스키마는 예시로부터 추론됩니다.
+// 결과는 JSON으로 직렬화할 수 있으며 OpenAPI 문서의 "components"에 병합하여 사용합니다.
+func OpenAPIComponents(statuses ...int) map[string]any {
+	if len(statuses) == 0 {
+		statuses = Statuses()
+	}
+
+	cfg := defaultResponder.config()
+	enc := cfg.encoder
+	if enc == nil {
+		enc = JSONEncoder{}
+	}
+	enc = cfg.customize(enc)
+	_, isJSON := enc.(JSONEncoder)
+	mediaType, _, err := mime.ParseMediaType(enc.ContentType())
+	if err != nil {
+		mediaType = enc.ContentType()
+	}
+
+	responses := make(map[string]any, len(statuses))
+	for _, status := range statuses {
+		e := New(status, "")
+		var buf bytes.Buffer
+		enc.Encode(&buf, e)
+
+		content := map[string]any{}
+		var example any
+		if json.Unmarshal(buf.Bytes(), &example) == nil {
+			content["example"] = example
+		} else {
+			content["example"] = buf.String()
+		}
+		if isJSON {
+			content["schema"] = map[string]any{"$ref": "#/components/schemas/HttpError"}
+		} else {
+			content["schema"] = inferSchema(content["example"])
+		}
+
+		responses[statusName(status)] = map[string]any{
+			"description": e.Message,
+			"content":     map[string]any{mediaType: content},
+		}
+	}
+
+	return map[string]any{
+		"schemas":   map[string]any{"HttpError": errorSchema("#/components/schemas/HttpError")},
+		"responses": responses,
+	}
+}
+
+// inferSchema returns a schema describing the JSON value v.
+func inferSchema(v any) map[string]any {
+	switch v := v.(type) {
+	case map[string]any:
+		properties := make(map[string]any, len(v))
+		for k, item := range v {
+			properties[k] = inferSchema(item)
+		}
+		return map[string]any{"type": "object", "properties": properties}
+	case []any:
+		if len(v) == 0 {
+			return map[string]any{"type": "array"}
+		}
+		return map[string]any{"type": "array", "items": inferSchema(v[0])}
+	case string:
+		return map[string]any{"type": "string"}
+	case float64:
+		if v == float64(int64(v)) {
+			return map[string]any{"type": "integer"}
+		}
+		return map[string]any{"type": "number"}
+	case bool:
+		return map[string]any{"type": "boolean"}
+	}
+	return map[string]any{}
+}
+
+// statusName returns the CamelCase name of status: the name of its helper,
+// the letters of its registered text, or "Status" and the code.
+func statusName(status int) string {
+	for _, f := range statusFamilies {
+		if f.Status == status {
+			return f.Name
+		}
+	}
+	var b strings.Builder
+	upper := true
+	for _, r := range StatusText(status) {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	if b.Len() == 0 {
+		return "Status" + strconv.Itoa(status)
+	}
+	return b.String()
+}
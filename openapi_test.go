@@ -0,0 +1,71 @@
+package httperror
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+// TestOpenAPIComponents tests the generated response components.
+func TestOpenAPIComponents(t *testing.T) {
+	components := OpenAPIComponents(http.StatusNotFound, StatusClientClosedRequest, 591)
+	if _, err := json.Marshal(components); err != nil {
+		t.Fatalf("expected the components to marshal: %v", err)
+	}
+
+	responses := components["responses"].(map[string]any)
+	for _, name := range []string{"NotFound", "ClientClosedRequest", "Status591"} {
+		if _, ok := responses[name]; !ok {
+			t.Errorf("expected a %q response, got %v", name, responses)
+		}
+	}
+
+	content := responses["NotFound"].(map[string]any)["content"].(map[string]any)["application/json"].(map[string]any)
+	if !reflect.DeepEqual(content["example"], map[string]any{"status": float64(404), "message": "Not Found"}) {
+		t.Errorf("unexpected example %v", content["example"])
+	}
+	if !reflect.DeepEqual(content["schema"], map[string]any{"$ref": "#/components/schemas/HttpError"}) {
+		t.Errorf("unexpected schema %v", content["schema"])
+	}
+
+	if got := len(OpenAPIComponents()["responses"].(map[string]any)); got != len(Statuses()) {
+		t.Errorf("expected a response per status, got %d", got)
+	}
+}
+
+// TestOpenAPIComponentsEnvelope tests that the examples follow the configured encoder.
+func TestOpenAPIComponentsEnvelope(t *testing.T) {
+	DefaultResponder().SetEncoder(TwirpEncoder{})
+	defer DefaultResponder().SetEncoder(nil)
+
+	responses := OpenAPIComponents(http.StatusNotFound)["responses"].(map[string]any)
+	content := responses["NotFound"].(map[string]any)["content"].(map[string]any)["application/json"].(map[string]any)
+
+	example := content["example"].(map[string]any)
+	if example["code"] != "not_found" || example["msg"] != "Not Found" {
+		t.Errorf("expected a Twirp example, got %v", example)
+	}
+	properties := content["schema"].(map[string]any)["properties"].(map[string]any)
+	if !reflect.DeepEqual(properties["code"], map[string]any{"type": "string"}) {
+		t.Errorf("expected the schema to be inferred from the example, got %v", content["schema"])
+	}
+}
+
+// TestOpenAPIComponentsStyledEncoder tests that a fixed encoder is documented with the Responder's styling.
+func TestOpenAPIComponentsStyledEncoder(t *testing.T) {
+	DefaultResponder().Configure(WithEncoders(JSONEncoder{}), WithEnvelope("error"), WithFieldNames(map[string]string{"message": "detail"}))
+	defer DefaultResponder().Configure(WithEncoders(), WithEnvelope(""), WithFieldNames(nil))
+
+	responses := OpenAPIComponents(http.StatusNotFound)["responses"].(map[string]any)
+	content := responses["NotFound"].(map[string]any)["content"].(map[string]any)["application/json"].(map[string]any)
+
+	expected := map[string]any{"error": map[string]any{"status": float64(http.StatusNotFound), "detail": "Not Found"}}
+	if !reflect.DeepEqual(content["example"], expected) {
+		t.Errorf("expected %v, got %v", expected, content["example"])
+	}
+	properties := content["schema"].(map[string]any)["properties"].(map[string]any)
+	if _, ok := properties["error"]; !ok {
+		t.Errorf("expected the schema to describe the envelope, got %v", content["schema"])
+	}
+}
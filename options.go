@@ -0,0 +1,133 @@
+package httperror
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Option configures an HttpError created by a helper function.
+// It is either a value returned by one of the With* functions or, for
+// compatibility with the former message ...string signature, a plain string
+// which is treated as WithMessage. Options are applied in order, so when
+// several messages are given the last one wins. Values of any other type
+// are ignored and logged.
+// Option은 헬퍼 함수가 생성하는 HttpError를 설정합니다. With* 함수가 반환하는 값이거나,
+// 이전의 message ...string 시그니처와의 호환을 위해 WithMessage로 취급되는 문자열입니다.
+// 옵션은 순서대로 적용되므로 메시지가 여러 개이면 마지막 메시지가 사용됩니다.
+type Option any
+
+// optionFunc is the concrete type of the options returned by the With* functions.
+type optionFunc func(e *HttpError)
+
+// WithMessage sets the error message.
+// WithMessage는 오류 메시지를 설정합니다.
+func WithMessage(message string) Option {
+	return optionFunc(func(e *HttpError) {
+		e.Message = message
+	})
+}
+
+// WithCode sets a machine-readable error code, e.g. "user_not_found".
+// WithCode는 기계가 읽을 수 있는 오류 코드(예: "user_not_found")를 설정합니다.
+func WithCode(code string) Option {
+	return optionFunc(func(e *HttpError) {
+		e.Code = code
+	})
+}
+
+// WithDetail adds a key/value pair to the error details.
+// WithDetail은 오류 상세 정보에 키/값 쌍을 추가합니다.
+func WithDetail(key string, value any) Option {
+	return optionFunc(func(e *HttpError) {
+		if e.Details == nil {
+			e.Details = make(map[string]any)
+		}
+		e.Details[key] = value
+	})
+}
+
+// WithHeader adds a response header written along with the error.
+// WithHeader는 오류와 함께 작성될 응답 헤더를 추가합니다.
+func WithHeader(key, value string) Option {
+	return optionFunc(func(e *HttpError) {
+		if e.Header == nil {
+			e.Header = make(http.Header)
+		}
+		e.Header.Add(key, value)
+	})
+}
+
+// WithLink adds a link to the error under the relation rel, such as "self",
+// "documentation", "retry" or "support". Links are rendered under "links" in
+// JSON and as anchors in HTML.
+// WithLink는 "self", "documentation", "retry", "support" 같은 관계 rel로 오류에 링크를 추가합니다.
+// 링크는 JSON에서는 "links" 아래에, HTML에서는 앵커로 렌더링됩니다.
+func WithLink(rel, href string) Option {
+	return optionFunc(func(e *HttpError) {
+		if e.Links == nil {
+			e.Links = make(map[string]string)
+		}
+		e.Links[rel] = href
+	})
+}
+
+// WithParam sets the value of a named placeholder of the message template,
+// e.g. NotFoundError("user {id} not found", WithParam("id", 42)).
+// WithParam은 메시지 템플릿의 이름 있는 자리 표시자 값을 설정합니다(예: NotFoundError("user {id} not found", WithParam("id", 42))).
+func WithParam(key string, value any) Option {
+	return optionFunc(func(e *HttpError) {
+		if e.Params == nil {
+			e.Params = make(map[string]any)
+		}
+		e.Params[key] = value
+	})
+}
+
+// WithMessageArgs sets arguments formatting the message, which then acts as
+// a translation key with fmt verbs, e.g. "%d items failed". The message is
+// translated (see RegisterCatalog and SetTranslator), then formatted, when the
+// error is rendered; Message itself keeps the key.
+// WithMessageArgs는 메시지를 서식화할 인자를 설정하며, 이때 메시지는 fmt 동사를 포함한 번역 키(예: "%d items failed")가 됩니다.
+// 메시지는 오류가 렌더링될 때 번역된 후 서식화되며, Message 필드에는 키가 유지됩니다.
+func WithMessageArgs(args ...any) Option {
+	return optionFunc(func(e *HttpError) {
+		e.args = args
+	})
+}
+
+// WithAllow sets the Allow header listing the methods supported by the target
+// resource, which a 405 Method Not Allowed response must carry, e.g.
+// MethodNotAllowedHandler(WithAllow(http.MethodGet, http.MethodPost)).
+// WithAllow는 대상 리소스가 지원하는 메서드를 나열하는 Allow 헤더를 설정합니다.
+// 405 Method Not Allowed 응답에는 이 헤더가 포함되어야 합니다.
+func WithAllow(methods ...string) Option {
+	return optionFunc(func(e *HttpError) {
+		if e.Header == nil {
+			e.Header = make(http.Header)
+		}
+		e.Header.Set("Allow", strings.Join(methods, ", "))
+	})
+}
+
+// newWithOptions creates an HttpError with the default status text and applies opts.
+func newWithOptions(status int, opts []Option) *HttpError {
+	e := New(status, StatusText(status))
+	applyOptions(e, opts)
+	return e
+}
+
+// applyOptions applies opts to e, adapting plain strings to WithMessage.
+func applyOptions(e *HttpError, opts []Option) {
+	for _, opt := range opts {
+		switch o := opt.(type) {
+		case nil:
+		case string:
+			e.Message = o
+		case optionFunc:
+			o(e)
+		default:
+			logger().Warn("httperror: ignoring unsupported option", "type", fmt.Sprintf("%T", opt))
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package httperror
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+// TestOptions tests that helper options configure the HttpError.
+func TestOptions(t *testing.T) {
+	err := NotFoundError(
+		WithMessage("user not found"),
+		WithCode("user_not_found"),
+		WithDetail("id", 42),
+		WithHeader("X-Resource", "user"),
+	)
+
+	if err.Status != http.StatusNotFound || err.Message != "user not found" || err.Code != "user_not_found" {
+		t.Errorf("unexpected error: %+v", err)
+	}
+	if err.Details["id"] != 42 {
+		t.Errorf("expected detail id=42, got %v", err.Details)
+	}
+	if err.Header.Get("X-Resource") != "user" {
+		t.Errorf("expected header X-Resource, got %v", err.Header)
+	}
+}
+
+// TestOptionsStringCompatibility tests that plain strings still set the message.
+func TestOptionsStringCompatibility(t *testing.T) {
+	testCases := []struct {
+		name     string
+		opts     []Option
+		expected string
+	}{
+		{"no options", nil, "Conflict"},
+		{"string", []Option{"already exists"}, "already exists"},
+		{"last message wins", []Option{"first", WithMessage("second")}, "second"},
+		{"nil and unsupported values are ignored", []Option{nil, 42, "kept"}, "kept"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := ConflictError(tc.opts...).Message; got != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+// TestOptionsRendered tests that codes, details and headers reach the response.
+func TestOptionsRendered(t *testing.T) {
+	SetErrorHandler(nil)
+
+	rr := httptest.NewRecorder()
+	TooManyRequests(rr, httptest.NewRequest("GET", "/", nil),
+		WithCode("rate_limited"),
+		WithDetail("limit", 100),
+		WithHeader("Retry-After", "30"),
+	)
+
+	if rr.Code != http.StatusTooManyRequests {
+		t.Errorf("expected status %d, got %d", http.StatusTooManyRequests, rr.Code)
+	}
+	if rr.Header().Get("Retry-After") != "30" {
+		t.Errorf("expected Retry-After header, got %v", rr.Header())
+	}
+
+	var body HttpError
+	if err := json.NewDecoder(rr.Body).Decode(&body); err != nil {
+		t.Fatalf("could not decode response body: %v", err)
+	}
+	if body.Code != "rate_limited" || body.Details["limit"] != float64(100) {
+		t.Errorf("unexpected body: %+v", body)
+	}
+}
+
+// TestLinks tests that links are rendered in JSON and HTML.
+func TestLinks(t *testing.T) {
+	err := ServiceUnavailableError("maintenance",
+		WithLink("retry", "/orders"),
+		WithLink("documentation", `https://example.com/errors?id=1&q="x"`),
+	)
+
+	rr := httptest.NewRecorder()
+	Respond(rr, httptest.NewRequest("GET", "/", nil), err)
+	var body HttpError
+	if err := json.NewDecoder(rr.Body).Decode(&body); err != nil {
+		t.Fatalf("could not decode response body: %v", err)
+	}
+	if !reflect.DeepEqual(body.Links, err.Links) {
+		t.Errorf("expected links %v, got %v", err.Links, body.Links)
+	}
+
+	req := httptest.NewRequest("GET", "/", nil)
+	req.Header.Set("Accept", "text/html")
+	rr = httptest.NewRecorder()
+	Respond(rr, req, err)
+	expected := `<div class="http-error">maintenance` +
+		` <a rel="documentation" href="https://example.com/errors?id=1&amp;q=&#34;x&#34;">documentation</a>` +
+		` <a rel="retry" href="/orders">retry</a></div>`
+	if rr.Body.String() != expected {
+		t.Errorf("expected %s, got %s", expected, rr.Body.String())
+	}
+}
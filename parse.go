@@ -0,0 +1,92 @@
+package httperror
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+)
+
+// maxErrorBody is the maximum size of an error body read by ParseResponse.
+const maxErrorBody = 1 << 20
+
+// ParseResponse decodes the error carried by a response, so services calling
+// each other can round-trip errors through HttpError. It returns nil, nil for
+// responses below 400. Bodies in this package's JSON format and RFC 9457
+// problem details (application/problem+json) are decoded; for other content
+// types the error only carries the response status and its text. The status
+// of the response always wins over the one in the body. The response headers
+// are kept in the error's Header, for helpers such as RetryAfter; headers
+// describing the body are not copied when the error is rendered again.
+// The payload set with WithData is kept as raw JSON, decoded by DataOf.
+// An error is returned, along with the status-only HttpError, when the body
+// exceeds 1 MiB or cannot be read or decoded. The body is read but not closed.
+// ParseResponse는 응답에 담긴 오류를 디코딩하여 서비스 간에 HttpError로 오류를 주고받을 수 있게 합니다.
+// 400 미만의 응답에 대해서는 nil, nil을 반환합니다. 이 패키지의 JSON 형식과 RFC 9457 문제 상세
+// (application/problem+json)를 디코딩하며, 다른 콘텐츠 타입은 응답 상태 코드와 텍스트만 담습니다.
+// 응답 헤더는 오류의 Header에 보존되며, WithData로 설정된 페이로드는 DataOf로 디코딩되는 원시 JSON으로 보존됩니다.
+// 본문이 1 MiB를 넘거나 읽기/디코딩에 실패하면 상태 코드만 담은 HttpError와 함께 오류를 반환합니다.
+// 본문은 읽지만 닫지 않습니다.
+func ParseResponse(resp *http.Response) (*HttpError, error) {
+	if resp.StatusCode < 400 {
+		return nil, nil
+	}
+	fallback := New(resp.StatusCode, "")
+	fallback.Header = resp.Header.Clone()
+
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if mediaType != "application/json" && !strings.HasSuffix(mediaType, "+json") {
+		return fallback, nil
+	}
+	if resp.Body == nil {
+		return fallback, nil
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody+1))
+	if err != nil {
+		return fallback, fmt.Errorf("httperror: reading error body: %w", err)
+	}
+	if len(data) > maxErrorBody {
+		return fallback, fmt.Errorf("httperror: error body exceeds %d bytes", maxErrorBody)
+	}
+
+	var body struct {
+		Code    string            `json:"code"`
+		Message string            `json:"message"`
+		Details map[string]any    `json:"details"`
+		Links   map[string]string `json:"links"`
+		Data    json.RawMessage   `json:"data"`
+		// Problem details members.
+		Type   string `json:"type"`
+		Title  string `json:"title"`
+		Detail string `json:"detail"`
+	}
+	if err := json.Unmarshal(data, &body); err != nil {
+		return fallback, fmt.Errorf("httperror: decoding error body: %w", err)
+	}
+
+	e := fallback
+	e.Code = body.Code
+	e.Details = body.Details
+	e.Links = body.Links
+	if len(body.Data) > 0 && string(body.Data) != "null" {
+		e.Data = body.Data
+	}
+	switch {
+	case body.Message != "":
+		e.Message = body.Message
+	case body.Detail != "":
+		e.Message = body.Detail
+	case body.Title != "":
+		e.Message = body.Title
+	}
+	if body.Type != "" && body.Type != "about:blank" {
+		e.Type = body.Type
+		if e.Code == "" {
+			e.Code = body.Type
+		}
+	}
+	return e, nil
+}
@@ -0,0 +1,84 @@
+package httperror
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestParseResponse tests decoding error responses back into HttpErrors.
+func TestParseResponse(t *testing.T) {
+	response := func(status int, contentType, body string) *http.Response {
+		h := make(http.Header)
+		if contentType != "" {
+			h.Set("Content-Type", contentType)
+		}
+		return &http.Response{StatusCode: status, Header: h, Body: io.NopCloser(strings.NewReader(body))}
+	}
+
+	testCases := []struct {
+		name      string
+		resp      *http.Response
+		expected  *HttpError
+		expectErr bool
+	}{
+		{"success", response(http.StatusOK, "application/json", `{}`), nil, false},
+		{
+			"package format",
+			response(http.StatusNotFound, "application/json; charset=utf-8", `{"status":404,"code":"user_not_found","message":"no such user","details":{"id":"42"}}`),
+			&HttpError{Status: http.StatusNotFound, Code: "user_not_found", Message: "no such user", Details: map[string]any{"id": "42"}},
+			false,
+		},
+		{
+			"problem details",
+			response(http.StatusForbidden, "application/problem+json", `{"type":"https://example.com/probs/out-of-credit","title":"You do not have enough credit.","detail":"Your balance is 30."}`),
+			&HttpError{Status: http.StatusForbidden, Code: "https://example.com/probs/out-of-credit", Type: "https://example.com/probs/out-of-credit", Message: "Your balance is 30."},
+			false,
+		},
+		{
+			"status wins",
+			response(http.StatusBadGateway, "application/json", `{"status":200,"message":"upstream"}`),
+			&HttpError{Status: http.StatusBadGateway, Message: "upstream"},
+			false,
+		},
+		{"unstructured", response(http.StatusInternalServerError, "text/plain", "oops"), New(http.StatusInternalServerError, ""), false},
+		{"malformed", response(http.StatusBadRequest, "application/json", "{"), New(http.StatusBadRequest, ""), true},
+		{"too large", response(http.StatusBadRequest, "application/json", `"`+strings.Repeat("x", maxErrorBody)+`"`), New(http.StatusBadRequest, ""), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParseResponse(tc.resp)
+			if (err != nil) != tc.expectErr {
+				t.Errorf("expected error %v, got %v", tc.expectErr, err)
+			}
+			if got != nil {
+				if !reflect.DeepEqual(got.Header, tc.resp.Header) {
+					t.Errorf("expected the response headers to be kept, got %v", got.Header)
+				}
+				got.Header = nil
+			}
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected %+v, got %+v", tc.expected, got)
+			}
+		})
+	}
+}
+
+// TestParseResponseRoundTrip tests that a rendered error parses back into an equal HttpError.
+func TestParseResponseRoundTrip(t *testing.T) {
+	sent := ConflictError("email taken", WithCode("email_taken"), WithDetail("field", "email"), WithLink("support", "mailto:support@example.com"))
+	rr := httptest.NewRecorder()
+	Respond(rr, httptest.NewRequest("POST", "/users", nil), sent)
+
+	got, err := ParseResponse(rr.Result())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Status != sent.Status || got.Code != sent.Code || got.Message != sent.Message || !reflect.DeepEqual(got.Details, sent.Details) || !reflect.DeepEqual(got.Links, sent.Links) {
+		t.Errorf("expected %+v, got %+v", sent, got)
+	}
+}
@@ -0,0 +1,66 @@
+package httperror
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// pathEncoder is the encoder of the errors of requests under a path prefix.
+type pathEncoder struct {
+	prefix string
+	enc    Encoder
+}
+
+// WithPathEncoders fixes the encoder of the errors of requests by path
+// prefix, regardless of their Accept header, e.g. {"/api/": JSONEncoder{},
+// "/": HTMLEncoder{}} so browsers hitting API routes still get JSON. The
+// longest matching prefix wins; requests matching none are negotiated as
+// usual. A nil map removes the rules.
+// WithPathEncoders는 Accept 헤더와 관계없이 요청 경로 접두사별로 오류 인코더를 고정합니다. 예를 들어
+// {"/api/": JSONEncoder{}, "/": HTMLEncoder{}}이면 브라우저가 API 경로를 요청해도 JSON을 받습니다.
+// 가장 긴 접두사가 우선하며, 어느 것과도 일치하지 않는 요청은 평소처럼 협상됩니다. nil 맵은 규칙을 제거합니다.
+func WithPathEncoders(rules map[string]Encoder) ResponderOption {
+	var encoders []pathEncoder
+	for prefix, enc := range rules {
+		if enc != nil {
+			encoders = append(encoders, pathEncoder{prefix, enc})
+		}
+	}
+	sort.Slice(encoders, func(i, j int) bool {
+		if len(encoders[i].prefix) != len(encoders[j].prefix) {
+			return len(encoders[i].prefix) > len(encoders[j].prefix)
+		}
+		return encoders[i].prefix < encoders[j].prefix
+	})
+	return func(cfg *responderConfig) { cfg.pathEncoders = encoders }
+}
+
+// pathEncoder returns the encoder fixed for the path of r, if any.
+func (cfg *responderConfig) pathEncoder(r *http.Request) (Encoder, bool) {
+	if r == nil || r.URL == nil {
+		return nil, false
+	}
+	for _, pe := range cfg.pathEncoders {
+		if strings.HasPrefix(r.URL.Path, pe.prefix) {
+			return pe.enc, true
+		}
+	}
+	return nil, false
+}
+
+// formatEncoders are the encoders named in the formats of a Config.
+var formatEncoders = map[string]Encoder{
+	"json": JSONEncoder{},
+	"html": HTMLEncoder{},
+}
+
+// formatEncoder returns the encoder named format in a Config.
+func formatEncoder(format string) (Encoder, error) {
+	enc, ok := formatEncoders[strings.ToLower(format)]
+	if !ok {
+		return nil, fmt.Errorf("unknown format %q", format)
+	}
+	return enc, nil
+}
@@ -0,0 +1,56 @@
+package httperror
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// TestWithPathEncoders tests fixing the encoder by path prefix.
+func TestWithPathEncoders(t *testing.T) {
+	rules := map[string]Encoder{"/api/": JSONEncoder{}, "/api/legacy/": TwirpEncoder{}, "/": HTMLEncoder{}}
+
+	testCases := []struct {
+		name         string
+		opts         []ResponderOption
+		target       string
+		accept       string
+		expectedType string
+	}{
+		{"api with html accept", []ResponderOption{WithPathEncoders(rules)}, "/api/users", "text/html", "application/json; charset=utf-8"},
+		{"longest prefix", []ResponderOption{WithPathEncoders(rules)}, "/api/legacy/users", "text/html", "application/json"},
+		{"fallback", []ResponderOption{WithPathEncoders(rules)}, "/users", "application/json", "text/html; charset=utf-8"},
+		{"over fixed encoder", []ResponderOption{WithEncoders(HTMLEncoder{}), WithPathEncoders(rules)}, "/api/users", "", "application/json; charset=utf-8"},
+		{"no match", []ResponderOption{WithPathEncoders(map[string]Encoder{"/api/": JSONEncoder{}})}, "/users", "text/html", "text/html; charset=utf-8"},
+		{"removed", []ResponderOption{WithPathEncoders(rules), WithPathEncoders(nil)}, "/api/users", "text/html", "text/html; charset=utf-8"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rs := NewResponder(tc.opts...)
+			req := httptest.NewRequest("GET", tc.target, nil)
+			if tc.accept != "" {
+				req.Header.Set("Accept", tc.accept)
+			}
+			rr := httptest.NewRecorder()
+			rs.HandleError(rr, req, NotFoundError())
+
+			if got := rr.Header().Get("Content-Type"); got != tc.expectedType {
+				t.Errorf("expected Content-Type %q, got %q", tc.expectedType, got)
+			}
+		})
+	}
+}
+
+// TestWithPathEncodersStyle tests that fixed built-in encoders keep the Responder's JSON style.
+func TestWithPathEncodersStyle(t *testing.T) {
+	rs := NewResponder(WithPathEncoders(map[string]Encoder{"/api/": JSONEncoder{}}), WithEnvelope("error"))
+	req := httptest.NewRequest("GET", "/api/users", nil)
+	req.Header.Set("Accept", "text/html")
+	rr := httptest.NewRecorder()
+	rs.HandleError(rr, req, NotFoundError())
+
+	if got := strings.TrimSuffix(rr.Body.String(), "\n"); got != `{"error":{"status":404,"message":"Not Found"}}` {
+		t.Errorf("unexpected body %s", got)
+	}
+}
@@ -0,0 +1,49 @@
+package httperror
+
+import "net/http"
+
+// Payment describes what a client must pay for to use a metered or paywalled
+// API, sent with a 402 Payment Required response.
+// Payment는 과금되거나 유료인 API를 사용하기 위해 클라이언트가 지불해야 하는 내용을 설명하며,
+// 402 Payment Required 응답과 함께 전송됩니다.
+type Payment struct {
+	// Plan is the plan required for the request, e.g. "pro".
+	Plan string
+	// UpgradeURL is where the client can upgrade or pay.
+	UpgradeURL string
+	// Challenge is a payment challenge sent in the WWW-Authenticate header,
+	// e.g. an L402 challenge carrying a macaroon and a lightning invoice:
+	// Challenge{Scheme: "L402", Params: map[string]string{"macaroon": m, "invoice": i}}.
+	// It is omitted if its scheme is empty.
+	Challenge Challenge
+}
+
+// WithPayment adds the payment information of p to the details, as a
+// "payment" object with "plan" and "upgrade_url" members, and sends its
+// challenge in the WWW-Authenticate header, e.g.
+// PaymentRequired(w, r, WithPayment(Payment{Plan: "pro", UpgradeURL: url})).
+// WithPayment는 p의 결제 정보를 "plan"과 "upgrade_url" 멤버를 가진 "payment" 객체로 상세 정보에 추가하고,
+// 챌린지를 WWW-Authenticate 헤더로 전송합니다(예: PaymentRequired(w, r, WithPayment(Payment{Plan: "pro", UpgradeURL: url}))).
+func WithPayment(p Payment) Option {
+	return optionFunc(func(e *HttpError) {
+		payment := make(map[string]any, 2)
+		if p.Plan != "" {
+			payment["plan"] = p.Plan
+		}
+		if p.UpgradeURL != "" {
+			payment["upgrade_url"] = p.UpgradeURL
+		}
+		if len(payment) > 0 {
+			if e.Details == nil {
+				e.Details = make(map[string]any)
+			}
+			e.Details["payment"] = payment
+		}
+		if p.Challenge.Scheme != "" {
+			if e.Header == nil {
+				e.Header = make(http.Header)
+			}
+			e.Header.Add("WWW-Authenticate", p.Challenge.String())
+		}
+	})
+}
@@ -0,0 +1,36 @@
+package httperror
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestWithPayment tests that 402 responses carry the payment information.
+func TestWithPayment(t *testing.T) {
+	testCases := []struct {
+		name           string
+		payment        Payment
+		expectedHeader string
+		expectedBody   string
+	}{
+		{"plan", Payment{Plan: "pro", UpgradeURL: "https://example.com/billing"}, "", `{"status":402,"message":"Payment Required","details":{"payment":{"plan":"pro","upgrade_url":"https://example.com/billing"}}}`},
+		{"l402", Payment{Challenge: Challenge{Scheme: "L402", Params: map[string]string{"macaroon": "AGIAJEem", "invoice": "lnbc100n1"}}}, `L402 invoice="lnbc100n1", macaroon="AGIAJEem"`, `{"status":402,"message":"Payment Required"}`},
+		{"empty", Payment{}, "", `{"status":402,"message":"Payment Required"}`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			PaymentRequired(rr, httptest.NewRequest("GET", "/reports", nil), WithPayment(tc.payment))
+			if rr.Code != http.StatusPaymentRequired {
+				t.Errorf("expected status 402, got %d", rr.Code)
+			}
+			if got := rr.Header().Get("WWW-Authenticate"); got != tc.expectedHeader {
+				t.Errorf("expected WWW-Authenticate %q, got %q", tc.expectedHeader, got)
+			}
+			if got := rr.Body.String(); got != tc.expectedBody+"\n" {
+				t.Errorf("expected body %s, got %s", tc.expectedBody, got)
+			}
+		})
+	}
+}
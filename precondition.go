@@ -0,0 +1,64 @@
+package httperror
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Validators are the current validators of a resource, sent with a 412
+// Precondition Failed response so optimistic-concurrency clients can refetch
+// and retry without another round trip.
+// Validators는 리소스의 현재 검증자로, 낙관적 동시성 클라이언트가 추가 왕복 없이 다시 가져와 재시도할 수 있도록
+// 412 Precondition Failed 응답과 함께 전송됩니다.
+type Validators struct {
+	// ETag is the entity tag, e.g. `"v3"` or `W/"v3"`; it is quoted if needed.
+	ETag string
+	// LastModified is the modification time of the resource.
+	LastModified time.Time
+}
+
+// etag returns the entity tag of v, quoted if it is not already.
+func (v Validators) etag() string {
+	if v.ETag == "" || strings.HasPrefix(v.ETag, `"`) || strings.HasPrefix(v.ETag, `W/"`) {
+		return v.ETag
+	}
+	return `"` + v.ETag + `"`
+}
+
+// WithValidators sets the ETag and Last-Modified headers of v and adds them
+// to the details ("etag" and "last_modified", in RFC 3339 format).
+// WithValidators는 v의 ETag와 Last-Modified 헤더를 설정하고 상세 정보("etag", "last_modified", RFC 3339 형식)에 추가합니다.
+func WithValidators(v Validators) Option {
+	return optionFunc(func(e *HttpError) {
+		if e.Header == nil {
+			e.Header = make(http.Header)
+		}
+		if e.Details == nil {
+			e.Details = make(map[string]any)
+		}
+		if etag := v.etag(); etag != "" {
+			e.Header.Set("ETag", etag)
+			e.Details["etag"] = etag
+		}
+		if !v.LastModified.IsZero() {
+			e.Header.Set("Last-Modified", v.LastModified.UTC().Format(http.TimeFormat))
+			e.Details["last_modified"] = v.LastModified.UTC().Format(time.RFC3339)
+		}
+	})
+}
+
+// PreconditionFailedValidators responds with a 412 Precondition Failed error
+// carrying the current validators of the resource, see WithValidators.
+// PreconditionFailedValidators는 리소스의 현재 검증자를 담은 412 Precondition Failed 오류로 응답합니다.
+// WithValidators를 참고하세요.
+func PreconditionFailedValidators(w http.ResponseWriter, r *http.Request, v Validators, opts ...Option) {
+	Respond(w, r, PreconditionFailedValidatorsError(v, opts...))
+}
+
+// PreconditionFailedValidatorsError creates a 412 Precondition Failed
+// HttpError carrying the current validators of the resource.
+// PreconditionFailedValidatorsError는 리소스의 현재 검증자를 담은 412 Precondition Failed HttpError를 생성합니다.
+func PreconditionFailedValidatorsError(v Validators, opts ...Option) *HttpError {
+	return newWithOptions(http.StatusPreconditionFailed, append([]Option{WithValidators(v)}, opts...))
+}
@@ -0,0 +1,44 @@
+package httperror
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// TestPreconditionFailedValidators tests that 412 responses carry the current validators.
+func TestPreconditionFailedValidators(t *testing.T) {
+	modified := time.Date(2026, 3, 1, 12, 30, 0, 0, time.FixedZone("KST", 9*60*60))
+
+	testCases := []struct {
+		name                 string
+		validators           Validators
+		expectedETag         string
+		expectedLastModified string
+		expectedBody         string
+	}{
+		{"both", Validators{ETag: `"v3"`, LastModified: modified}, `"v3"`, "Sun, 01 Mar 2026 03:30:00 GMT", `{"status":412,"message":"Precondition Failed","details":{"etag":"\"v3\"","last_modified":"2026-03-01T03:30:00Z"}}`},
+		{"unquoted etag", Validators{ETag: "v3"}, `"v3"`, "", `{"status":412,"message":"Precondition Failed","details":{"etag":"\"v3\""}}`},
+		{"weak etag", Validators{ETag: `W/"v3"`}, `W/"v3"`, "", `{"status":412,"message":"Precondition Failed","details":{"etag":"W/\"v3\""}}`},
+		{"last modified", Validators{LastModified: modified}, "", "Sun, 01 Mar 2026 03:30:00 GMT", `{"status":412,"message":"Precondition Failed","details":{"last_modified":"2026-03-01T03:30:00Z"}}`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			PreconditionFailedValidators(rr, httptest.NewRequest("PUT", "/docs/1", nil), tc.validators)
+			if rr.Code != http.StatusPreconditionFailed {
+				t.Errorf("expected status 412, got %d", rr.Code)
+			}
+			if got := rr.Header().Get("ETag"); got != tc.expectedETag {
+				t.Errorf("expected ETag %q, got %q", tc.expectedETag, got)
+			}
+			if got := rr.Header().Get("Last-Modified"); got != tc.expectedLastModified {
+				t.Errorf("expected Last-Modified %q, got %q", tc.expectedLastModified, got)
+			}
+			if got := rr.Body.String(); got != tc.expectedBody+"\n" {
+				t.Errorf("expected body %s, got %s", tc.expectedBody, got)
+			}
+		})
+	}
+}
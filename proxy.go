@@ -0,0 +1,41 @@
+package httperror
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+)
+
+// ProxyErrorHandler matches the signature of httputil.ReverseProxy.ErrorHandler.
+// It classifies transport errors and renders them through Respond instead of
+// the proxy's bare 502 text: timeouts become 504 Gateway Timeout, requests
+// canceled by the client 499 Client Closed Request, and any other failure to
+// reach the upstream (connection refused, DNS errors, resets) 502 Bad Gateway.
+// The transport error is kept as the cause of the rendered HttpError.
+// ProxyErrorHandler는 httputil.ReverseProxy.ErrorHandler와 같은 시그니처를 가집니다.
+// 전송 오류를 분류하여 프록시의 기본 502 텍스트 대신 Respond로 렌더링합니다.
+// 시간 초과는 504, 클라이언트가 취소한 요청은 499, 그 밖의 업스트림 연결 실패는 502가 됩니다.
+//
+//	proxy := httputil.NewSingleHostReverseProxy(target)
+//	proxy.ErrorHandler = httperror.ProxyErrorHandler
+func ProxyErrorHandler(w http.ResponseWriter, r *http.Request, err error) {
+	status := proxyErrorStatus(r, err)
+	e := New(status, StatusText(status))
+	e.cause = err
+	Respond(w, r, e)
+}
+
+// proxyErrorStatus returns the status of a transport error returned by a ReverseProxy.
+func proxyErrorStatus(r *http.Request, err error) int {
+	var netErr net.Error
+	switch {
+	case errors.Is(err, context.Canceled) && r.Context().Err() != nil:
+		return StatusClientClosedRequest
+	case errors.Is(err, context.DeadlineExceeded),
+		errors.As(err, &netErr) && netErr.Timeout():
+		return http.StatusGatewayTimeout
+	}
+	// Connection refused, DNS failures, resets and malformed upstream responses.
+	return http.StatusBadGateway
+}
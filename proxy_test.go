@@ -0,0 +1,80 @@
+package httperror
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// TestProxyErrorHandler tests the classification of transport errors.
+func TestProxyErrorHandler(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	testCases := []struct {
+		name           string
+		ctx            context.Context
+		err            error
+		expectedStatus int
+	}{
+		{"client canceled", canceled, context.Canceled, StatusClientClosedRequest},
+		{"deadline", context.Background(), context.DeadlineExceeded, http.StatusGatewayTimeout},
+		{"net timeout", context.Background(), &net.OpError{Op: "read", Err: os.ErrDeadlineExceeded}, http.StatusGatewayTimeout},
+		{"refused", context.Background(), &net.OpError{Op: "dial", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}, http.StatusBadGateway},
+		{"other", context.Background(), errors.New("unexpected EOF"), http.StatusBadGateway},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			req := httptest.NewRequest("GET", "/", nil).WithContext(tc.ctx)
+			ProxyErrorHandler(rr, req, tc.err)
+
+			if rr.Code != tc.expectedStatus {
+				t.Errorf("expected status %d, got %d", tc.expectedStatus, rr.Code)
+			}
+			if tc.expectedStatus == StatusClientClosedRequest {
+				if rr.Body.Len() != 0 {
+					t.Errorf("expected no body for a closed connection, got %q", rr.Body.String())
+				}
+				return
+			}
+			var body HttpError
+			if err := json.NewDecoder(rr.Body).Decode(&body); err != nil {
+				t.Fatalf("could not decode response body: %v", err)
+			}
+			if body.Status != tc.expectedStatus || body.Message == "" {
+				t.Errorf("unexpected body: %+v", body)
+			}
+		})
+	}
+}
+
+// TestProxyErrorHandlerReverseProxy tests the handler installed on a ReverseProxy.
+func TestProxyErrorHandlerReverseProxy(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := ln.Addr().String()
+	ln.Close() // nothing listens on addr anymore
+
+	proxy := httputil.NewSingleHostReverseProxy(&url.URL{Scheme: "http", Host: addr})
+	proxy.ErrorHandler = ProxyErrorHandler
+	proxy.Transport = &http.Transport{DialContext: (&net.Dialer{Timeout: time.Second}).DialContext}
+
+	rr := httptest.NewRecorder()
+	proxy.ServeHTTP(rr, httptest.NewRequest("GET", "/", nil))
+	if rr.Code != http.StatusBadGateway || rr.Header().Get("Content-Type") != "application/json; charset=utf-8" {
+		t.Errorf("expected structured 502, got %d %q", rr.Code, rr.Header().Get("Content-Type"))
+	}
+}
@@ -0,0 +1,34 @@
+package httperror
+
+import (
+	"net/http"
+	"strconv"
+)
+
+// WithUnsatisfiedRange sets the Content-Range header a 416 Range Not
+// Satisfiable response must carry, "bytes */size", where size is the current
+// length of the selected representation (RFC 9110, section 15.5.17).
+// WithUnsatisfiedRange는 416 Range Not Satisfiable 응답에 포함되어야 하는 Content-Range 헤더
+// "bytes */size"를 설정합니다. size는 선택된 표현의 현재 길이입니다(RFC 9110, 15.5.17절).
+func WithUnsatisfiedRange(size int64) Option {
+	return optionFunc(func(e *HttpError) {
+		if e.Header == nil {
+			e.Header = make(http.Header)
+		}
+		e.Header.Set("Content-Range", "bytes */"+strconv.FormatInt(size, 10))
+	})
+}
+
+// RangeNotSatisfiableSize responds with a 416 Range Not Satisfiable error for
+// a resource of size bytes, see WithUnsatisfiedRange.
+// RangeNotSatisfiableSize는 size 바이트 리소스에 대한 416 Range Not Satisfiable 오류로 응답합니다. WithUnsatisfiedRange를 참고하세요.
+func RangeNotSatisfiableSize(w http.ResponseWriter, r *http.Request, size int64, opts ...Option) {
+	Respond(w, r, RangeNotSatisfiableSizeError(size, opts...))
+}
+
+// RangeNotSatisfiableSizeError creates a 416 Range Not Satisfiable HttpError
+// for a resource of size bytes, see WithUnsatisfiedRange.
+// RangeNotSatisfiableSizeError는 size 바이트 리소스에 대한 416 Range Not Satisfiable HttpError를 생성합니다.
+func RangeNotSatisfiableSizeError(size int64, opts ...Option) *HttpError {
+	return newWithOptions(http.StatusRequestedRangeNotSatisfiable, append([]Option{WithUnsatisfiedRange(size)}, opts...))
+}
@@ -0,0 +1,30 @@
+package httperror
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestRangeNotSatisfiableSize tests that 416 responses carry the resource size.
+func TestRangeNotSatisfiableSize(t *testing.T) {
+	rr := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/files/report.pdf", nil)
+	req.Header.Set("Range", "bytes=5000-")
+	RangeNotSatisfiableSize(rr, req, 4096)
+
+	if rr.Code != http.StatusRequestedRangeNotSatisfiable {
+		t.Errorf("expected status 416, got %d", rr.Code)
+	}
+	if got := rr.Header().Get("Content-Range"); got != "bytes */4096" {
+		t.Errorf("expected Content-Range bytes */4096, got %q", got)
+	}
+
+	t.Run("other statuses drop Content-Range", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		Respond(rr, httptest.NewRequest("GET", "/", nil), BadRequestError(WithUnsatisfiedRange(4096)))
+		if got := rr.Header().Get("Content-Range"); got != "" {
+			t.Errorf("expected no Content-Range, got %q", got)
+		}
+	})
+}
@@ -0,0 +1,89 @@
+package httperror
+
+import (
+	"math"
+	"net/http"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// Limiter decides whether a request may proceed. *rate.Limiter from
+// golang.org/x/time/rate satisfies it.
+// Limiter는 요청의 진행 여부를 결정합니다. golang.org/x/time/rate의 *rate.Limiter가 이 인터페이스를 만족합니다.
+type Limiter interface {
+	Allow() bool
+}
+
+// RateLimit returns a middleware rejecting requests denied by l with a 429
+// Too Many Requests carrying a Retry-After header.
+//
+// When l also reports its state like *rate.Limiter does, with Burst() int,
+// Tokens() float64 and a Limit() method returning events per second, every
+// response carries X-RateLimit-Limit, X-RateLimit-Remaining and
+// X-RateLimit-Reset headers, and Retry-After is the time until the next token
+// is available. Otherwise Retry-After is 1 second.
+// RateLimit은 l이 거부한 요청을 Retry-After 헤더를 포함한 429 Too Many Requests로 거부하는 미들웨어를 반환합니다.
+// l이 *rate.Limiter처럼 Burst(), Tokens(), Limit() 메서드로 상태를 제공하면 모든 응답에 X-RateLimit-* 헤더가
+// 포함되며, Retry-After는 다음 토큰을 사용할 수 있을 때까지의 시간이 됩니다. 그렇지 않으면 Retry-After는 1초입니다.
+func RateLimit(l Limiter) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			allowed := l.Allow()
+			state, hasState := limiterState(l)
+			if hasState {
+				h := w.Header()
+				h.Set("X-RateLimit-Limit", strconv.Itoa(state.burst))
+				h.Set("X-RateLimit-Remaining", strconv.Itoa(max(0, int(math.Floor(state.tokens)))))
+				h.Set("X-RateLimit-Reset", seconds(state.untilTokens(float64(state.burst))))
+			}
+			if allowed {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			retryAfter := time.Second
+			if hasState {
+				retryAfter = max(time.Second, state.untilTokens(1))
+			}
+			Respond(w, r, TooManyRequestsError(WithHeader("Retry-After", seconds(retryAfter))))
+		})
+	}
+}
+
+// rateState is the state of a token bucket limiter.
+type rateState struct {
+	burst  int
+	tokens float64
+	rate   float64 // tokens per second
+}
+
+// untilTokens returns how long it takes for the bucket to hold n tokens, rounded up to the second.
+func (s rateState) untilTokens(n float64) time.Duration {
+	missing := n - s.tokens
+	if missing <= 0 || s.rate <= 0 || math.IsInf(s.rate, 1) {
+		return 0
+	}
+	return time.Duration(math.Ceil(missing/s.rate)) * time.Second
+}
+
+// limiterState reads the state of limiters shaped like *rate.Limiter. Limit is
+// read by reflection, as its result type (rate.Limit) is defined by x/time/rate.
+func limiterState(l Limiter) (rateState, bool) {
+	bucket, ok := l.(interface {
+		Burst() int
+		Tokens() float64
+	})
+	if !ok {
+		return rateState{}, false
+	}
+	m := reflect.ValueOf(l).MethodByName("Limit")
+	if !m.IsValid() || m.Type().NumIn() != 0 || m.Type().NumOut() != 1 || m.Type().Out(0).Kind() != reflect.Float64 {
+		return rateState{}, false
+	}
+	return rateState{
+		burst:  bucket.Burst(),
+		tokens: bucket.Tokens(),
+		rate:   m.Call(nil)[0].Float(),
+	}, true
+}
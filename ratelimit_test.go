@@ -0,0 +1,78 @@
+package httperror
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// limit mirrors rate.Limit of golang.org/x/time/rate.
+type limit float64
+
+// bucketLimiter is a token bucket shaped like *rate.Limiter, without refill.
+type bucketLimiter struct {
+	burst  int
+	tokens float64
+	rate   limit
+}
+
+func (b *bucketLimiter) Allow() bool {
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+func (b *bucketLimiter) Burst() int      { return b.burst }
+func (b *bucketLimiter) Tokens() float64 { return b.tokens }
+func (b *bucketLimiter) Limit() limit    { return b.rate }
+
+// countLimiter only implements Allow.
+type countLimiter struct{ left int }
+
+func (c *countLimiter) Allow() bool {
+	c.left--
+	return c.left >= 0
+}
+
+// TestRateLimit tests 429 responses and rate limit headers.
+func TestRateLimit(t *testing.T) {
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+
+	t.Run("token bucket", func(t *testing.T) {
+		handler := RateLimit(&bucketLimiter{burst: 2, tokens: 2, rate: 0.25})(ok)
+		expected := []struct {
+			status               int
+			remaining, retry, rs string
+		}{
+			{http.StatusOK, "1", "", "4"},
+			{http.StatusOK, "0", "", "8"},
+			{http.StatusTooManyRequests, "0", "4", "8"},
+		}
+		for i, e := range expected {
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, httptest.NewRequest("GET", "/", nil))
+			h := rr.Header()
+			if rr.Code != e.status || h.Get("X-RateLimit-Limit") != "2" || h.Get("X-RateLimit-Remaining") != e.remaining ||
+				h.Get("Retry-After") != e.retry || h.Get("X-RateLimit-Reset") != e.rs {
+				t.Errorf("request %d: unexpected response %d %v", i, rr.Code, h)
+			}
+		}
+	})
+
+	t.Run("plain limiter", func(t *testing.T) {
+		handler := RateLimit(&countLimiter{left: 1})(ok)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, httptest.NewRequest("GET", "/", nil))
+		if rr.Code != http.StatusOK || rr.Header().Get("X-RateLimit-Limit") != "" {
+			t.Errorf("unexpected first response %d %v", rr.Code, rr.Header())
+		}
+
+		rr = httptest.NewRecorder()
+		handler.ServeHTTP(rr, httptest.NewRequest("GET", "/", nil))
+		if rr.Code != http.StatusTooManyRequests || rr.Header().Get("Retry-After") != "1" {
+			t.Errorf("unexpected second response %d %v", rr.Code, rr.Header())
+		}
+	})
+}
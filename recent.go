@@ -0,0 +1,84 @@
+package httperror
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RecentError is an error recently responded by Respond, as kept by the
+// journal (see SetJournalSize).
+// RecentError는 저널에 보관된(SetJournalSize 참고), Respond가 최근에 응답한 오류입니다.
+type RecentError struct {
+	Time    time.Time `json:"time"`
+	Status  int       `json:"status"`
+	Code    string    `json:"code,omitempty"`
+	Route   string    `json:"route,omitempty"`
+	Message string    `json:"message"`
+}
+
+// RecentErrors returns up to n of the errors kept by the journal, most recent
+// first, or all of them if n is not positive.
+// RecentErrors는 저널에 보관된 오류를 최근 순으로 최대 n개 반환하며, n이 양수가 아니면 모두 반환합니다.
+func RecentErrors(n int) []RecentError {
+	entries := journalSince(time.Time{})
+	if n <= 0 || n > len(entries) {
+		n = len(entries)
+	}
+	recent := make([]RecentError, 0, n)
+	for i := len(entries) - 1; i >= len(entries)-n; i-- {
+		e := entries[i]
+		recent = append(recent, RecentError{Time: e.Time, Status: e.Status, Code: e.Code, Route: e.Route, Message: e.Message})
+	}
+	return recent
+}
+
+// writeRecentErrorsHTML writes the errors as a minimal HTML page.
+func writeRecentErrorsHTML(w io.Writer, recent []RecentError) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "<!DOCTYPE html>\n<html><head><title>Recent errors</title></head><body>\n")
+	fmt.Fprintf(&b, "<h1>%d recent errors</h1>\n<table>\n<tr><th>Time</th><th>Status</th><th>Code</th><th>Route</th><th>Message</th></tr>\n", len(recent))
+	for _, e := range recent {
+		fmt.Fprintf(&b, "<tr><td>%s</td><td>%d</td><td>%s</td><td>%s</td><td>%s</td></tr>\n",
+			e.Time.Format(time.RFC3339), e.Status, html.EscapeString(e.Code), html.EscapeString(e.Route), html.EscapeString(e.Message))
+	}
+	b.WriteString("</table>\n</body></html>\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// RecentErrorsHandler returns an http.Handler listing RecentErrors, a
+// zero-dependency debugging console meant to be mounted as an internal
+// endpoint, e.g. in staging environments, as it exposes error messages. The
+// "limit" query parameter caps the number of errors, 100 by default. The list
+// is HTML when the client accepts text/html and JSON otherwise.
+// RecentErrorsHandler는 RecentErrors를 나열하는 http.Handler를 반환합니다. 외부 의존성이 없는 디버깅 콘솔로,
+// 오류 메시지를 노출하므로 스테이징 환경 같은 내부 엔드포인트로 마운트하는 용도입니다.
+// "limit" 쿼리 매개변수로 오류 수를 제한하며 기본값은 100입니다.
+func RecentErrorsHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		limit := 100
+		if v := r.URL.Query().Get("limit"); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil || n <= 0 {
+				BadRequest(w, r, "invalid limit parameter")
+				return
+			}
+			limit = n
+		}
+
+		recent := RecentErrors(limit)
+		if strings.Contains(r.Header.Get("Accept"), "text/html") {
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			writeRecentErrorsHTML(w, recent)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		json.NewEncoder(w).Encode(recent)
+	})
+}
@@ -0,0 +1,101 @@
+package httperror
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestRecentErrors tests listing the most recent errors first.
+func TestRecentErrors(t *testing.T) {
+	SetJournalSize(3)
+	defer SetJournalSize(0)
+
+	for _, route := range []string{"/a", "/b", "/c", "/d"} {
+		req := httptest.NewRequest("GET", route+"/1", nil)
+		Respond(httptest.NewRecorder(), req.WithContext(WithRoute(req.Context(), route+"/{id}")), NotFoundError(WithCode("missing")))
+	}
+
+	testCases := []struct {
+		name           string
+		n              int
+		expectedRoutes []string
+	}{
+		{"all", 0, []string{"GET /d/{id}", "GET /c/{id}", "GET /b/{id}"}},
+		{"limited", 2, []string{"GET /d/{id}", "GET /c/{id}"}},
+		{"more than kept", 10, []string{"GET /d/{id}", "GET /c/{id}", "GET /b/{id}"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			recent := RecentErrors(tc.n)
+			if len(recent) != len(tc.expectedRoutes) {
+				t.Fatalf("expected %d errors, got %+v", len(tc.expectedRoutes), recent)
+			}
+			for i, route := range tc.expectedRoutes {
+				if recent[i].Route != route || recent[i].Status != 404 || recent[i].Code != "missing" {
+					t.Errorf("unexpected error %d: %+v", i, recent[i])
+				}
+			}
+		})
+	}
+}
+
+// TestRecentErrorsHandler tests serving the recent errors as JSON and HTML.
+func TestRecentErrorsHandler(t *testing.T) {
+	SetJournalSize(16)
+	defer SetJournalSize(0)
+	Respond(httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil), BadRequestError("<script>"))
+	Respond(httptest.NewRecorder(), httptest.NewRequest("GET", "/orders", nil), ConflictError())
+
+	rr := httptest.NewRecorder()
+	RecentErrorsHandler().ServeHTTP(rr, httptest.NewRequest("GET", "/debug/recent?limit=1", nil))
+	var recent []RecentError
+	if err := json.NewDecoder(rr.Body).Decode(&recent); err != nil {
+		t.Fatalf("could not decode errors: %v", err)
+	}
+	if len(recent) != 1 || recent[0].Status != 409 {
+		t.Errorf("unexpected errors: %+v", recent)
+	}
+
+	req := httptest.NewRequest("GET", "/debug/recent", nil)
+	req.Header.Set("Accept", "text/html")
+	rr = httptest.NewRecorder()
+	RecentErrorsHandler().ServeHTTP(rr, req)
+	if body := rr.Body.String(); strings.Contains(body, "<script>") || !strings.Contains(body, "&lt;script&gt;") {
+		t.Errorf("expected escaped HTML, got %s", body)
+	}
+
+	rr = httptest.NewRecorder()
+	RecentErrorsHandler().ServeHTTP(rr, httptest.NewRequest("GET", "/debug/recent?limit=x", nil))
+	if rr.Code != 400 {
+		t.Errorf("expected 400 for an invalid limit, got %d", rr.Code)
+	}
+}
+
+// TestJournalDisabledByDefault tests that nothing is recorded until the journal is sized.
+func TestJournalDisabledByDefault(t *testing.T) {
+	Respond(httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil), NotFoundError())
+	if recent := RecentErrors(0); len(recent) != 0 {
+		t.Errorf("expected no recorded errors, got %+v", recent)
+	}
+}
+
+// TestRecentErrorsRedacted tests that the journal keeps messages scrubbed by the configured redactors.
+func TestRecentErrorsRedacted(t *testing.T) {
+	SetJournalSize(4)
+	defer SetJournalSize(0)
+	DefaultResponder().Configure(WithRedactors(RedactPatterns()))
+	defer DefaultResponder().Configure(WithRedactors())
+
+	Respond(httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil), BadRequestError("no account for jane@example.com"))
+
+	recent := RecentErrors(0)
+	if len(recent) != 1 || recent[0].Message != "no account for "+Redacted {
+		t.Errorf("expected a redacted message, got %+v", recent)
+	}
+	if got := TaxonomyReport(time.Time{}).ByStatus[0].Example; got != "no account for "+Redacted {
+		t.Errorf("expected a redacted example, got %q", got)
+	}
+}
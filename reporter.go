@@ -0,0 +1,245 @@
+package httperror
+
+import (
+	"context"
+	"net/http"
+	"runtime/debug"
+	"sync"
+	"time"
+)
+
+// Report describes a single server error (5xx) delivered to a Reporter.
+// Report는 Reporter에 전달되는 단일 서버 오류(5xx)를 나타냅니다.
+type Report struct {
+	// Request is a clone of the original request, detached from its cancellation.
+	// The body is shared with the original request and has usually been consumed.
+	// It is nil for errors responded without a request.
+	Request *http.Request
+	// Err is the error passed to Respond.
+	Err error
+	// HttpError is the HttpError the error was resolved to.
+	HttpError *HttpError
+	// Stack is the goroutine stack trace captured when Respond was called.
+	Stack []byte
+	// Time is when the error was responded.
+	Time time.Time
+}
+
+// Reporter receives batches of server error reports, e.g. to forward them to
+// Sentry, Bugsnag or Rollbar. Report is called from a background goroutine,
+// never concurrently, and a panic inside it is recovered and logged.
+// Reporter는 서버 오류 보고서 묶음을 받아 Sentry, Bugsnag, Rollbar 등의 서비스로 전달합니다.
+// Report는 백그라운드 고루틴에서 순차적으로 호출되며, 내부에서 발생한 패닉은 복구되어 기록됩니다.
+type Reporter interface {
+	Report(reports []Report)
+}
+
+// ReporterFunc is an adapter to allow the use of ordinary functions as Reporters.
+// ReporterFunc는 일반 함수를 Reporter로 사용할 수 있게 해주는 어댑터입니다.
+type ReporterFunc func(reports []Report)
+
+// Report calls f(reports).
+func (f ReporterFunc) Report(reports []Report) {
+	f(reports)
+}
+
+// ReporterConfig configures how reports are queued and batched.
+// Zero values select the defaults.
+// ReporterConfig는 보고서의 대기열 및 일괄 처리 방식을 설정합니다. 0 값은 기본값을 사용합니다.
+type ReporterConfig struct {
+	// BatchSize is the maximum number of reports per Report call. Defaults to 10.
+	BatchSize int
+	// FlushInterval is the maximum time a report waits for its batch to fill. Defaults to 1s.
+	FlushInterval time.Duration
+	// QueueSize is the number of pending reports; reports are dropped when it is full. Defaults to 1024.
+	QueueSize int
+}
+
+var (
+	reporterMu sync.RWMutex
+	reporter   *asyncReporter
+)
+
+// SetReporter installs rep as the global error reporter, invoked asynchronously
+// for every 5xx error handled by Respond. Any previously installed reporter is
+// flushed and stopped. If nil is provided, reporting is disabled.
+// SetReporter는 Respond가 처리하는 모든 5xx 오류에 대해 비동기적으로 호출되는 전역 보고기를 설정합니다.
+// 기존 보고기는 남은 보고서를 전송한 뒤 중지됩니다. nil이 제공되면 보고가 비활성화됩니다.
+func SetReporter(rep Reporter, config ...ReporterConfig) {
+	var next *asyncReporter
+	if rep != nil {
+		var cfg ReporterConfig
+		if len(config) > 0 {
+			cfg = config[0]
+		}
+		next = newAsyncReporter(rep, cfg)
+	}
+
+	reporterMu.Lock()
+	prev := reporter
+	reporter = next
+	reporterMu.Unlock()
+
+	if prev != nil {
+		prev.stop()
+	}
+}
+
+// FlushReports blocks until all queued reports have been delivered to the
+// reporter, or ctx is done. It is typically called during graceful shutdown.
+// FlushReports는 대기 중인 모든 보고서가 전달되거나 ctx가 종료될 때까지 대기합니다.
+func FlushReports(ctx context.Context) error {
+	reporterMu.RLock()
+	rep := reporter
+	reporterMu.RUnlock()
+	if rep == nil {
+		return nil
+	}
+	return rep.flush(ctx)
+}
+
+// reportError queues a report for server errors when a reporter is installed.
+func reportError(r *http.Request, err error, httpErr *HttpError) {
+	if httpErr.Status < http.StatusInternalServerError {
+		return
+	}
+
+	reporterMu.RLock()
+	defer reporterMu.RUnlock()
+	if reporter == nil {
+		return
+	}
+
+	var clone *http.Request
+	if r != nil {
+		clone = r.Clone(context.WithoutCancel(r.Context()))
+	}
+	reporter.enqueue(Report{
+		Request:   clone,
+		Err:       err,
+		HttpError: httpErr,
+		Stack:     debug.Stack(),
+		Time:      time.Now(),
+	})
+}
+
+// asyncReporter batches reports and delivers them from a single goroutine.
+type asyncReporter struct {
+	rep     Reporter
+	cfg     ReporterConfig
+	queue   chan Report
+	flushes chan chan struct{}
+	done    chan struct{}
+}
+
+func newAsyncReporter(rep Reporter, cfg ReporterConfig) *asyncReporter {
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 10
+	}
+	if cfg.FlushInterval <= 0 {
+		cfg.FlushInterval = time.Second
+	}
+	if cfg.QueueSize <= 0 {
+		cfg.QueueSize = 1024
+	}
+	a := &asyncReporter{
+		rep:     rep,
+		cfg:     cfg,
+		queue:   make(chan Report, cfg.QueueSize),
+		flushes: make(chan chan struct{}),
+		done:    make(chan struct{}),
+	}
+	go a.run()
+	return a
+}
+
+// enqueue adds a report without blocking; it is dropped if the queue is full.
+func (a *asyncReporter) enqueue(rp Report) {
+	select {
+	case a.queue <- rp:
+	default:
+		logger().Warn("httperror: report queue full, dropping report", "status", rp.HttpError.Status)
+	}
+}
+
+func (a *asyncReporter) flush(ctx context.Context) error {
+	ack := make(chan struct{})
+	select {
+	case a.flushes <- ack:
+	case <-a.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	select {
+	case <-ack:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// stop delivers the remaining reports and terminates the goroutine.
+func (a *asyncReporter) stop() {
+	close(a.queue)
+	<-a.done
+}
+
+func (a *asyncReporter) run() {
+	defer close(a.done)
+
+	ticker := time.NewTicker(a.cfg.FlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]Report, 0, a.cfg.BatchSize)
+	send := func() {
+		if len(batch) > 0 {
+			a.deliver(batch)
+			batch = make([]Report, 0, a.cfg.BatchSize)
+		}
+	}
+
+	for {
+		select {
+		case rp, ok := <-a.queue:
+			if !ok {
+				send()
+				return
+			}
+			batch = append(batch, rp)
+			if len(batch) >= a.cfg.BatchSize {
+				send()
+			}
+		case <-ticker.C:
+			send()
+		case ack := <-a.flushes:
+			for drained := false; !drained; {
+				select {
+				case rp, ok := <-a.queue:
+					if !ok {
+						drained = true
+						break
+					}
+					batch = append(batch, rp)
+					if len(batch) >= a.cfg.BatchSize {
+						send()
+					}
+				default:
+					drained = true
+				}
+			}
+			send()
+			close(ack)
+		}
+	}
+}
+
+// deliver calls the reporter, recovering from any panic it raises.
+func (a *asyncReporter) deliver(batch []Report) {
+	defer func() {
+		if v := recover(); v != nil {
+			logger().Error("httperror: reporter panicked", "panic", v)
+		}
+	}()
+	a.rep.Report(batch)
+}
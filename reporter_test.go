@@ -0,0 +1,106 @@
+package httperror
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestReporter tests that server errors are reported asynchronously in batches.
+func TestReporter(t *testing.T) {
+	SetErrorHandler(nil)
+
+	var mu sync.Mutex
+	var batches [][]Report
+	SetReporter(ReporterFunc(func(reports []Report) {
+		mu.Lock()
+		defer mu.Unlock()
+		batches = append(batches, reports)
+	}), ReporterConfig{BatchSize: 2, FlushInterval: time.Hour})
+	defer SetReporter(nil)
+
+	req := httptest.NewRequest("GET", "/report", nil)
+	Respond(httptest.NewRecorder(), req, errors.New("db down"))
+	Respond(httptest.NewRecorder(), req, New(http.StatusNotFound, "Not Found")) // not reported
+	Respond(httptest.NewRecorder(), req, New(http.StatusBadGateway, "Bad Gateway"))
+	Respond(httptest.NewRecorder(), req, New(http.StatusServiceUnavailable, "Service Unavailable"))
+
+	if err := FlushReports(context.Background()); err != nil {
+		t.Fatalf("unexpected flush error: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(batches) != 2 {
+		t.Fatalf("expected 2 batches, got %d", len(batches))
+	}
+	if len(batches[0]) != 2 || len(batches[1]) != 1 {
+		t.Errorf("expected batch sizes [2 1], got [%d %d]", len(batches[0]), len(batches[1]))
+	}
+
+	first := batches[0][0]
+	if first.Err == nil || first.Err.Error() != "db down" {
+		t.Errorf("expected original error, got %v", first.Err)
+	}
+	if first.HttpError.Status != http.StatusInternalServerError {
+		t.Errorf("expected status 500, got %d", first.HttpError.Status)
+	}
+	if first.Request == nil || first.Request.URL.Path != "/report" {
+		t.Errorf("expected request clone for /report, got %+v", first.Request)
+	}
+	if len(first.Stack) == 0 {
+		t.Error("expected a stack trace")
+	}
+}
+
+// TestReporterPanic tests that a panicking reporter does not crash the process.
+func TestReporterPanic(t *testing.T) {
+	SetErrorHandler(nil)
+
+	calls := make(chan struct{}, 2)
+	SetReporter(ReporterFunc(func(reports []Report) {
+		calls <- struct{}{}
+		panic("reporter failure")
+	}), ReporterConfig{BatchSize: 1})
+	defer SetReporter(nil)
+
+	req := httptest.NewRequest("GET", "/", nil)
+	Respond(httptest.NewRecorder(), req, errors.New("first"))
+	Respond(httptest.NewRecorder(), req, errors.New("second"))
+
+	if err := FlushReports(context.Background()); err != nil {
+		t.Fatalf("unexpected flush error: %v", err)
+	}
+	if len(calls) != 2 {
+		t.Errorf("expected reporter to keep running after a panic, got %d calls", len(calls))
+	}
+}
+
+// TestReporterNilRequest tests that background errors are reported without a request.
+func TestReporterNilRequest(t *testing.T) {
+	reports := make(chan Report, 1)
+	SetReporter(ReporterFunc(func(rs []Report) {
+		for _, rp := range rs {
+			reports <- rp
+		}
+	}))
+	defer SetReporter(nil)
+
+	Respond(nil, nil, errors.New("background failure"))
+	if err := FlushReports(context.Background()); err != nil {
+		t.Fatalf("unexpected flush error: %v", err)
+	}
+
+	select {
+	case rp := <-reports:
+		if rp.Request != nil {
+			t.Errorf("expected nil request, got %+v", rp.Request)
+		}
+	default:
+		t.Error("expected the error to be reported")
+	}
+}
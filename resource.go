@@ -0,0 +1,64 @@
+package httperror
+
+import "net/http"
+
+// WithResource adds the type and identifier of the resource the error is
+// about to the details ("resource_type" and "resource_id"), so clients can
+// tell e.g. a missing user from a missing order programmatically.
+// WithResource는 오류 대상 리소스의 타입과 식별자를 상세 정보("resource_type", "resource_id")에 추가하여,
+// 클라이언트가 예를 들어 사용자 없음과 주문 없음을 프로그램적으로 구분할 수 있게 합니다.
+func WithResource(kind, id string) Option {
+	return optionFunc(func(e *HttpError) {
+		if e.Details == nil {
+			e.Details = make(map[string]any)
+		}
+		e.Details["resource_type"] = kind
+		e.Details["resource_id"] = id
+	})
+}
+
+// NotFoundResource responds with a 404 Not Found error about the resource of
+// type kind identified by id, see WithResource.
+// NotFoundResource는 id로 식별되는 kind 타입 리소스에 대한 404 Not Found 오류로 응답합니다. WithResource를 참고하세요.
+func NotFoundResource(w http.ResponseWriter, r *http.Request, kind, id string, opts ...Option) {
+	Respond(w, r, NotFoundResourceError(kind, id, opts...))
+}
+
+// NotFoundResourceError creates a 404 Not Found HttpError about the resource
+// of type kind identified by id, see WithResource.
+// NotFoundResourceError는 id로 식별되는 kind 타입 리소스에 대한 404 Not Found HttpError를 생성합니다.
+func NotFoundResourceError(kind, id string, opts ...Option) *HttpError {
+	return newWithOptions(http.StatusNotFound, append([]Option{WithResource(kind, id)}, opts...))
+}
+
+// WithExisting points the client at the existing resource the request
+// conflicts with, e.g. the object an idempotent create already made: location
+// is sent in the Location header and added to the details ("location").
+// WithExisting은 요청과 충돌하는 기존 리소스(예: 멱등 생성 요청이 이미 만든 객체)를 클라이언트에 알려줍니다.
+// location은 Location 헤더로 전송되고 상세 정보("location")에 추가됩니다.
+func WithExisting(location string) Option {
+	return optionFunc(func(e *HttpError) {
+		if e.Header == nil {
+			e.Header = make(http.Header)
+		}
+		e.Header.Set("Location", location)
+		if e.Details == nil {
+			e.Details = make(map[string]any)
+		}
+		e.Details["location"] = location
+	})
+}
+
+// ConflictExisting responds with a 409 Conflict error pointing at the existing
+// resource at location, see WithExisting.
+// ConflictExisting은 location의 기존 리소스를 가리키는 409 Conflict 오류로 응답합니다. WithExisting을 참고하세요.
+func ConflictExisting(w http.ResponseWriter, r *http.Request, location string, opts ...Option) {
+	Respond(w, r, ConflictExistingError(location, opts...))
+}
+
+// ConflictExistingError creates a 409 Conflict HttpError pointing at the
+// existing resource at location, see WithExisting.
+// ConflictExistingError는 location의 기존 리소스를 가리키는 409 Conflict HttpError를 생성합니다.
+func ConflictExistingError(location string, opts ...Option) *HttpError {
+	return newWithOptions(http.StatusConflict, append([]Option{WithExisting(location)}, opts...))
+}
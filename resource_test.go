@@ -0,0 +1,55 @@
+package httperror
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestNotFoundResource tests that 404 responses carry the missing resource.
+func TestNotFoundResource(t *testing.T) {
+	testCases := []struct {
+		name         string
+		kind         string
+		id           string
+		opts         []Option
+		expectedBody string
+	}{
+		{"user", "user", "42", nil, `{"status":404,"message":"Not Found","details":{"resource_id":"42","resource_type":"user"}}`},
+		{"order with message", "order", "A-7", []Option{"order A-7 does not exist", WithCode("order_not_found")}, `{"status":404,"code":"order_not_found","message":"order A-7 does not exist","details":{"resource_id":"A-7","resource_type":"order"}}`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			NotFoundResource(rr, httptest.NewRequest("GET", "/", nil), tc.kind, tc.id, tc.opts...)
+			if rr.Code != http.StatusNotFound {
+				t.Errorf("expected status 404, got %d", rr.Code)
+			}
+			if got := rr.Body.String(); got != tc.expectedBody+"\n" {
+				t.Errorf("expected body %s, got %s", tc.expectedBody, got)
+			}
+		})
+	}
+
+	e := NotFoundResourceError("user", "42")
+	if kind, _ := e.Get("resource_type"); kind != "user" {
+		t.Errorf("expected resource type user, got %v", kind)
+	}
+}
+
+// TestConflictExisting tests that 409 responses point at the existing resource.
+func TestConflictExisting(t *testing.T) {
+	rr := httptest.NewRecorder()
+	ConflictExisting(rr, httptest.NewRequest("POST", "/orders", nil), "/orders/A-7", WithResource("order", "A-7"))
+
+	if rr.Code != http.StatusConflict {
+		t.Errorf("expected status 409, got %d", rr.Code)
+	}
+	if got := rr.Header().Get("Location"); got != "/orders/A-7" {
+		t.Errorf("expected Location /orders/A-7, got %q", got)
+	}
+	expected := `{"status":409,"message":"Conflict","details":{"location":"/orders/A-7","resource_id":"A-7","resource_type":"order"}}` + "\n"
+	if got := rr.Body.String(); got != expected {
+		t.Errorf("expected body %s, got %s", expected, got)
+	}
+}
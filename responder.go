@@ -0,0 +1,279 @@
+package httperror
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"log/slog"
+	"maps"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Responder renders errors as JSON or HTML responses according to its own
+// configuration. Its HandleError method is an ErrorHandler, so a configured
+// Responder can be installed with SetErrorHandler(rs.HandleError).
+// Responder는 자체 설정에 따라 오류를 JSON 또는 HTML 응답으로 렌더링합니다.
+// HandleError 메서드는 ErrorHandler이므로 SetErrorHandler(rs.HandleError)로 설치할 수 있습니다.
+//
+// A Responder may be reconfigured while it serves requests: setters publish a
+// new configuration snapshot, and each HandleError call renders with the
+// snapshot taken when it started, never a mix of old and new settings.
+// Responder는 요청 처리 중에도 설정을 변경할 수 있습니다. 설정 메서드는 새 설정 스냅샷을 게시하며,
+// 각 HandleError 호출은 시작 시점의 스냅샷으로만 렌더링합니다.
+type Responder struct {
+	mu  sync.Mutex // serializes updates
+	cfg atomic.Pointer[responderConfig]
+}
+
+// responderConfig is an immutable snapshot of a Responder's configuration.
+type responderConfig struct {
+	surrogate          map[int]SurrogatePolicy
+	surrogateByClass   map[int]SurrogatePolicy
+	flush              bool
+	writeTimeout       time.Duration
+	debug              bool
+	encoder            Encoder
+	encoders           []Encoder
+	pathEncoders       []pathEncoder
+	htmlTemplate       *template.Template
+	envelope           string
+	jsonNames          *jsonNames
+	keyCase            KeyCase
+	logger             *slog.Logger
+	messages           map[int]string
+	language           string
+	severityDetail     bool
+	redactors          []Redactor
+	hideTraceIDs       bool
+	echoIdempotencyKey bool
+	cors               *CORSPolicy
+	compressMin        int
+	cacheControl       *string
+	statusCacheControl map[int]string
+}
+
+// defaultResponder is the Responder used by DefaultErrorHandler.
+var defaultResponder = NewResponder()
+
+// NewResponder creates a Responder with the default configuration, changed by opts.
+// NewResponder는 기본 설정에 opts를 적용한 Responder를 생성합니다.
+func NewResponder(opts ...ResponderOption) *Responder {
+	cfg := &responderConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	rs := &Responder{}
+	rs.cfg.Store(cfg)
+	return rs
+}
+
+// config returns the current configuration snapshot.
+func (rs *Responder) config() *responderConfig {
+	if cfg := rs.cfg.Load(); cfg != nil {
+		return cfg
+	}
+	return &responderConfig{}
+}
+
+// update publishes a new configuration, made by applying fn to a copy of the current one.
+func (rs *Responder) update(fn func(cfg *responderConfig)) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	next := *rs.config()
+	next.surrogate = maps.Clone(next.surrogate)
+	next.surrogateByClass = maps.Clone(next.surrogateByClass)
+	next.messages = maps.Clone(next.messages)
+	next.statusCacheControl = maps.Clone(next.statusCacheControl)
+	fn(&next)
+	rs.cfg.Store(&next)
+}
+
+// DefaultResponder returns the Responder used by DefaultErrorHandler, so the
+// default rendering can be configured in place.
+// DefaultResponder는 DefaultErrorHandler가 사용하는 Responder를 반환하여 기본 렌더링 설정을 변경할 수 있게 합니다.
+func DefaultResponder() *Responder {
+	return defaultResponder
+}
+
+// Clone returns a Responder starting with the current configuration of rs.
+// Later changes to either Responder do not affect the other.
+// Clone은 rs의 현재 설정으로 시작하는 Responder를 반환합니다. 이후 한쪽의 변경은 다른 쪽에 영향을 주지 않습니다.
+func (rs *Responder) Clone() *Responder {
+	c := &Responder{}
+	c.cfg.Store(rs.config())
+	return c
+}
+
+// HandleError writes the error response for err. Errors are resolved to an
+// HttpError like DefaultErrorHandler does, and the format (JSON or HTML) is
+// negotiated from the request's Accept header unless an encoder was set with SetEncoder.
+// Encoding and write failures are passed on to RespondE, see HandleErrorE.
+// HandleError는 err에 대한 오류 응답을 작성합니다. 형식(JSON 또는 HTML)은 Accept 헤더로 결정됩니다.
+// 인코딩 및 쓰기 실패는 RespondE로 전달됩니다(HandleErrorE 참고).
+func (rs *Responder) HandleError(w http.ResponseWriter, r *http.Request, err error) {
+	if failure := rs.HandleErrorE(w, r, err); failure != nil {
+		if fr, ok := w.(failureRecorder); ok {
+			fr.recordFailure(failure)
+		}
+	}
+}
+
+// HandleErrorE is like HandleError but returns the error that occurred while
+// encoding or writing the response, e.g. a broken pipe. If the body cannot be
+// encoded, the response is written without a body.
+// HandleErrorE는 HandleError와 같지만 응답을 인코딩하거나 쓰는 중 발생한 오류(예: broken pipe)를 반환합니다.
+// 본문을 인코딩할 수 없으면 본문 없이 응답을 작성합니다.
+func (rs *Responder) HandleErrorE(w http.ResponseWriter, r *http.Request, err error) error {
+	if w == nil {
+		return nil
+	}
+	return rs.render(w, r, err, toHttpError(err))
+}
+
+// render writes the error response for err, already resolved to resolved.
+func (rs *Responder) render(w http.ResponseWriter, r *http.Request, err error, resolved *HttpError) error {
+	markRendered(w)
+	cfg := rs.config()
+	enc := cfg.encoderFor(r)
+
+	httpErr := cfg.withDebugDetails(cfg.withDefaultMessage(withRouteMessage(r, resolved)), err)
+	httpErr = withDocumentation(expandParams(localize(r, httpErr, cfg.language), httpErr.Message))
+	if cfg.severityDetail {
+		httpErr = withSeverityDetail(httpErr)
+	}
+	httpErr = cfg.withTraceIDs(r, httpErr)
+	httpErr = cfg.withIdempotencyKey(r, httpErr)
+	httpErr = redact(httpErr, cfg.redactors)
+
+	for key, values := range httpErr.Header {
+		if isBodyHeader(key, httpErr.Status) {
+			continue
+		}
+		for _, v := range values {
+			w.Header().Add(key, v)
+		}
+	}
+	if lang := httpErr.Language(); lang != "" {
+		w.Header().Set("Content-Language", lang)
+	}
+	cfg.setCacheControl(w.Header(), httpErr)
+	cfg.setSurrogateControl(w.Header(), httpErr.Status)
+	cfg.setVary(w.Header(), r)
+	cfg.setCORS(w.Header(), r)
+	cfg.beginWrite(w)
+	defer cfg.endWrite(w)
+
+	status := responseStatus(enc, httpErr)
+	if !bodyAllowed(status) || status == StatusClientClosedRequest {
+		// A 499 is only recorded: the client is gone, there is no one to read a body.
+		w.WriteHeader(status)
+		return nil
+	}
+
+	body, ok := cachedBody(enc, httpErr)
+	if !ok {
+		// The body is encoded up front into a pooled buffer and written at once.
+		buf := bufferPool.Get().(*bytes.Buffer)
+		defer putBuffer(buf)
+		if err := enc.Encode(buf, httpErr); err != nil {
+			w.WriteHeader(status)
+			return fmt.Errorf("httperror: encoding error response: %w", err)
+		}
+		body = buf.Bytes()
+	}
+
+	// Header MUST be set before WriteHeader
+	w.Header().Set("Content-Type", enc.ContentType())
+	if cfg.compressMin > 0 {
+		zbuf := bufferPool.Get().(*bytes.Buffer)
+		defer putBuffer(zbuf)
+		body = cfg.compress(w.Header(), r, body, zbuf)
+	}
+	w.WriteHeader(status)
+	if _, err := w.Write(body); err != nil {
+		return fmt.Errorf("httperror: writing error response: %w", err)
+	}
+	return nil
+}
+
+// maxPooledBuffer is the capacity above which buffers are not returned to
+// bufferPool, so a single huge error body does not stay in memory.
+const maxPooledBuffer = 64 << 10
+
+// bufferPool holds the buffers error bodies are encoded into.
+var bufferPool = sync.Pool{
+	New: func() any { return new(bytes.Buffer) },
+}
+
+// putBuffer resets buf and returns it to bufferPool.
+func putBuffer(buf *bytes.Buffer) {
+	if buf.Cap() > maxPooledBuffer {
+		return
+	}
+	buf.Reset()
+	bufferPool.Put(buf)
+}
+
+// bodyHeaders describe a response body, so an error carrying the headers of
+// another response (e.g. one parsed by ParseResponse) must not copy them.
+var bodyHeaders = []string{"Content-Type", "Content-Length", "Content-Encoding", "Content-Language", "Content-Range", "Transfer-Encoding", "Connection"}
+
+// statusHeaders are the bodyHeaders a response with the status must carry:
+// the Content-Range of a 416, with the size of the selected representation
+// (RFC 9110, section 15.5.17), and the Connection of a 426, listing the
+// Upgrade header (section 15.5.22).
+var statusHeaders = map[int]string{
+	http.StatusRequestedRangeNotSatisfiable: "Content-Range",
+	http.StatusUpgradeRequired:              "Connection",
+}
+
+// isBodyHeader reports whether key is one of bodyHeaders and not required
+// in a response with status, see statusHeaders.
+func isBodyHeader(key string, status int) bool {
+	key = http.CanonicalHeaderKey(key)
+	if statusHeaders[status] == key {
+		return false
+	}
+	for _, h := range bodyHeaders {
+		if key == h {
+			return true
+		}
+	}
+	return false
+}
+
+// bodyAllowed reports whether a response with the given status may have a body.
+func bodyAllowed(status int) bool {
+	return status >= 200 && status != http.StatusNoContent && status != http.StatusNotModified
+}
+
+// SetDebug enables debug responses, which add diagnostic information such as the
+// layers recorded by Annotate to the error details. It must not be enabled in
+// production, as it exposes internals to clients.
+// SetDebug는 Annotate로 기록된 계층 등 진단 정보를 오류 상세 정보에 추가하는 디버그 응답을 활성화합니다.
+// 내부 정보가 클라이언트에 노출되므로 운영 환경에서는 사용하지 마세요.
+func (rs *Responder) SetDebug(debug bool) {
+	rs.update(func(cfg *responderConfig) { cfg.debug = debug })
+}
+
+// withDebugDetails returns a copy of httpErr carrying debug details, if enabled.
+func (cfg *responderConfig) withDebugDetails(httpErr *HttpError, err error) *HttpError {
+	if !cfg.debug {
+		return httpErr
+	}
+
+	layers := Layers(err)
+	if len(layers) == 0 {
+		return httpErr
+	}
+	e := *httpErr
+	e.Details = make(map[string]any, len(httpErr.Details)+1)
+	for k, v := range httpErr.Details {
+		e.Details[k] = v
+	}
+	e.Details["layers"] = layers
+	return &e
+}
@@ -0,0 +1,144 @@
+package httperror
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// TestConcurrentReconfiguration tests that global and Responder configuration,
+// including the error handler and the translator, can change while errors are
+// being responded. Run with -race.
+func TestConcurrentReconfiguration(t *testing.T) {
+	defer SetErrorHandler(nil)
+	defer ResetMappers()
+	defer ResetHooks()
+	defer ResetCatalogs()
+	defer SetTranslator(nil)
+
+	errQuota := errors.New("quota exceeded")
+	rs := NewResponder()
+
+	var wg sync.WaitGroup
+	stop := make(chan struct{})
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; ; i++ {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			if i%2 == 0 {
+				SetErrorHandler(rs.HandleError)
+				RegisterMapper(func(err error) (*HttpError, bool) {
+					if errors.Is(err, errQuota) {
+						return TooManyRequestsError(), true
+					}
+					return nil, false
+				})
+				AddHook(func(ErrorEvent) {})
+				rs.SetEncoder(TwirpEncoder{})
+				rs.SetClassSurrogateControl(5, SurrogatePolicy{StaleIfError: 60})
+				RegisterCatalog("ko", Catalog{"Too Many Requests": "요청이 너무 많음"})
+				SetTranslator(catalogTranslator{})
+			} else {
+				SetErrorHandler(nil)
+				ResetMappers()
+				ResetHooks()
+				ResetCatalogs()
+				SetTranslator(nil)
+				rs.SetEncoder(nil)
+				rs.SetDebug(i%4 == 1)
+			}
+		}
+	}()
+
+	for i := 0; i < 200; i++ {
+		rr := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", "/", nil)
+		req.Header.Set("Accept-Language", "ko")
+		Respond(rr, req, errQuota)
+		if rr.Code != http.StatusTooManyRequests && rr.Code != http.StatusInternalServerError {
+			t.Fatalf("unexpected status %d", rr.Code)
+		}
+	}
+	close(stop)
+	wg.Wait()
+}
+
+// TestResponderUpdateIsolation tests that a configuration snapshot is not
+// affected by later updates.
+func TestResponderUpdateIsolation(t *testing.T) {
+	rs := NewResponder()
+	rs.SetSurrogateControl(http.StatusServiceUnavailable, SurrogatePolicy{MaxAge: 0})
+	snapshot := rs.config()
+
+	rs.SetSurrogateControl(http.StatusBadGateway, SurrogatePolicy{NoStore: true})
+	rs.SetDebug(true)
+
+	if _, ok := snapshot.surrogate[http.StatusBadGateway]; ok || snapshot.debug {
+		t.Error("expected an earlier snapshot to be unaffected by later updates")
+	}
+	if _, ok := rs.config().surrogate[http.StatusBadGateway]; !ok || !rs.config().debug {
+		t.Error("expected the current snapshot to include the updates")
+	}
+}
+
+// TestResponderClone tests that a clone keeps the configuration and is isolated from the original.
+func TestResponderClone(t *testing.T) {
+	rs := NewResponder(WithEnvelope("error"))
+	c := rs.Clone()
+	c.SetEncoder(HTMLEncoder{})
+	rs.SetDebug(true)
+
+	if c.config().envelope != "error" {
+		t.Errorf("expected the clone to keep the envelope, got %q", c.config().envelope)
+	}
+	if c.config().debug {
+		t.Error("expected the clone to be unaffected by updates of the original")
+	}
+	if rs.config().encoder != nil {
+		t.Error("expected the original to be unaffected by updates of the clone")
+	}
+}
+
+// writeCounter is a ResponseWriter counting the calls to Write.
+type writeCounter struct {
+	*httptest.ResponseRecorder
+	writes int
+}
+
+func (w *writeCounter) Write(p []byte) (int, error) {
+	w.writes++
+	return w.ResponseRecorder.Write(p)
+}
+
+// TestHandleErrorSingleWrite tests that the body is written at once, whatever its size.
+func TestHandleErrorSingleWrite(t *testing.T) {
+	testCases := []struct {
+		name    string
+		message string
+	}{
+		{"small body", "short"},
+		{"body above the pooled size", strings.Repeat("x", maxPooledBuffer+1)},
+	}
+
+	rs := NewResponder()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for i := 0; i < 2; i++ {
+				w := &writeCounter{ResponseRecorder: httptest.NewRecorder()}
+				rs.HandleError(w, httptest.NewRequest("GET", "/", nil), BadRequestError(tc.message))
+
+				if w.writes != 1 || !strings.Contains(w.Body.String(), tc.message) {
+					t.Errorf("expected the body in a single write, got %d writes", w.writes)
+				}
+			}
+		})
+	}
+}
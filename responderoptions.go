@@ -0,0 +1,198 @@
+package httperror
+
+import (
+	"html/template"
+	"io"
+	"log/slog"
+	"maps"
+	"mime"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ResponderOption configures a Responder created by NewResponder.
+// ResponderOption은 NewResponder가 생성하는 Responder를 설정합니다.
+type ResponderOption func(cfg *responderConfig)
+
+// WithDebug enables debug responses, like SetDebug.
+// WithDebug는 SetDebug처럼 디버그 응답을 활성화합니다.
+func WithDebug(debug bool) ResponderOption {
+	return func(cfg *responderConfig) { cfg.debug = debug }
+}
+
+// WithLogger sets the logger used for the Responder's diagnostics, such as
+// failures to flush a response. By default the logger set with SetLogger is used.
+// WithLogger는 응답 플러시 실패 등 Responder의 진단 정보를 기록할 로거를 설정합니다.
+// 기본값은 SetLogger로 설정된 로거입니다.
+func WithLogger(l *slog.Logger) ResponderOption {
+	return func(cfg *responderConfig) { cfg.logger = l }
+}
+
+// WithEncoders sets the encoders the response format is negotiated between.
+// The encoder whose content type best matches the request's Accept header is
+// used; the first one is used when none matches or the header is absent.
+// A single encoder is used for every error, like SetEncoder.
+// WithEncoders는 응답 형식을 협상할 인코더들을 설정합니다. 요청의 Accept 헤더에 가장 잘 맞는
+// 콘텐츠 타입의 인코더가 사용되며, 일치하는 것이 없거나 헤더가 없으면 첫 번째 인코더가 사용됩니다.
+// 인코더가 하나이면 SetEncoder처럼 모든 오류에 사용됩니다.
+func WithEncoders(encoders ...Encoder) ResponderOption {
+	return func(cfg *responderConfig) {
+		cfg.encoder = nil
+		cfg.encoders = nil
+		switch len(encoders) {
+		case 0:
+		case 1:
+			cfg.encoder = encoders[0]
+		default:
+			cfg.encoders = append([]Encoder(nil), encoders...)
+		}
+	}
+}
+
+// WithHTMLTemplate renders HTML responses with tmpl instead of the built-in
+// fragment. The template is executed with the *HttpError as data.
+// WithHTMLTemplate은 내장 HTML 조각 대신 tmpl로 HTML 응답을 렌더링합니다. 템플릿은 *HttpError를 데이터로 실행됩니다.
+func WithHTMLTemplate(tmpl *template.Template) ResponderOption {
+	return func(cfg *responderConfig) { cfg.htmlTemplate = tmpl }
+}
+
+// WithEnvelope wraps JSON responses in an object under key, e.g.
+// {"error":{"status":404,...}} for "error". An empty key disables the envelope.
+// WithEnvelope는 JSON 응답을 key 아래의 객체로 감쌉니다. 예를 들어 "error"이면
+// {"error":{"status":404,...}}가 됩니다. 빈 key는 감싸기를 비활성화합니다.
+func WithEnvelope(key string) ResponderOption {
+	return func(cfg *responderConfig) { cfg.envelope = key }
+}
+
+// WithDefaultMessages replaces the default messages of the given statuses,
+// e.g. 404 → "No such page". Errors created with an explicit message keep it.
+// WithDefaultMessages는 주어진 상태 코드의 기본 메시지를 교체합니다(예: 404 → "No such page").
+// 메시지를 지정하여 생성된 오류는 그 메시지를 유지합니다.
+func WithDefaultMessages(messages map[int]string) ResponderOption {
+	return func(cfg *responderConfig) { cfg.messages = maps.Clone(messages) }
+}
+
+// WithLanguage sets the language messages are translated into when none can
+// be negotiated from the Accept-Language header of the request.
+// WithLanguage는 요청의 Accept-Language 헤더로 언어를 협상할 수 없을 때 메시지를 번역할 언어를 설정합니다.
+func WithLanguage(lang string) ResponderOption {
+	return func(cfg *responderConfig) { cfg.language = lang }
+}
+
+// WithSeverityDetail exposes the severity of errors (see SeverityOf) to
+// clients in the "severity" detail.
+// WithSeverityDetail은 오류의 심각도(SeverityOf 참고)를 "severity" 상세 정보로 클라이언트에 노출합니다.
+func WithSeverityDetail(expose bool) ResponderOption {
+	return func(cfg *responderConfig) { cfg.severityDetail = expose }
+}
+
+// WithRedactors scrubs the message and the string details of every error
+// with the redactors, in order, before it is encoded, so emails, tokens or
+// connection strings never reach clients. See RedactPatterns.
+// WithRedactors는 인코딩 전에 모든 오류의 메시지와 문자열 상세 정보를 redactors로 차례로 정리하여
+// 이메일, 토큰, 연결 문자열 등이 클라이언트에 전달되지 않게 합니다. RedactPatterns를 참고하세요.
+func WithRedactors(redactors ...Redactor) ResponderOption {
+	return func(cfg *responderConfig) { cfg.redactors = append([]Redactor(nil), redactors...) }
+}
+
+// WithFlush flushes error bodies right after writing them, like SetFlush.
+// WithFlush는 SetFlush처럼 오류 본문을 작성한 직후 플러시합니다.
+func WithFlush(flush bool) ResponderOption {
+	return func(cfg *responderConfig) { cfg.flush = flush }
+}
+
+// WithWriteTimeout sets a write deadline for error responses, like SetWriteTimeout.
+// WithWriteTimeout은 SetWriteTimeout처럼 오류 응답의 쓰기 기한을 설정합니다.
+func WithWriteTimeout(d time.Duration) ResponderOption {
+	return func(cfg *responderConfig) { cfg.writeTimeout = d }
+}
+
+// Configure applies opts to the Responder's current configuration.
+// Configure는 Responder의 현재 설정에 opts를 적용합니다.
+func (rs *Responder) Configure(opts ...ResponderOption) {
+	rs.update(func(cfg *responderConfig) {
+		for _, opt := range opts {
+			opt(cfg)
+		}
+	})
+}
+
+// withDefaultMessage returns a copy of e with the default message configured
+// for its status, if e has the standard one.
+func (cfg *responderConfig) withDefaultMessage(e *HttpError) *HttpError {
+	message, ok := cfg.messages[e.Status]
+	if !ok || e.Message != StatusText(e.Status) {
+		return e
+	}
+	c := *e
+	c.Message = message
+	return &c
+}
+
+// log returns the logger of the Responder.
+func (cfg *responderConfig) log() *slog.Logger {
+	if cfg.logger != nil {
+		return cfg.logger
+	}
+	return logger()
+}
+
+// negotiateEncoder returns the encoder of encoders preferred by the Accept
+// header of r, or the first one.
+func negotiateEncoder(r *http.Request, encoders []Encoder) Encoder {
+	if r == nil {
+		return encoders[0]
+	}
+	accept := strings.Join(r.Header.Values("Accept"), ",")
+	if accept == "" {
+		return encoders[0]
+	}
+
+	type mediaRange struct {
+		pattern string
+		q       float64
+	}
+	var ranges []mediaRange
+	for _, part := range strings.Split(accept, ",") {
+		pattern, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		q := 1.0
+		if v, err := strconv.ParseFloat(params["q"], 64); err == nil {
+			q = v
+		}
+		if q > 0 {
+			ranges = append(ranges, mediaRange{pattern, q})
+		}
+	}
+	sort.SliceStable(ranges, func(i, j int) bool { return ranges[i].q > ranges[j].q })
+
+	for _, mr := range ranges {
+		for _, enc := range encoders {
+			mediaType, _, err := mime.ParseMediaType(enc.ContentType())
+			if err == nil && matchMediaType([]string{mr.pattern}, mediaType) {
+				return enc
+			}
+		}
+	}
+	return encoders[0]
+}
+
+// templateEncoder renders HTML responses with a template set by WithHTMLTemplate.
+type templateEncoder struct {
+	tmpl *template.Template
+}
+
+// ContentType implements Encoder.
+func (templateEncoder) ContentType() string {
+	return HTMLEncoder{}.ContentType()
+}
+
+// Encode implements Encoder.
+func (enc templateEncoder) Encode(w io.Writer, e *HttpError) error {
+	return enc.tmpl.Execute(w, e)
+}
@@ -0,0 +1,83 @@
+package httperror
+
+import (
+	"bytes"
+	"errors"
+	"html/template"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// unflushableRecorder is a ResponseRecorder whose Flush fails.
+type unflushableRecorder struct {
+	*httptest.ResponseRecorder
+}
+
+func (unflushableRecorder) FlushError() error {
+	return errors.New("connection reset")
+}
+
+// TestResponderOptions tests configuring a Responder with options.
+func TestResponderOptions(t *testing.T) {
+	page := template.Must(template.New("error").Parse(`<h1>{{.Status}}</h1><p>{{.Message}}</p>`))
+
+	testCases := []struct {
+		name         string
+		opts         []ResponderOption
+		accept       string
+		expectedType string
+		expectedBody string
+	}{
+		{"default", nil, "", "application/json; charset=utf-8", `{"status":404,"message":"Not Found"}` + "\n"},
+		{"envelope", []ResponderOption{WithEnvelope("error")}, "", "application/json; charset=utf-8", `{"error":{"status":404,"message":"Not Found"}}` + "\n"},
+		{"html template", []ResponderOption{WithHTMLTemplate(page)}, "text/html", "text/html; charset=utf-8", `<h1>404</h1><p>Not Found</p>`},
+		{"template keeps json", []ResponderOption{WithHTMLTemplate(page)}, "application/json", "application/json; charset=utf-8", `{"status":404,"message":"Not Found"}` + "\n"},
+		{"single encoder", []ResponderOption{WithEncoders(TwirpEncoder{})}, "text/html", "application/json", `{"code":"not_found","msg":"Not Found"}` + "\n"},
+		{"single json encoder styled", []ResponderOption{WithEncoders(JSONEncoder{}), WithEnvelope("error"), WithFieldNames(map[string]string{"message": "detail"})}, "text/html", "application/json; charset=utf-8", `{"error":{"status":404,"detail":"Not Found"}}` + "\n"},
+		{"single html encoder template", []ResponderOption{WithEncoders(HTMLEncoder{}), WithHTMLTemplate(page)}, "application/json", "text/html; charset=utf-8", `<h1>404</h1><p>Not Found</p>`},
+		{"negotiated", []ResponderOption{WithEncoders(JSONEncoder{}, HTMLEncoder{})}, "text/html;q=0.9, application/json;q=0.5", "text/html; charset=utf-8", `<div class="http-error">Not Found</div>`},
+		{"wildcard", []ResponderOption{WithEncoders(HTMLEncoder{}, JSONEncoder{})}, "application/*", "application/json; charset=utf-8", `{"status":404,"message":"Not Found"}` + "\n"},
+		{"refused", []ResponderOption{WithEncoders(JSONEncoder{}, HTMLEncoder{})}, "text/html;q=0", "application/json; charset=utf-8", `{"status":404,"message":"Not Found"}` + "\n"},
+		{"negotiated envelope", []ResponderOption{WithEncoders(HTMLEncoder{}, JSONEncoder{}), WithEnvelope("error")}, "application/json", "application/json; charset=utf-8", `{"error":{"status":404,"message":"Not Found"}}` + "\n"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rs := NewResponder(tc.opts...)
+			req := httptest.NewRequest("GET", "/", nil)
+			if tc.accept != "" {
+				req.Header.Set("Accept", tc.accept)
+			}
+			rr := httptest.NewRecorder()
+			rs.HandleError(rr, req, NotFoundError())
+
+			if got := rr.Header().Get("Content-Type"); !strings.HasPrefix(got, tc.expectedType) {
+				t.Errorf("expected Content-Type %q, got %q", tc.expectedType, got)
+			}
+			if got := rr.Body.String(); got != tc.expectedBody {
+				t.Errorf("expected body %q, got %q", tc.expectedBody, got)
+			}
+		})
+	}
+}
+
+// TestResponderOptionsLogger tests that a Responder logs to its own logger.
+func TestResponderOptionsLogger(t *testing.T) {
+	var buf bytes.Buffer
+	rs := NewResponder(WithLogger(slog.New(slog.NewTextHandler(&buf, nil))), WithFlush(true), WithDebug(true))
+
+	rr := unflushableRecorder{httptest.NewRecorder()}
+	rs.HandleError(rr, httptest.NewRequest("GET", "/", nil), Annotate(NotFoundError(), "loading user"))
+	if rr.Code != http.StatusNotFound {
+		t.Fatalf("expected status 404, got %d", rr.Code)
+	}
+	if !strings.Contains(rr.Body.String(), `"layers"`) {
+		t.Errorf("expected debug details, got %s", rr.Body)
+	}
+	if !strings.Contains(buf.String(), "could not flush error response") {
+		t.Errorf("expected the flush failure to be logged, got %q", buf.String())
+	}
+}
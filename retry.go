@@ -0,0 +1,82 @@
+package httperror
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// retryableStatuses holds the set of statuses considered retryable by IsRetryable.
+var retryableStatuses atomic.Pointer[map[int]bool]
+
+func init() {
+	SetRetryableStatuses()
+}
+
+// SetRetryableStatuses sets the statuses IsRetryable considers transient. Called
+// without arguments, it restores the default: 429, 502, 503 and 504.
+// SetRetryableStatuses는 IsRetryable이 일시적인 오류로 간주할 상태 코드를 설정합니다.
+// 인자 없이 호출하면 기본값(429, 502, 503, 504)으로 복원됩니다.
+func SetRetryableStatuses(statuses ...int) {
+	if len(statuses) == 0 {
+		statuses = []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		}
+	}
+	set := make(map[int]bool, len(statuses))
+	for _, s := range statuses {
+		set[s] = true
+	}
+	retryableStatuses.Store(&set)
+}
+
+// IsRetryable reports whether retrying the request that failed with err is
+// sensible: err must wrap an HttpError whose status is retryable (see
+// SetRetryableStatuses) or whose headers carry a Retry-After, as errors
+// returned by NewTransport and ParseResponse do.
+// IsRetryable은 err로 실패한 요청을 재시도하는 것이 합리적인지 보고합니다. err는 재시도 가능한 상태 코드이거나
+// (SetRetryableStatuses 참고) Retry-After 헤더를 가진 HttpError를 감싸고 있어야 합니다.
+func IsRetryable(err error) bool {
+	var e *HttpError
+	if !errors.As(err, &e) || e == nil {
+		return false
+	}
+	if (*retryableStatuses.Load())[e.Status] {
+		return true
+	}
+	return e.Status >= 400 && e.Header.Get("Retry-After") != ""
+}
+
+// RetryAfter returns the delay requested by the Retry-After header of the
+// HttpError wrapped by err, such as those returned by NewTransport and
+// ParseResponse, so backoff logic can respect the server's guidance. Both
+// delay-seconds and HTTP-date values are understood; a date in the past yields 0.
+// It returns false if err carries no valid Retry-After.
+// RetryAfter는 err가 감싼 HttpError의 Retry-After 헤더가 요청하는 대기 시간을 반환합니다.
+// 초 단위 값과 HTTP 날짜 형식을 모두 지원하며, 지난 날짜는 0이 됩니다. 유효한 Retry-After가 없으면 false를 반환합니다.
+func RetryAfter(err error) (time.Duration, bool) {
+	var e *HttpError
+	if !errors.As(err, &e) || e == nil {
+		return 0, false
+	}
+	v := strings.TrimSpace(e.Header.Get("Retry-After"))
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.ParseInt(v, 10, 64); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return max(0, time.Until(t)), true
+	}
+	return 0, false
+}
@@ -0,0 +1,69 @@
+package httperror
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// TestIsRetryable tests the classification of retryable errors.
+func TestIsRetryable(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{"nil", nil, false},
+		{"plain error", errors.New("boom"), false},
+		{"429", TooManyRequestsError(), true},
+		{"503 wrapped", fmt.Errorf("calling billing: %w", ServiceUnavailableError()), true},
+		{"500", InternalServerErrorError(), false},
+		{"404", NotFoundError(), false},
+		{"retry after", ConflictError(WithHeader("Retry-After", "2")), true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := IsRetryable(tc.err); got != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+
+	SetRetryableStatuses(http.StatusInternalServerError)
+	defer SetRetryableStatuses()
+	if !IsRetryable(InternalServerErrorError()) || IsRetryable(TooManyRequestsError()) {
+		t.Error("expected the configured statuses to replace the defaults")
+	}
+}
+
+// TestRetryAfter tests parsing the Retry-After header of errors.
+func TestRetryAfter(t *testing.T) {
+	future := time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)
+	past := time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat)
+
+	testCases := []struct {
+		name     string
+		err      error
+		min, max time.Duration
+		ok       bool
+	}{
+		{"no header", ServiceUnavailableError(), 0, 0, false},
+		{"plain error", errors.New("boom"), 0, 0, false},
+		{"seconds", TooManyRequestsError(WithHeader("Retry-After", "120")), 2 * time.Minute, 2 * time.Minute, true},
+		{"wrapped", fmt.Errorf("sync: %w", TooManyRequestsError(WithHeader("Retry-After", "3"))), 3 * time.Second, 3 * time.Second, true},
+		{"http date", ServiceUnavailableError(WithHeader("Retry-After", future)), 59 * time.Minute, time.Hour, true},
+		{"past date", ServiceUnavailableError(WithHeader("Retry-After", past)), 0, 0, true},
+		{"invalid", ServiceUnavailableError(WithHeader("Retry-After", "soon")), 0, 0, false},
+		{"negative", ServiceUnavailableError(WithHeader("Retry-After", "-5")), 0, 0, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			d, ok := RetryAfter(tc.err)
+			if ok != tc.ok || d < tc.min || d > tc.max {
+				t.Errorf("expected %v in [%v, %v], got %v %v", tc.ok, tc.min, tc.max, ok, d)
+			}
+		})
+	}
+}
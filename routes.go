@@ -0,0 +1,117 @@
+package httperror
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"sync"
+	"sync/atomic"
+)
+
+// routeKey is the context key of the route pattern.
+type routeKey struct{}
+
+// WithRoute returns a context carrying the pattern of the route matched by
+// the router, e.g. "/v1/users/{id}", which selects the messages registered
+// with RegisterRouteMessages. Routing middleware set it once per request.
+// WithRoute는 라우터가 일치시킨 경로 패턴(예: "/v1/users/{id}")을 담은 컨텍스트를 반환하며,
+// 이 패턴으로 RegisterRouteMessages에 등록된 메시지를 선택합니다. 라우팅 미들웨어가 요청마다 한 번 설정합니다.
+func WithRoute(ctx context.Context, pattern string) context.Context {
+	return context.WithValue(ctx, routeKey{}, pattern)
+}
+
+// Route returns the route pattern stored in ctx, or "" if there is none.
+// Route는 ctx에 저장된 경로 패턴을 반환하며, 없으면 빈 문자열을 반환합니다.
+func Route(ctx context.Context) string {
+	pattern, _ := ctx.Value(routeKey{}).(string)
+	return pattern
+}
+
+// routeMessages holds an immutable snapshot of the default messages
+// registered per route pattern and status, replaced as a whole under
+// routeMessagesMu.
+var (
+	routeMessagesMu sync.Mutex
+	routeMessages   atomic.Pointer[map[string]map[int]string]
+)
+
+// RegisterRouteMessages registers default messages per status for the
+// requests of a route, e.g. 404 → "user not found" for "/v1/users/*", so
+// handlers need not pass them. The pattern is compared to the route set with
+// WithRoute, then matched against the path of the request, where "*" matches
+// a single segment (see path.Match); the longest matching pattern wins. Like
+// the messages of WithDefaultMessages, they only replace standard messages,
+// and take precedence over them.
+// RegisterRouteMessages는 경로의 요청에 대한 상태 코드별 기본 메시지(예: "/v1/users/*"에 404 → "user not found")를
+// 등록하여 핸들러가 메시지를 전달하지 않아도 되게 합니다. 패턴은 WithRoute로 설정된 경로와 비교된 후
+// 요청 경로와 일치 여부를 검사하며, "*"는 한 세그먼트와 일치하고(path.Match 참고) 가장 긴 패턴이 우선합니다.
+// WithDefaultMessages의 메시지처럼 표준 메시지만 교체하며, 그보다 우선합니다.
+func RegisterRouteMessages(pattern string, messages map[int]string) {
+	routeMessagesMu.Lock()
+	defer routeMessagesMu.Unlock()
+	next := map[string]map[int]string{}
+	if m := routeMessages.Load(); m != nil {
+		for k, v := range *m {
+			next[k] = v
+		}
+	}
+	merged := map[int]string{}
+	for status, message := range next[pattern] {
+		merged[status] = message
+	}
+	for status, message := range messages {
+		merged[status] = message
+	}
+	next[pattern] = merged
+	routeMessages.Store(&next)
+}
+
+// ResetRouteMessages removes all registered route messages.
+// ResetRouteMessages는 등록된 모든 경로 메시지를 제거합니다.
+func ResetRouteMessages() {
+	routeMessagesMu.Lock()
+	defer routeMessagesMu.Unlock()
+	routeMessages.Store(nil)
+}
+
+// routeMessage returns the message registered for the route of r and status, if any.
+func routeMessage(r *http.Request, status int) (string, bool) {
+	m := routeMessages.Load()
+	if m == nil || r == nil {
+		return "", false
+	}
+	if messages, ok := (*m)[Route(r.Context())]; ok {
+		if message, ok := messages[status]; ok {
+			return message, true
+		}
+	}
+	if r.URL == nil {
+		return "", false
+	}
+	bestPattern, best, found := "", "", false
+	for pattern, messages := range *m {
+		message, ok := messages[status]
+		if !ok || found && (len(pattern) < len(bestPattern) || len(pattern) == len(bestPattern) && pattern > bestPattern) {
+			continue
+		}
+		if matched, _ := path.Match(pattern, r.URL.Path); matched {
+			bestPattern, best, found = pattern, message, true
+		}
+	}
+	return best, found
+}
+
+// withRouteMessage returns a copy of e with the message registered for the
+// route of r and its status, if e has the standard one.
+func withRouteMessage(r *http.Request, e *HttpError) *HttpError {
+	if e.Message != StatusText(e.Status) {
+		return e
+	}
+	message, ok := routeMessage(r, e.Status)
+	if !ok {
+		return e
+	}
+	c := *e
+	c.Message = message
+	return &c
+}
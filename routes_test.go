@@ -0,0 +1,64 @@
+package httperror
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+// TestRouteMessages tests replacing default messages per route.
+func TestRouteMessages(t *testing.T) {
+	defer ResetRouteMessages()
+	RegisterRouteMessages("/v1/users/*", map[int]string{404: "user not found"})
+	RegisterRouteMessages("/v1/users/*/posts/*", map[int]string{404: "post not found"})
+	RegisterRouteMessages("/v1/users/{id}", map[int]string{403: "not your account"})
+	RegisterRouteMessages("/v1/users/*", map[int]string{409: "user already exists"})
+
+	testCases := []struct {
+		name            string
+		opts            []ResponderOption
+		target          string
+		route           string
+		err             error
+		expectedMessage string
+	}{
+		{"matched path", nil, "/v1/users/7", "", NotFoundError(), "user not found"},
+		{"merged registration", nil, "/v1/users/7", "", ConflictError(), "user already exists"},
+		{"longest pattern", nil, "/v1/users/7/posts/3", "", NotFoundError(), "post not found"},
+		{"single segment", nil, "/v1/users/7/comments", "", NotFoundError(), "Not Found"},
+		{"other status", nil, "/v1/users/7", "", BadRequestError(), "Bad Request"},
+		{"explicit message", nil, "/v1/users/7", "", NotFoundError(WithMessage("gone")), "gone"},
+		{"route from context", nil, "/v1/users/me", "/v1/users/{id}", ForbiddenError(), "not your account"},
+		{"over responder messages", []ResponderOption{WithDefaultMessages(map[int]string{404: "No such page"})}, "/v1/users/7", "", NotFoundError(), "user not found"},
+		{"responder messages elsewhere", []ResponderOption{WithDefaultMessages(map[int]string{404: "No such page"})}, "/v1/orders/7", "", NotFoundError(), "No such page"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", tc.target, nil)
+			if tc.route != "" {
+				req = req.WithContext(WithRoute(req.Context(), tc.route))
+			}
+			rr := httptest.NewRecorder()
+			NewResponder(tc.opts...).HandleError(rr, req, tc.err)
+
+			got, err := ParseResponse(rr.Result())
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Message != tc.expectedMessage {
+				t.Errorf("expected message %q, got %q", tc.expectedMessage, got.Message)
+			}
+		})
+	}
+}
+
+// TestRoute tests storing the route pattern in a context.
+func TestRoute(t *testing.T) {
+	req := httptest.NewRequest("GET", "/", nil)
+	if got := Route(req.Context()); got != "" {
+		t.Errorf("expected no route, got %q", got)
+	}
+	if got := Route(WithRoute(req.Context(), "/v1/users/{id}")); got != "/v1/users/{id}" {
+		t.Errorf("expected route %q, got %q", "/v1/users/{id}", got)
+	}
+}
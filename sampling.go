@@ -0,0 +1,57 @@
+package httperror
+
+import (
+	"log/slog"
+	"sync"
+	"sync/atomic"
+)
+
+// Sampling sets how many error responses are logged by SampledLogHook, as
+// rates: a rate of N logs 1 in N responses, the first one included. A rate of
+// 0 or 1 logs every response. The rate of a status takes precedence over the
+// rate of its class; statuses without a rate are all logged, so server faults
+// stay fully visible unless sampled explicitly.
+// Sampling은 SampledLogHook이 기록할 오류 응답의 비율을 설정합니다. 비율 N은 첫 응답을 포함하여 N개 중 1개를 기록하며,
+// 0이나 1은 모든 응답을 기록합니다. 상태 코드의 비율이 클래스의 비율보다 우선하며, 비율이 없는 상태 코드는
+// 모두 기록되므로 명시적으로 샘플링하지 않는 한 서버 오류는 모두 보입니다.
+type Sampling struct {
+	// Status holds the rates of statuses, e.g. {404: 100}.
+	Status map[int]int
+	// Class holds the rates of status classes, given as their first digit
+	// (4 for 4xx, 5 for 5xx).
+	Class map[int]int
+}
+
+// rate returns the sampling rate of status.
+func (s Sampling) rate(status int) int {
+	if n, ok := s.Status[status]; ok {
+		return n
+	}
+	return s.Class[status/100]
+}
+
+// SampledLogHook is like LogHook but only logs part of the error responses
+// of sampled statuses, so a client probing paths cannot flood the logs.
+// Sampled records carry the rate in the "sample_rate" attribute.
+// SampledLogHook은 LogHook과 같지만 샘플링된 상태 코드의 오류 응답은 일부만 기록하여,
+// 경로를 탐색하는 클라이언트가 로그를 범람시키지 못하게 합니다. 샘플링된 기록에는 "sample_rate" 속성에 비율이 담깁니다.
+func SampledLogHook(l *slog.Logger, s Sampling) Hook {
+	var counters sync.Map // status → *atomic.Uint64
+	return func(ev ErrorEvent) {
+		status := ev.HttpError.Status
+		n := s.rate(status)
+		if n <= 1 {
+			logEvent(l, ev)
+			return
+		}
+
+		c, ok := counters.Load(status)
+		if !ok {
+			c, _ = counters.LoadOrStore(status, new(atomic.Uint64))
+		}
+		if (c.(*atomic.Uint64).Add(1)-1)%uint64(n) != 0 {
+			return
+		}
+		logEvent(l, ev, slog.Int("sample_rate", n))
+	}
+}
@@ -0,0 +1,78 @@
+package httperror
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// TestSampledLogHook tests that sampled statuses are only partly logged.
+func TestSampledLogHook(t *testing.T) {
+	testCases := []struct {
+		name     string
+		sampling Sampling
+		status   int
+		expected int
+	}{
+		{"unsampled", Sampling{}, http.StatusNotFound, 250},
+		{"status rate", Sampling{Status: map[int]int{404: 100}}, http.StatusNotFound, 3},
+		{"class rate", Sampling{Class: map[int]int{4: 10}}, http.StatusForbidden, 25},
+		{"status over class", Sampling{Status: map[int]int{500: 1}, Class: map[int]int{5: 10}}, http.StatusInternalServerError, 250},
+		{"other status", Sampling{Status: map[int]int{404: 100}}, http.StatusBadGateway, 250},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var mu sync.Mutex
+			var buf bytes.Buffer
+			hook := SampledLogHook(slog.New(slog.NewTextHandler(lockedWriter{&mu, &buf}, nil)), tc.sampling)
+
+			var wg sync.WaitGroup
+			for i := 0; i < 250; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					hook(ErrorEvent{HttpError: New(tc.status, "")})
+				}()
+			}
+			wg.Wait()
+
+			if got := strings.Count(buf.String(), "http error"); got != tc.expected {
+				t.Errorf("expected %d records, got %d", tc.expected, got)
+			}
+			if n := tc.sampling.rate(tc.status); n > 1 && !strings.Contains(buf.String(), "sample_rate=") {
+				t.Errorf("expected the sample rate to be recorded, got %q", buf.String())
+			}
+		})
+	}
+}
+
+// lockedWriter serializes writes to w.
+type lockedWriter struct {
+	mu *sync.Mutex
+	w  *bytes.Buffer
+}
+
+func (l lockedWriter) Write(p []byte) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.w.Write(p)
+}
+
+// TestSampledLogHookRespond tests sampling the error responses of Respond.
+func TestSampledLogHookRespond(t *testing.T) {
+	var buf bytes.Buffer
+	AddHook(SampledLogHook(slog.New(slog.NewTextHandler(&buf, nil)), Sampling{Status: map[int]int{404: 2}}))
+	defer ResetHooks()
+
+	for _, path := range []string{"/.env", "/wp-admin", "/.git/config"} {
+		NotFound(httptest.NewRecorder(), httptest.NewRequest("GET", path, nil))
+	}
+	if got := strings.Count(buf.String(), "status=404"); got != 2 {
+		t.Errorf("expected 2 records, got %d: %s", got, buf.String())
+	}
+}
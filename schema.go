@@ -0,0 +1,86 @@
+package httperror
+
+// JSONSchemaID is the $id of the schema returned by JSONSchema.
+// JSONSchemaID는 JSONSchema가 반환하는 스키마의 $id입니다.
+const JSONSchemaID = "https://github.com/DevNewbie1826/httperror/error.schema.json"
+
+// JSONSchema returns the JSON Schema (draft 2020-12) of an error serialized
+// by JSONEncoder, including the details set by this package: JSON decoding
+// failures (offset, field, value, expected), body and media type limits,
+// debug layers, message templates and joined errors. Other details are
+// allowed. The result marshals to JSON, for client code generation and
+// contract tests.
+// JSONSchema는 JSONEncoder가 직렬화한 오류의 JSON Schema(draft 2020-12)를 반환합니다.
+// 이 패키지가 설정하는 상세 정보(JSON 디코딩 실패, 본문 및 미디어 타입 제한, 디버그 계층, 메시지 템플릿,
+// 결합된 오류)를 포함하며 그 외의 상세 정보도 허용됩니다. 결과는 JSON으로 직렬화할 수 있으며
+// 클라이언트 코드 생성과 계약 테스트에 사용합니다.
+func JSONSchema() map[string]any {
+	schema := errorSchema("#")
+	schema["$schema"] = "https://json-schema.org/draft/2020-12/schema"
+	schema["$id"] = JSONSchemaID
+	schema["title"] = "HttpError"
+	return schema
+}
+
+// errorSchema returns the schema of an error serialized by JSONEncoder, in
+// which ref refers to the schema itself.
+func errorSchema(ref string) map[string]any {
+	str := map[string]any{"type": "string"}
+	stringList := map[string]any{"type": "array", "items": str}
+
+	return map[string]any{
+		"type":     "object",
+		"required": []string{"status", "message"},
+		"properties": map[string]any{
+			"status":  map[string]any{"type": "integer", "description": "HTTP status code"},
+			"code":    map[string]any{"type": "string", "description": "Machine-readable error code"},
+			"type":    map[string]any{"type": "string", "format": "uri", "description": "URI documenting the error"},
+			"message": map[string]any{"type": "string", "description": "Human-readable error message"},
+			"links": map[string]any{
+				"type":                 "object",
+				"description":          "URLs by link relation, e.g. self, documentation, retry or support",
+				"additionalProperties": str,
+			},
+			"details": map[string]any{
+				"type":        "object",
+				"description": "Additional error details",
+				"properties": map[string]any{
+					"offset":          map[string]any{"type": "integer", "description": "Byte offset of a JSON decoding error"},
+					"field":           map[string]any{"type": "string", "description": "Field holding an invalid JSON value"},
+					"value":           map[string]any{"type": "string", "description": "Kind of the invalid JSON value"},
+					"expected":        map[string]any{"type": "string", "description": "Type expected for the field"},
+					"error":           map[string]any{"type": "string", "description": "Underlying decoding error"},
+					"limit":           map[string]any{"type": "integer", "description": "Maximum request body size in bytes"},
+					"accepted":        stringList,
+					"supported":       stringList,
+					"layers":          stringList,
+					"template":        map[string]any{"type": "string", "description": "Untranslated message template"},
+					"params":          map[string]any{"type": "object", "description": "Values of the message template placeholders", "additionalProperties": true},
+					"severity":        map[string]any{"type": "string", "enum": []any{"info", "warn", "error", "critical"}, "description": "Severity of the error"},
+					"resource_type":   map[string]any{"type": "string", "description": "Type of the resource the error is about"},
+					"resource_id":     map[string]any{"type": "string", "description": "Identifier of the resource the error is about"},
+					"location":        map[string]any{"type": "string", "description": "URI of the existing resource the request conflicts with"},
+					"upgrade":         stringList,
+					"etag":            map[string]any{"type": "string", "description": "Current entity tag of the resource"},
+					"last_modified":   map[string]any{"type": "string", "format": "date-time", "description": "Current modification time of the resource"},
+					"trace_id":        map[string]any{"type": "string", "description": "ID of the trace of the request"},
+					"span_id":         map[string]any{"type": "string", "description": "ID of the span of the request"},
+					"idempotency_key": map[string]any{"type": "string", "description": "Idempotency-Key of the request"},
+					"checks":          map[string]any{"type": "object", "description": "Errors of the failing readiness checks, by name", "additionalProperties": map[string]any{"type": "string"}},
+					"breaker":         map[string]any{"type": "string", "description": "Name of the open circuit breaker"},
+					"payment": map[string]any{
+						"type":        "object",
+						"description": "Payment required for the request",
+						"properties": map[string]any{
+							"plan":        map[string]any{"type": "string", "description": "Plan required for the request"},
+							"upgrade_url": map[string]any{"type": "string", "format": "uri", "description": "Where to upgrade or pay"},
+						},
+					},
+					"errors": map[string]any{"type": "array", "description": "Members of a joined error or of an Errors", "items": map[string]any{"$ref": ref}},
+				},
+				"additionalProperties": true,
+			},
+			"data": map[string]any{"description": "Typed payload of the error, see WithData"},
+		},
+	}
+}
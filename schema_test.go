@@ -0,0 +1,112 @@
+package httperror
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// validate checks value against the subset of JSON Schema used by JSONSchema.
+func validate(t *testing.T, root, schema map[string]any, value any, path string) {
+	t.Helper()
+	if ref, ok := schema["$ref"]; ok {
+		if ref != "#" {
+			t.Fatalf("%s: unsupported $ref %v", path, ref)
+		}
+		schema = root
+	}
+
+	switch schema["type"] {
+	case "object":
+		obj, ok := value.(map[string]any)
+		if !ok {
+			t.Errorf("%s: expected an object, got %T", path, value)
+			return
+		}
+		if required, ok := schema["required"].([]string); ok {
+			for _, key := range required {
+				if _, ok := obj[key]; !ok {
+					t.Errorf("%s: missing required %q", path, key)
+				}
+			}
+		}
+		properties, _ := schema["properties"].(map[string]any)
+		for key, v := range obj {
+			p, ok := properties[key]
+			if !ok {
+				if additional, ok := schema["additionalProperties"].(map[string]any); ok {
+					validate(t, root, additional, v, path+"."+key)
+				} else if schema["additionalProperties"] != true {
+					t.Errorf("%s: unexpected property %q", path, key)
+				}
+				continue
+			}
+			validate(t, root, p.(map[string]any), v, path+"."+key)
+		}
+	case "array":
+		items, ok := value.([]any)
+		if !ok {
+			t.Errorf("%s: expected an array, got %T", path, value)
+			return
+		}
+		if itemSchema, ok := schema["items"].(map[string]any); ok {
+			for _, item := range items {
+				validate(t, root, itemSchema, item, path+"[]")
+			}
+		}
+	case "string":
+		if _, ok := value.(string); !ok {
+			t.Errorf("%s: expected a string, got %T", path, value)
+		}
+	case "integer":
+		if f, ok := value.(float64); !ok || f != float64(int64(f)) {
+			t.Errorf("%s: expected an integer, got %v", path, value)
+		}
+	}
+}
+
+// TestJSONSchema tests that rendered errors conform to the schema.
+func TestJSONSchema(t *testing.T) {
+	schema := JSONSchema()
+	if _, err := json.Marshal(schema); err != nil {
+		t.Fatalf("expected the schema to marshal: %v", err)
+	}
+	if schema["$id"] != JSONSchemaID {
+		t.Errorf("unexpected $id %v", schema["$id"])
+	}
+
+	SetJoinDetails(true)
+	defer SetJoinDetails(false)
+	RegisterJSONMappers()
+	defer ResetMappers()
+
+	var target struct{ Age int }
+	typeErr := json.NewDecoder(strings.NewReader(`{"Age":"x"}`)).Decode(&target)
+
+	testCases := []struct {
+		name string
+		err  error
+	}{
+		{"default", NotFoundError()},
+		{"code and details", ConflictError("taken", WithCode("email_taken"), WithDetail("email", "a@b.c"))},
+		{"json type error", typeErr},
+		{"links", NotFoundError(WithLink("documentation", "https://example.com/errors/not-found"))},
+		{"template", NotFoundError("user {id} not found", WithParam("id", 7))},
+		{"joined", errors.Join(NotFoundError(), ForbiddenError(WithCode("denied")))},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			Respond(rr, httptest.NewRequest("GET", "/", nil), tc.err)
+
+			var body any
+			if err := json.Unmarshal(rr.Body.Bytes(), &body); err != nil {
+				t.Fatalf("could not decode response body: %v", err)
+			}
+			validate(t, schema, schema, body, "$")
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package httperror
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Severity tells how serious an error is, so logging can pick a level that
+// fits: an expected 404 is no reason for an error-level log line.
+// Severity는 오류의 심각도를 나타내며, 로깅 시 적절한 레벨을 고를 수 있게 합니다. 예상된 404에 error 레벨 로그는 필요 없습니다.
+type Severity string
+
+// Severities, from the least to the most serious.
+const (
+	SeverityInfo     Severity = "info"
+	SeverityWarn     Severity = "warn"
+	SeverityError    Severity = "error"
+	SeverityCritical Severity = "critical"
+)
+
+// LevelCritical is the slog level of SeverityCritical, above slog.LevelError.
+// LevelCritical은 SeverityCritical의 slog 레벨로, slog.LevelError보다 높습니다.
+const LevelCritical = slog.LevelError + 4
+
+// Level returns the slog level of the severity. Unknown severities are logged at error level.
+// Level은 심각도의 slog 레벨을 반환합니다. 알 수 없는 심각도는 error 레벨입니다.
+func (s Severity) Level() slog.Level {
+	switch s {
+	case SeverityInfo:
+		return slog.LevelInfo
+	case SeverityWarn:
+		return slog.LevelWarn
+	case SeverityCritical:
+		return LevelCritical
+	}
+	return slog.LevelError
+}
+
+// WithSeverity sets the severity of the error.
+// WithSeverity는 오류의 심각도를 설정합니다.
+func WithSeverity(s Severity) Option {
+	return optionFunc(func(e *HttpError) {
+		e.Severity = s
+	})
+}
+
+// SeverityOf returns the severity err resolves to: the Severity of its
+// HttpError if set, otherwise error for 5xx statuses, warn for 4xx and info
+// for the others.
+// SeverityOf는 err가 해석되는 심각도를 반환합니다. HttpError의 Severity가 설정되어 있으면 그 값이고,
+// 그렇지 않으면 5xx는 error, 4xx는 warn, 그 외는 info입니다.
+func SeverityOf(err error) Severity {
+	e := toHttpError(err)
+	if e.Severity != "" {
+		return e.Severity
+	}
+	switch {
+	case e.Status >= 500:
+		return SeverityError
+	case e.Status >= 400:
+		return SeverityWarn
+	}
+	return SeverityInfo
+}
+
+// LogHook returns a Hook logging every error response to l, or to the logger
+// set with SetLogger if l is nil, at the level of the error's severity.
+// See SampledLogHook to log only part of frequent errors.
+// LogHook은 모든 오류 응답을 오류 심각도에 해당하는 레벨로 l(nil이면 SetLogger로 설정된 로거)에 기록하는 Hook을 반환합니다.
+// 빈번한 오류의 일부만 기록하려면 SampledLogHook을 참고하세요.
+func LogHook(l *slog.Logger) Hook {
+	return func(ev ErrorEvent) {
+		logEvent(l, ev)
+	}
+}
+
+// logEvent logs ev to l, or to the configured logger if l is nil, at the
+// level of the error's severity, with extra attributes.
+func logEvent(l *slog.Logger, ev ErrorEvent, extra ...slog.Attr) {
+	if l == nil {
+		l = logger()
+	}
+	severity := SeverityOf(ev.HttpError)
+	attrs := []slog.Attr{
+		slog.String("request_id", ev.RequestID),
+		slog.Int("status", ev.HttpError.Status),
+		slog.String("severity", string(severity)),
+		slog.String("message", ev.HttpError.Message),
+	}
+	if ev.HttpError.Code != "" {
+		attrs = append(attrs, slog.String("code", ev.HttpError.Code))
+	}
+	if ev.Err != nil && ev.Err != error(ev.HttpError) {
+		attrs = append(attrs, slog.String("error", ev.Err.Error()))
+	}
+	ctx := context.Background()
+	if ev.Request != nil {
+		ctx = ev.Request.Context()
+	}
+	l.LogAttrs(ctx, severity.Level(), "http error", append(attrs, extra...)...)
+}
+
+// withSeverityDetail returns a copy of e exposing its severity in the "severity" detail.
+func withSeverityDetail(e *HttpError) *HttpError {
+	c := *e
+	c.Details = make(map[string]any, len(e.Details)+1)
+	for k, v := range e.Details {
+		c.Details[k] = v
+	}
+	c.Details["severity"] = string(SeverityOf(e))
+	return &c
+}
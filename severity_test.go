@@ -0,0 +1,100 @@
+package httperror
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// TestSeverityOf tests the severity errors resolve to.
+func TestSeverityOf(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      error
+		expected Severity
+		level    slog.Level
+	}{
+		{"client error", NotFoundError(), SeverityWarn, slog.LevelWarn},
+		{"server error", ServiceUnavailableError(), SeverityError, slog.LevelError},
+		{"plain error", errors.New("boom"), SeverityError, slog.LevelError},
+		{"redirect", New(http.StatusFound, ""), SeverityInfo, slog.LevelInfo},
+		{"downgraded", NotFoundError(WithSeverity(SeverityInfo)), SeverityInfo, slog.LevelInfo},
+		{"critical", InternalServerErrorError(WithSeverity(SeverityCritical)), SeverityCritical, LevelCritical},
+		{"wrapped", fmt.Errorf("loading: %w", ConflictError(WithSeverity(SeverityError))), SeverityError, slog.LevelError},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := SeverityOf(tc.err)
+			if got != tc.expected {
+				t.Errorf("expected severity %q, got %q", tc.expected, got)
+			}
+			if got.Level() != tc.level {
+				t.Errorf("expected level %v, got %v", tc.level, got.Level())
+			}
+		})
+	}
+}
+
+// TestLogHook tests that error responses are logged at the level of their severity.
+func TestLogHook(t *testing.T) {
+	var buf bytes.Buffer
+	AddHook(LogHook(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))))
+	defer ResetHooks()
+
+	req := httptest.NewRequest("GET", "/", nil)
+	Respond(httptest.NewRecorder(), req, NotFoundError(WithSeverity(SeverityInfo), WithCode("page_not_found")))
+	Respond(httptest.NewRecorder(), req, fmt.Errorf("db: %w", InternalServerErrorError(WithSeverity(SeverityCritical))))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 log lines, got %q", buf.String())
+	}
+	for i, expected := range []string{"level=INFO", "level=ERROR+4"} {
+		if !strings.Contains(lines[i], expected) {
+			t.Errorf("expected %q in %q", expected, lines[i])
+		}
+	}
+	if !strings.Contains(lines[0], "code=page_not_found") || !strings.Contains(lines[1], `error="db: Internal Server Error"`) {
+		t.Errorf("unexpected log lines %q", lines)
+	}
+}
+
+// TestSeverityDetail tests exposing the severity in the response body.
+func TestSeverityDetail(t *testing.T) {
+	testCases := []struct {
+		name     string
+		expose   bool
+		err      *HttpError
+		expected any
+	}{
+		{"hidden", false, NotFoundError(WithSeverity(SeverityInfo)), nil},
+		{"set", true, NotFoundError(WithSeverity(SeverityInfo)), "info"},
+		{"default", true, BadGatewayError(), "error"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rs := NewResponder(WithSeverityDetail(tc.expose))
+			rr := httptest.NewRecorder()
+			rs.HandleError(rr, httptest.NewRequest("GET", "/", nil), tc.err)
+
+			var body HttpError
+			if err := json.NewDecoder(rr.Body).Decode(&body); err != nil {
+				t.Fatalf("could not decode response body: %v", err)
+			}
+			if got := body.Details["severity"]; got != tc.expected {
+				t.Errorf("expected severity %v, got %v", tc.expected, got)
+			}
+			if tc.err.Details != nil {
+				t.Errorf("expected the error not to be modified, got %v", tc.err.Details)
+			}
+		})
+	}
+}
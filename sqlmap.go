@@ -0,0 +1,93 @@
+package httperror
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+)
+
+// SQLSTATE codes for integrity constraint violations.
+const (
+	sqlStateUniqueViolation     = "23505"
+	sqlStateForeignKeyViolation = "23503"
+)
+
+// Driver error messages indicating unique-constraint violations
+// (MySQL/MariaDB, SQLite, SQL Server, PostgreSQL).
+var sqlUniqueMessages = []string{
+	"error 1062",
+	"duplicate entry",
+	"unique constraint failed",
+	"violation of unique key constraint",
+	"violation of primary key constraint",
+	"cannot insert duplicate key",
+	"duplicate key value violates unique constraint",
+}
+
+// Driver error messages indicating foreign-key violations
+// (MySQL/MariaDB, SQLite, SQL Server, PostgreSQL).
+var sqlForeignKeyMessages = []string{
+	"error 1451",
+	"error 1452",
+	"foreign key constraint fails",
+	"foreign key constraint failed",
+	"conflicted with the foreign key constraint",
+	"violates foreign key constraint",
+}
+
+// SQLMappers returns the opt-in mapping set for database/sql errors:
+// sql.ErrNoRows becomes 404 Not Found, unique-constraint violations become
+// 409 Conflict and foreign-key violations become 422 Unprocessable Entity.
+// Driver errors are recognized through their SQLSTATE (pgx, lib/pq) or their message
+// (MySQL, SQLite, SQL Server), so no driver needs to be imported.
+// SQLMappers는 database/sql 오류를 위한 선택적 매퍼 집합을 반환합니다.
+// sql.ErrNoRows는 404, 고유 제약 조건 위반은 409, 외래 키 위반은 422로 변환됩니다.
+func SQLMappers() []Mapper {
+	return []Mapper{mapSQLNoRows, mapSQLConstraint}
+}
+
+// RegisterSQLMappers registers the mappers returned by SQLMappers.
+// RegisterSQLMappers는 SQLMappers가 반환하는 매퍼들을 등록합니다.
+func RegisterSQLMappers() {
+	for _, m := range SQLMappers() {
+		RegisterMapper(m)
+	}
+}
+
+func mapSQLNoRows(err error) (*HttpError, bool) {
+	if errors.Is(err, sql.ErrNoRows) {
+		return NotFoundError(), true
+	}
+	return nil, false
+}
+
+func mapSQLConstraint(err error) (*HttpError, bool) {
+	var stateErr interface{ SQLState() string }
+	if errors.As(err, &stateErr) {
+		switch stateErr.SQLState() {
+		case sqlStateUniqueViolation:
+			return ConflictError(), true
+		case sqlStateForeignKeyViolation:
+			return UnprocessableEntityError(), true
+		}
+	}
+
+	msg := strings.ToLower(err.Error())
+	if containsAny(msg, sqlUniqueMessages) {
+		return ConflictError(), true
+	}
+	if containsAny(msg, sqlForeignKeyMessages) {
+		return UnprocessableEntityError(), true
+	}
+	return nil, false
+}
+
+// containsAny reports whether s contains any of the substrings.
+func containsAny(s string, substrs []string) bool {
+	for _, sub := range substrs {
+		if strings.Contains(s, sub) {
+			return true
+		}
+	}
+	return false
+}
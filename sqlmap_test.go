@@ -0,0 +1,77 @@
+package httperror
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+// pgError mimics the SQLState method of pgx and lib/pq errors.
+type pgError struct{ code string }
+
+func (e *pgError) Error() string    { return "pg error " + e.code }
+func (e *pgError) SQLState() string { return e.code }
+
+// TestSQLMappers tests the translation of database/sql errors.
+func TestSQLMappers(t *testing.T) {
+	testCases := []struct {
+		name           string
+		err            error
+		expectedStatus int
+	}{
+		{"no rows", sql.ErrNoRows, http.StatusNotFound},
+		{"wrapped no rows", fmt.Errorf("find user: %w", sql.ErrNoRows), http.StatusNotFound},
+		{"postgres unique", fmt.Errorf("insert: %w", &pgError{"23505"}), http.StatusConflict},
+		{"postgres foreign key", &pgError{"23503"}, http.StatusUnprocessableEntity},
+		{"mysql duplicate", errors.New("Error 1062 (23000): Duplicate entry 'a' for key 'email'"), http.StatusConflict},
+		{"mysql foreign key", errors.New("Error 1452 (23000): Cannot add or update a child row: a foreign key constraint fails"), http.StatusUnprocessableEntity},
+		{"sqlite unique", errors.New("UNIQUE constraint failed: users.email"), http.StatusConflict},
+		{"sqlite foreign key", errors.New("FOREIGN KEY constraint failed"), http.StatusUnprocessableEntity},
+		{"sqlserver duplicate", errors.New("mssql: Cannot insert duplicate key row in object 'dbo.users'"), http.StatusConflict},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			httpErr, ok := applyMappers(SQLMappers(), tc.err)
+			if !ok {
+				t.Fatalf("expected %v to be mapped", tc.err)
+			}
+			if httpErr.Status != tc.expectedStatus {
+				t.Errorf("expected status %d, got %d", tc.expectedStatus, httpErr.Status)
+			}
+		})
+	}
+
+	t.Run("unrelated errors are not mapped", func(t *testing.T) {
+		if _, ok := applyMappers(SQLMappers(), errors.New("connection refused")); ok {
+			t.Error("expected unrelated error not to be mapped")
+		}
+		if _, ok := applyMappers(SQLMappers(), &pgError{"40001"}); ok {
+			t.Error("expected serialization failure not to be mapped")
+		}
+	})
+}
+
+// TestRegisterSQLMappers tests that the SQL mappers are opt-in.
+func TestRegisterSQLMappers(t *testing.T) {
+	defer ResetMappers()
+
+	if toHttpError(sql.ErrNoRows).Status != http.StatusInternalServerError {
+		t.Error("expected sql.ErrNoRows to be a 500 before registration")
+	}
+	RegisterSQLMappers()
+	if toHttpError(sql.ErrNoRows).Status != http.StatusNotFound {
+		t.Error("expected sql.ErrNoRows to be a 404 after registration")
+	}
+}
+
+func applyMappers(ms []Mapper, err error) (*HttpError, bool) {
+	for _, m := range ms {
+		if httpErr, ok := m(err); ok {
+			return httpErr, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,115 @@
+package httperror
+
+import (
+	"maps"
+	"sync"
+	"time"
+)
+
+// statsWindow is the longest window of the rolling rates, in seconds.
+const statsWindow = 300
+
+// ErrorStats is a snapshot of the errors responded by Respond since the
+// process started or ResetStats was called. Statuses below 400 are not errors
+// and are not counted.
+// ErrorStats는 프로세스 시작 또는 ResetStats 호출 이후 Respond가 응답한 오류의 스냅샷입니다.
+// 400 미만의 상태 코드는 오류가 아니므로 집계되지 않습니다.
+type ErrorStats struct {
+	Total    int64            `json:"total"`
+	ByStatus map[int]int64    `json:"by_status"`
+	ByCode   map[string]int64 `json:"by_code"`
+	// Rate1m and Rate5m are the errors per second over the last minute and
+	// the last five minutes.
+	Rate1m float64 `json:"rate_1m"`
+	Rate5m float64 `json:"rate_5m"`
+	// ServerRate1m and ServerRate5m are the same rates for 5xx errors only.
+	ServerRate1m float64 `json:"server_rate_1m"`
+	ServerRate5m float64 `json:"server_rate_5m"`
+}
+
+// stats holds the counters of Stats; buckets count the errors of the last
+// statsWindow seconds, one bucket per second.
+var stats = struct {
+	mu       sync.Mutex
+	total    int64
+	byStatus map[int]int64
+	byCode   map[string]int64
+	buckets  [statsWindow]struct {
+		second int64
+		all    int64
+		server int64
+	}
+}{byStatus: map[int]int64{}, byCode: map[string]int64{}}
+
+// Stats returns a snapshot of the error statistics maintained by Respond,
+// e.g. for admission control or adaptive behavior inside the application.
+// Stats는 Respond가 유지하는 오류 통계의 스냅샷을 반환합니다(예: 애플리케이션 내부의 유입 제어나 적응형 동작에 활용).
+func Stats() ErrorStats {
+	return statsAt(time.Now())
+}
+
+// ResetStats clears the error statistics.
+// ResetStats는 오류 통계를 초기화합니다.
+func ResetStats() {
+	stats.mu.Lock()
+	defer stats.mu.Unlock()
+	stats.total = 0
+	stats.byStatus = map[int]int64{}
+	stats.byCode = map[string]int64{}
+	clear(stats.buckets[:])
+}
+
+// recordStats counts e, responded at t.
+func recordStats(e *HttpError, t time.Time) {
+	if e.Status < 400 {
+		return
+	}
+	stats.mu.Lock()
+	defer stats.mu.Unlock()
+	stats.total++
+	stats.byStatus[e.Status]++
+	if e.Code != "" {
+		stats.byCode[e.Code]++
+	}
+
+	second := t.Unix()
+	b := &stats.buckets[second%statsWindow]
+	if b.second != second {
+		b.second, b.all, b.server = second, 0, 0
+	}
+	b.all++
+	if e.Status >= 500 {
+		b.server++
+	}
+}
+
+// statsAt returns the statistics as of now.
+func statsAt(now time.Time) ErrorStats {
+	stats.mu.Lock()
+	defer stats.mu.Unlock()
+	s := ErrorStats{
+		Total:    stats.total,
+		ByStatus: maps.Clone(stats.byStatus),
+		ByCode:   maps.Clone(stats.byCode),
+	}
+
+	second := now.Unix()
+	var all1m, server1m, all5m, server5m int64
+	for _, b := range stats.buckets {
+		age := second - b.second
+		if age < 0 || age >= statsWindow {
+			continue
+		}
+		all5m += b.all
+		server5m += b.server
+		if age < 60 {
+			all1m += b.all
+			server1m += b.server
+		}
+	}
+	s.Rate1m = float64(all1m) / 60
+	s.Rate5m = float64(all5m) / statsWindow
+	s.ServerRate1m = float64(server1m) / 60
+	s.ServerRate5m = float64(server5m) / statsWindow
+	return s
+}
@@ -0,0 +1,65 @@
+package httperror
+
+import (
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// TestStats tests that Respond maintains the error statistics.
+func TestStats(t *testing.T) {
+	ResetStats()
+	defer ResetStats()
+
+	req := httptest.NewRequest("GET", "/", nil)
+	Respond(httptest.NewRecorder(), req, NotFoundError(WithCode("user_not_found")))
+	Respond(httptest.NewRecorder(), req, NotFoundError())
+	Respond(httptest.NewRecorder(), req, errors.New("boom"))
+	Respond(httptest.NewRecorder(), req, New(204, ""))
+
+	s := Stats()
+	if s.Total != 3 || s.ByStatus[404] != 2 || s.ByStatus[500] != 1 || s.ByCode["user_not_found"] != 1 {
+		t.Errorf("unexpected counts: %+v", s)
+	}
+	if s.Rate1m != 3.0/60 || s.ServerRate1m != 1.0/60 {
+		t.Errorf("unexpected rates: %+v", s)
+	}
+
+	s.ByStatus[404] = 100
+	if Stats().ByStatus[404] != 2 {
+		t.Error("expected the snapshot to be a copy")
+	}
+}
+
+// TestStatsRates tests the rolling windows of the error rates.
+func TestStatsRates(t *testing.T) {
+	ResetStats()
+	defer ResetStats()
+
+	now := time.Unix(1_800_000_000, 0)
+	recordStats(InternalServerErrorError(), now.Add(-10*time.Minute))
+	recordStats(InternalServerErrorError(), now.Add(-3*time.Minute))
+	recordStats(BadRequestError(), now.Add(-30*time.Second))
+	recordStats(BadRequestError(), now)
+
+	testCases := []struct {
+		name     string
+		got      float64
+		expected float64
+	}{
+		{"1m", statsAt(now).Rate1m, 2.0 / 60},
+		{"5m", statsAt(now).Rate5m, 3.0 / 300},
+		{"server 1m", statsAt(now).ServerRate1m, 0},
+		{"server 5m", statsAt(now).ServerRate5m, 1.0 / 300},
+		{"later", statsAt(now.Add(10 * time.Minute)).Rate5m, 0},
+	}
+	for _, tc := range testCases {
+		if tc.got != tc.expected {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.expected, tc.got)
+		}
+	}
+	if got := statsAt(now).Total; got != 4 {
+		t.Errorf("expected 4 errors in total, got %d", got)
+	}
+}
@@ -0,0 +1,105 @@
+package httperror
+
+import (
+	"net/http"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// customStatuses holds an immutable snapshot of the registered status texts,
+// replaced as a whole under customStatusesMu.
+var (
+	customStatusesMu sync.Mutex
+	customStatuses   atomic.Pointer[map[int]string]
+)
+
+// RegisterStatus registers the text of a non-standard status code, such as the
+// Cloudflare 52x codes or internal codes, so New, the helpers and the encoders
+// render a sensible default message instead of an empty one. Codes that have a
+// standard text, including 499 Client Closed Request, keep it.
+// RegisterStatus는 Cloudflare 52x 코드나 내부 코드 같은 비표준 상태 코드의 텍스트를 등록하여
+// New, 헬퍼, 인코더가 빈 메시지 대신 적절한 기본 메시지를 렌더링하게 합니다. 표준 텍스트가 있는 코드는 변경되지 않습니다.
+func RegisterStatus(code int, text string) {
+	if standardStatusText(code) != "" {
+		return
+	}
+	customStatusesMu.Lock()
+	defer customStatusesMu.Unlock()
+	next := make(map[int]string)
+	if m := customStatuses.Load(); m != nil {
+		for k, v := range *m {
+			next[k] = v
+		}
+	}
+	next[code] = text
+	customStatuses.Store(&next)
+}
+
+// RegisterNginxStatuses registers the non-standard statuses used by nginx (494–499).
+// RegisterNginxStatuses는 nginx가 사용하는 비표준 상태 코드(494–499)를 등록합니다.
+func RegisterNginxStatuses() {
+	RegisterStatus(494, "Request Header Too Large")
+	RegisterStatus(495, "SSL Certificate Error")
+	RegisterStatus(496, "SSL Certificate Required")
+	RegisterStatus(497, "HTTP Request Sent to HTTPS Port")
+}
+
+// RegisterCloudflareStatuses registers the non-standard statuses used by Cloudflare (520–527).
+// RegisterCloudflareStatuses는 Cloudflare가 사용하는 비표준 상태 코드(520–527)를 등록합니다.
+func RegisterCloudflareStatuses() {
+	RegisterStatus(520, "Web Server Returned an Unknown Error")
+	RegisterStatus(521, "Web Server Is Down")
+	RegisterStatus(522, "Connection Timed Out")
+	RegisterStatus(523, "Origin Is Unreachable")
+	RegisterStatus(524, "A Timeout Occurred")
+	RegisterStatus(525, "SSL Handshake Failed")
+	RegisterStatus(526, "Invalid SSL Certificate")
+	RegisterStatus(527, "Railgun Error")
+}
+
+// StatusText returns the text of a status code: the standard text given by
+// http.StatusText, "Client Closed Request" for 499, or the text registered
+// with RegisterStatus. It returns the empty string if the code is unknown.
+// StatusText는 상태 코드의 텍스트를 반환합니다. http.StatusText의 표준 텍스트, 499의 "Client Closed Request",
+// 또는 RegisterStatus로 등록된 텍스트이며, 알 수 없는 코드이면 빈 문자열을 반환합니다.
+func StatusText(code int) string {
+	if text := standardStatusText(code); text != "" {
+		return text
+	}
+	if m := customStatuses.Load(); m != nil {
+		return (*m)[code]
+	}
+	return ""
+}
+
+// standardStatusText returns the text of the statuses known without
+// registration: the standard ones and 499, which has generated helpers. It
+// does not depend on init order, so package-level sentinels get their text.
+func standardStatusText(code int) string {
+	if code == StatusClientClosedRequest {
+		return "Client Closed Request"
+	}
+	return http.StatusText(code)
+}
+
+// Statuses returns, in ascending order, every status code this package has a
+// helper for and the codes registered with RegisterStatus.
+// Statuses는 이 패키지가 헬퍼를 제공하는 모든 상태 코드와 RegisterStatus로 등록된 코드를 오름차순으로 반환합니다.
+func Statuses() []int {
+	codes := make([]int, 0, len(statusFamilies))
+	generated := make(map[int]bool, len(statusFamilies))
+	for _, f := range statusFamilies {
+		codes = append(codes, f.Status)
+		generated[f.Status] = true
+	}
+	if m := customStatuses.Load(); m != nil {
+		for code := range *m {
+			if !generated[code] {
+				codes = append(codes, code)
+			}
+		}
+	}
+	sort.Ints(codes)
+	return codes
+}
@@ -0,0 +1,64 @@
+package httperror
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sort"
+	"testing"
+)
+
+// TestRegisterStatus tests the texts of non-standard status codes.
+func TestRegisterStatus(t *testing.T) {
+	RegisterCloudflareStatuses()
+	RegisterStatus(590, "Ledger Unavailable")
+	RegisterStatus(http.StatusNotFound, "Nope")
+
+	testCases := []struct {
+		code     int
+		expected string
+	}{
+		{http.StatusNotFound, "Not Found"},
+		{StatusClientClosedRequest, "Client Closed Request"},
+		{521, "Web Server Is Down"},
+		{590, "Ledger Unavailable"},
+		{591, ""},
+	}
+	for _, tc := range testCases {
+		if got := StatusText(tc.code); got != tc.expected {
+			t.Errorf("StatusText(%d): expected %q, got %q", tc.code, tc.expected, got)
+		}
+	}
+
+	if got := New(590, "").Message; got != "Ledger Unavailable" {
+		t.Errorf("expected New to default to the registered text, got %q", got)
+	}
+	rr := httptest.NewRecorder()
+	Respond(rr, httptest.NewRequest("GET", "/", nil), New(522, ""))
+	var body HttpError
+	if err := json.NewDecoder(rr.Body).Decode(&body); err != nil {
+		t.Fatalf("could not decode response body: %v", err)
+	}
+	if rr.Code != 522 || body.Message != "Connection Timed Out" {
+		t.Errorf("unexpected response: %d %+v", rr.Code, body)
+	}
+}
+
+// TestStatuses tests listing the generated and registered statuses.
+func TestStatuses(t *testing.T) {
+	RegisterStatus(590, "Ledger Unavailable")
+	codes := Statuses()
+
+	if !sort.IntsAreSorted(codes) {
+		t.Errorf("expected sorted codes, got %v", codes)
+	}
+	for _, code := range []int{http.StatusBadRequest, http.StatusNetworkAuthenticationRequired, StatusClientClosedRequest, 590} {
+		if !slices.Contains(codes, code) {
+			t.Errorf("expected %d to be listed", code)
+		}
+	}
+	if !slices.Equal(codes, slices.Compact(slices.Clone(codes))) {
+		t.Errorf("expected every code to be listed once, got %v", codes)
+	}
+}
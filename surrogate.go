@@ -0,0 +1,82 @@
+package httperror
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SurrogatePolicy describes the Surrogate-Control header emitted on error
+// responses, so CDN and edge caches can keep serving stale content while the
+// origin is failing instead of caching or forwarding the error.
+// SurrogatePolicy는 오류 응답에 포함되는 Surrogate-Control 헤더를 정의합니다.
+// 이를 통해 CDN/엣지 캐시가 원본 서버 장애 중에도 오래된 콘텐츠를 계속 제공할 수 있습니다.
+type SurrogatePolicy struct {
+	// NoStore forbids surrogates from storing the error response.
+	NoStore bool
+	// MaxAge is how long surrogates may cache the error response.
+	MaxAge time.Duration
+	// StaleIfError is how long surrogates may serve stale content instead of an error.
+	StaleIfError time.Duration
+	// StaleWhileRevalidate is how long surrogates may serve stale content while revalidating.
+	StaleWhileRevalidate time.Duration
+}
+
+// String returns the Surrogate-Control header value, e.g. "max-age=0, stale-if-error=300".
+func (p SurrogatePolicy) String() string {
+	var directives []string
+	if p.NoStore {
+		directives = append(directives, "no-store")
+	} else {
+		directives = append(directives, "max-age="+seconds(p.MaxAge))
+	}
+	if p.StaleWhileRevalidate > 0 {
+		directives = append(directives, "stale-while-revalidate="+seconds(p.StaleWhileRevalidate))
+	}
+	if p.StaleIfError > 0 {
+		directives = append(directives, "stale-if-error="+seconds(p.StaleIfError))
+	}
+	return strings.Join(directives, ", ")
+}
+
+func seconds(d time.Duration) string {
+	return strconv.FormatInt(int64(d/time.Second), 10)
+}
+
+// SetSurrogateControl sets the Surrogate-Control policy for a specific status code.
+// It takes precedence over the policy of the status class.
+// SetSurrogateControl은 특정 상태 코드에 대한 Surrogate-Control 정책을 설정합니다.
+// 상태 클래스 정책보다 우선합니다.
+func (rs *Responder) SetSurrogateControl(status int, policy SurrogatePolicy) {
+	rs.update(func(cfg *responderConfig) {
+		if cfg.surrogate == nil {
+			cfg.surrogate = make(map[int]SurrogatePolicy)
+		}
+		cfg.surrogate[status] = policy
+	})
+}
+
+// SetClassSurrogateControl sets the Surrogate-Control policy for a whole status
+// class, given as its first digit (4 for 4xx, 5 for 5xx).
+// SetClassSurrogateControl은 상태 클래스 전체(4xx는 4, 5xx는 5)에 대한 Surrogate-Control 정책을 설정합니다.
+func (rs *Responder) SetClassSurrogateControl(class int, policy SurrogatePolicy) {
+	rs.update(func(cfg *responderConfig) {
+		if cfg.surrogateByClass == nil {
+			cfg.surrogateByClass = make(map[int]SurrogatePolicy)
+		}
+		cfg.surrogateByClass[class] = policy
+	})
+}
+
+// setSurrogateControl writes the Surrogate-Control header configured for status, if any.
+func (cfg *responderConfig) setSurrogateControl(h http.Header, status int) {
+	policy, ok := cfg.surrogate[status]
+	if !ok {
+		policy, ok = cfg.surrogateByClass[status/100]
+	}
+
+	if ok {
+		h.Set("Surrogate-Control", policy.String())
+	}
+}
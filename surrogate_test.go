@@ -0,0 +1,58 @@
+package httperror
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// TestSurrogatePolicy_String tests the rendering of Surrogate-Control directives.
+func TestSurrogatePolicy_String(t *testing.T) {
+	testCases := []struct {
+		policy   SurrogatePolicy
+		expected string
+	}{
+		{SurrogatePolicy{}, "max-age=0"},
+		{SurrogatePolicy{NoStore: true}, "no-store"},
+		{SurrogatePolicy{MaxAge: 10 * time.Second, StaleIfError: 5 * time.Minute}, "max-age=10, stale-if-error=300"},
+		{SurrogatePolicy{StaleWhileRevalidate: time.Minute, StaleIfError: time.Hour}, "max-age=0, stale-while-revalidate=60, stale-if-error=3600"},
+	}
+	for _, tc := range testCases {
+		if got := tc.policy.String(); got != tc.expected {
+			t.Errorf("expected %q, got %q", tc.expected, got)
+		}
+	}
+}
+
+// TestResponderSurrogateControl tests per-status and per-class surrogate policies.
+func TestResponderSurrogateControl(t *testing.T) {
+	rs := NewResponder()
+	rs.SetClassSurrogateControl(5, SurrogatePolicy{StaleIfError: time.Hour})
+	rs.SetSurrogateControl(http.StatusNotFound, SurrogatePolicy{MaxAge: time.Minute})
+	rs.SetSurrogateControl(http.StatusServiceUnavailable, SurrogatePolicy{NoStore: true})
+
+	testCases := []struct {
+		err      *HttpError
+		expected string
+	}{
+		{NotFoundError(), "max-age=60"},
+		{BadGatewayError(), "max-age=0, stale-if-error=3600"},
+		{ServiceUnavailableError(), "no-store"},
+		{BadRequestError(), ""},
+	}
+	for _, tc := range testCases {
+		rr := httptest.NewRecorder()
+		rs.HandleError(rr, httptest.NewRequest("GET", "/", nil), tc.err)
+		if got := rr.Header().Get("Surrogate-Control"); got != tc.expected {
+			t.Errorf("status %d: expected Surrogate-Control %q, got %q", tc.err.Status, tc.expected, got)
+		}
+	}
+
+	// The default responder is unaffected by other responders.
+	rr := httptest.NewRecorder()
+	DefaultErrorHandler(rr, httptest.NewRequest("GET", "/", nil), BadGatewayError())
+	if got := rr.Header().Get("Surrogate-Control"); got != "" {
+		t.Errorf("expected no Surrogate-Control on the default responder, got %q", got)
+	}
+}
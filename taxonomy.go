@@ -0,0 +1,139 @@
+package httperror
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Taxonomy is an aggregated view of the errors recorded in the journal,
+// grouped by status, code, route and fingerprint, most frequent first.
+// Taxonomy는 저널에 기록된 오류를 상태 코드, 코드, 경로, 지문별로 집계한 결과이며 빈도순으로 정렬됩니다.
+type Taxonomy struct {
+	Since         time.Time       `json:"since"`
+	Until         time.Time       `json:"until"`
+	Total         int             `json:"total"`
+	ByStatus      []TaxonomyEntry `json:"by_status"`
+	ByCode        []TaxonomyEntry `json:"by_code"`
+	ByRoute       []TaxonomyEntry `json:"by_route"`
+	ByFingerprint []TaxonomyEntry `json:"by_fingerprint"`
+}
+
+// TaxonomyEntry is the number of errors sharing a key, with the message of the latest one.
+// TaxonomyEntry는 같은 키를 가진 오류의 수와 가장 최근 오류의 메시지입니다.
+type TaxonomyEntry struct {
+	Key     string    `json:"key"`
+	Count   int       `json:"count"`
+	Last    time.Time `json:"last"`
+	Example string    `json:"example,omitempty"`
+}
+
+// TaxonomyReport aggregates the errors responded since the given time, for quick
+// incident triage without external tooling. It only covers the errors still
+// kept by the journal, which is disabled unless sized with SetJournalSize.
+// Errors without a code or a route pattern (see WithRoute) are grouped under "-".
+// TaxonomyReport는 주어진 시각 이후 응답된 오류를 집계하여 외부 도구 없이 장애를 빠르게 분류할 수 있게 합니다.
+// SetJournalSize로 크기를 지정해야 활성화되는 저널에 남아 있는 오류만 포함됩니다.
+func TaxonomyReport(since time.Time) Taxonomy {
+	entries := journalSince(since)
+	t := Taxonomy{Since: since, Until: time.Now(), Total: len(entries)}
+
+	group := func(key func(e journalEntry) string) []TaxonomyEntry {
+		idx := make(map[string]int)
+		var out []TaxonomyEntry
+		for _, e := range entries {
+			k := key(e)
+			if k == "" {
+				k = "-"
+			}
+			i, ok := idx[k]
+			if !ok {
+				i = len(out)
+				idx[k] = i
+				out = append(out, TaxonomyEntry{Key: k})
+			}
+			out[i].Count++
+			out[i].Last = e.Time
+			out[i].Example = e.Message
+		}
+		sort.SliceStable(out, func(i, j int) bool {
+			if out[i].Count != out[j].Count {
+				return out[i].Count > out[j].Count
+			}
+			return out[i].Key < out[j].Key
+		})
+		return out
+	}
+
+	t.ByStatus = group(func(e journalEntry) string { return strconv.Itoa(e.Status) })
+	t.ByCode = group(func(e journalEntry) string { return e.Code })
+	t.ByRoute = group(func(e journalEntry) string { return e.Route })
+	t.ByFingerprint = group(func(e journalEntry) string { return e.Fingerprint })
+	return t
+}
+
+// WriteHTML writes the taxonomy as a minimal HTML page.
+// WriteHTML은 집계 결과를 간단한 HTML 페이지로 작성합니다.
+func (t Taxonomy) WriteHTML(w io.Writer) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "<!DOCTYPE html>\n<html><head><title>Error taxonomy</title></head><body>\n")
+	fmt.Fprintf(&b, "<h1>%d errors</h1>\n<p>%s &ndash; %s</p>\n", t.Total,
+		t.Since.Format(time.RFC3339), t.Until.Format(time.RFC3339))
+	sections := []struct {
+		title   string
+		entries []TaxonomyEntry
+	}{
+		{"Status", t.ByStatus},
+		{"Code", t.ByCode},
+		{"Route", t.ByRoute},
+		{"Fingerprint", t.ByFingerprint},
+	}
+	for _, s := range sections {
+		fmt.Fprintf(&b, "<h2>By %s</h2>\n<table>\n<tr><th>%s</th><th>Count</th><th>Last</th><th>Example</th></tr>\n", s.title, s.title)
+		for _, e := range s.entries {
+			fmt.Fprintf(&b, "<tr><td>%s</td><td>%d</td><td>%s</td><td>%s</td></tr>\n",
+				html.EscapeString(e.Key), e.Count, e.Last.Format(time.RFC3339), html.EscapeString(e.Example))
+		}
+		b.WriteString("</table>\n")
+	}
+	b.WriteString("</body></html>\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// TaxonomyHandler returns an http.Handler serving TaxonomyReport, meant to be
+// mounted as an internal endpoint. The "since" query parameter is either a
+// duration looking back from now (e.g. "15m") or an RFC 3339 time, and defaults
+// to one hour. The report is HTML when the client accepts text/html and JSON otherwise.
+// TaxonomyHandler는 TaxonomyReport를 제공하는 http.Handler를 반환하며, 내부 엔드포인트로 마운트하는 용도입니다.
+// "since" 쿼리 매개변수는 현재로부터의 기간(예: "15m") 또는 RFC 3339 시각이며 기본값은 1시간입니다.
+func TaxonomyHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		since := time.Now().Add(-time.Hour)
+		if v := r.URL.Query().Get("since"); v != "" {
+			if d, err := time.ParseDuration(v); err == nil {
+				since = time.Now().Add(-d)
+			} else if t, err := time.Parse(time.RFC3339, v); err == nil {
+				since = t
+			} else {
+				BadRequest(w, r, "invalid since parameter")
+				return
+			}
+		}
+
+		t := TaxonomyReport(since)
+		if strings.Contains(r.Header.Get("Accept"), "text/html") {
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			t.WriteHTML(w)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		json.NewEncoder(w).Encode(t)
+	})
+}
@@ -0,0 +1,101 @@
+package httperror
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestTaxonomyReport tests aggregating the journal by status, code, route and fingerprint.
+func TestTaxonomyReport(t *testing.T) {
+	SetJournalSize(16)
+	defer SetJournalSize(0)
+
+	errDB := errors.New("connection refused")
+	respond := func(method, path, route string, err error) {
+		req := httptest.NewRequest(method, path, nil)
+		Respond(httptest.NewRecorder(), req.WithContext(WithRoute(req.Context(), route)), err)
+	}
+	respond("GET", "/users/1", "/users/{id}", NotFoundError(WithCode("user_not_found")))
+	respond("GET", "/users/2", "/users/{id}", NotFoundError(WithCode("user_not_found")))
+	respond("POST", "/orders", "/orders", fmt.Errorf("saving order: %w", errDB))
+	respond("GET", "/ok", "/ok", New(http.StatusNoContent, "No Content"))
+
+	report := TaxonomyReport(time.Now().Add(-time.Minute))
+	if report.Total != 3 {
+		t.Fatalf("expected 3 errors, got %d", report.Total)
+	}
+	if got := report.ByStatus[0]; got.Key != "404" || got.Count != 2 {
+		t.Errorf("unexpected top status: %+v", got)
+	}
+	if got := report.ByCode; len(got) != 2 || got[0].Key != "user_not_found" || got[1].Key != "-" {
+		t.Errorf("unexpected codes: %+v", got)
+	}
+	if got := report.ByRoute[0]; got.Key != "GET /users/{id}" || got.Count != 2 {
+		t.Errorf("unexpected top route: %+v", got)
+	}
+	if len(report.ByFingerprint) != 2 {
+		t.Errorf("expected 2 fingerprints, got %+v", report.ByFingerprint)
+	}
+
+	if got := TaxonomyReport(time.Now().Add(time.Minute)); got.Total != 0 {
+		t.Errorf("expected no errors in the future, got %d", got.Total)
+	}
+}
+
+// TestJournalWrap tests that the journal keeps only the most recent errors.
+func TestJournalWrap(t *testing.T) {
+	SetJournalSize(2)
+	defer SetJournalSize(0)
+
+	for _, status := range []int{400, 401, 403} {
+		Respond(nil, nil, New(status, http.StatusText(status)))
+	}
+	entries := journalSince(time.Time{})
+	if len(entries) != 2 || entries[0].Status != 401 || entries[1].Status != 403 {
+		t.Errorf("unexpected journal entries: %+v", entries)
+	}
+
+	SetJournalSize(0)
+	Respond(nil, nil, BadRequestError())
+	if got := journalSince(time.Time{}); len(got) != 0 {
+		t.Errorf("expected a disabled journal to stay empty, got %+v", got)
+	}
+}
+
+// TestTaxonomyHandler tests serving the report as JSON and HTML.
+func TestTaxonomyHandler(t *testing.T) {
+	SetJournalSize(16)
+	defer SetJournalSize(0)
+	req := httptest.NewRequest("GET", "/", nil)
+	Respond(httptest.NewRecorder(), req.WithContext(WithRoute(req.Context(), "/<script>")), BadRequestError())
+
+	rr := httptest.NewRecorder()
+	TaxonomyHandler().ServeHTTP(rr, httptest.NewRequest("GET", "/debug/errors?since=5m", nil))
+	var report Taxonomy
+	if err := json.NewDecoder(rr.Body).Decode(&report); err != nil {
+		t.Fatalf("could not decode report: %v", err)
+	}
+	if report.Total != 1 {
+		t.Errorf("expected 1 error, got %d", report.Total)
+	}
+
+	rr = httptest.NewRecorder()
+	req = httptest.NewRequest("GET", "/debug/errors", nil)
+	req.Header.Set("Accept", "text/html")
+	TaxonomyHandler().ServeHTTP(rr, req)
+	if body := rr.Body.String(); !strings.Contains(body, "GET /&lt;script&gt;") {
+		t.Errorf("expected escaped route in HTML report, got %q", body)
+	}
+
+	rr = httptest.NewRecorder()
+	TaxonomyHandler().ServeHTTP(rr, httptest.NewRequest("GET", "/debug/errors?since=yesterday", nil))
+	if rr.Code != http.StatusBadRequest {
+		t.Errorf("expected 400 for an invalid since, got %d", rr.Code)
+	}
+}
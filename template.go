@@ -0,0 +1,52 @@
+package httperror
+
+import (
+	"fmt"
+	"strings"
+)
+
+// expandParams returns a copy of e with the named placeholders of its message
+// substituted from e.Params. The untranslated template and the raw params are
+// exposed in the details under "template" and "params", so clients can
+// localize the message themselves. Unknown placeholders are left as is.
+func expandParams(e *HttpError, template string) *HttpError {
+	if len(e.Params) == 0 {
+		return e
+	}
+
+	expanded := *e
+	expanded.Message = expandTemplate(e.Message, e.Params)
+	expanded.Details = make(map[string]any, len(e.Details)+2)
+	for k, v := range e.Details {
+		expanded.Details[k] = v
+	}
+	expanded.Details["template"] = template
+	expanded.Details["params"] = e.Params
+	return &expanded
+}
+
+// expandTemplate substitutes every {name} placeholder of template found in params.
+func expandTemplate(template string, params map[string]any) string {
+	var b strings.Builder
+	for {
+		start := strings.IndexByte(template, '{')
+		if start < 0 {
+			break
+		}
+		end := strings.IndexByte(template[start:], '}')
+		if end < 0 {
+			break
+		}
+		end += start
+
+		b.WriteString(template[:start])
+		if v, ok := params[template[start+1:end]]; ok {
+			fmt.Fprint(&b, v)
+		} else {
+			b.WriteString(template[start : end+1])
+		}
+		template = template[end+1:]
+	}
+	b.WriteString(template)
+	return b.String()
+}
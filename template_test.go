@@ -0,0 +1,79 @@
+package httperror
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+// TestExpandTemplate tests substituting named placeholders.
+func TestExpandTemplate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		template string
+		params   map[string]any
+		expected string
+	}{
+		{"single", "user {id} not found", map[string]any{"id": 42}, "user 42 not found"},
+		{"several", "{kind} {id} is {state}", map[string]any{"kind": "order", "id": "A-7", "state": "locked"}, "order A-7 is locked"},
+		{"repeated", "{id} and {id}", map[string]any{"id": 1}, "1 and 1"},
+		{"unknown placeholder", "user {name} not found", map[string]any{"id": 42}, "user {name} not found"},
+		{"unclosed brace", "user {id", map[string]any{"id": 42}, "user {id"},
+		{"no placeholder", "plain message", map[string]any{"id": 42}, "plain message"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := expandTemplate(tc.template, tc.params); got != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+// TestMessageTemplate tests rendering templated messages after translation.
+func TestMessageTemplate(t *testing.T) {
+	RegisterCatalog("ko", Catalog{"user {id} not found": "사용자 {id}을(를) 찾을 수 없습니다"})
+	defer ResetCatalogs()
+
+	testCases := []struct {
+		name           string
+		acceptLanguage string
+		expected       string
+	}{
+		{"default language", "", "user 42 not found"},
+		{"translated", "ko", "사용자 42을(를) 찾을 수 없습니다"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := NotFoundError("user {id} not found", WithParam("id", 42), WithDetail("resource", "user"))
+			req := httptest.NewRequest("GET", "/", nil)
+			if tc.acceptLanguage != "" {
+				req.Header.Set("Accept-Language", tc.acceptLanguage)
+			}
+			rr := httptest.NewRecorder()
+			Respond(rr, req, err)
+
+			var body HttpError
+			if err := json.NewDecoder(rr.Body).Decode(&body); err != nil {
+				t.Fatalf("could not decode response body: %v", err)
+			}
+			if body.Message != tc.expected {
+				t.Errorf("expected message %q, got %q", tc.expected, body.Message)
+			}
+			expectedDetails := map[string]any{
+				"resource": "user",
+				"template": "user {id} not found",
+				"params":   map[string]any{"id": float64(42)},
+			}
+			if !reflect.DeepEqual(body.Details, expectedDetails) {
+				t.Errorf("expected details %v, got %v", expectedDetails, body.Details)
+			}
+			if err.Message != "user {id} not found" || len(err.Details) != 1 {
+				t.Errorf("expected the error not to be modified, got %q %v", err.Message, err.Details)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package httperror
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Timeout returns a middleware enforcing a per-request deadline of d. The
+// handler runs with a context that expires after d; if it has not finished by
+// then, a 504 Gateway Timeout HttpError is rendered through Respond, negotiated
+// like any other error, unlike http.TimeoutHandler's fixed text body. A client
+// going away first is responded like context.Canceled.
+// As with http.TimeoutHandler, the handler's response is buffered until it
+// returns, so it does not suit streaming handlers; writes after the deadline
+// fail with http.ErrHandlerTimeout.
+// Timeout은 요청마다 d의 기한을 적용하는 미들웨어를 반환합니다. 핸들러는 d 후 만료되는 컨텍스트로 실행되며,
+// 그때까지 끝나지 않으면 http.TimeoutHandler의 고정 텍스트 대신 Respond를 통해 504 Gateway Timeout을 렌더링합니다.
+// http.TimeoutHandler와 마찬가지로 응답은 핸들러가 반환될 때까지 버퍼링되므로 스트리밍 핸들러에는 적합하지 않습니다.
+func Timeout(d time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx, cancel := context.WithTimeout(r.Context(), d)
+			defer cancel()
+			r = r.WithContext(ctx)
+
+			tw := &timeoutWriter{header: make(http.Header)}
+			done := make(chan struct{})
+			panicked := make(chan any, 1)
+			go func() {
+				defer func() {
+					if v := recover(); v != nil {
+						panicked <- v
+					}
+				}()
+				next.ServeHTTP(tw, r)
+				close(done)
+			}()
+
+			select {
+			case v := <-panicked:
+				panic(v)
+			case <-done:
+				tw.mu.Lock()
+				defer tw.mu.Unlock()
+				dst := w.Header()
+				for k, vv := range tw.header {
+					dst[k] = vv
+				}
+				if tw.status == 0 {
+					tw.status = http.StatusOK
+				}
+				w.WriteHeader(tw.status)
+				w.Write(tw.body.Bytes())
+			case <-ctx.Done():
+				tw.mu.Lock()
+				defer tw.mu.Unlock()
+				tw.timedOut = true
+				err := ctx.Err()
+				if errors.Is(err, context.DeadlineExceeded) {
+					e := GatewayTimeoutError()
+					e.cause = err
+					err = e
+				}
+				Respond(w, r, err)
+			}
+		})
+	}
+}
+
+// timeoutWriter buffers the response of a handler run by Timeout.
+type timeoutWriter struct {
+	mu       sync.Mutex
+	header   http.Header
+	body     bytes.Buffer
+	status   int
+	timedOut bool
+}
+
+func (tw *timeoutWriter) Header() http.Header {
+	return tw.header
+}
+
+func (tw *timeoutWriter) WriteHeader(status int) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	if tw.timedOut || tw.status != 0 {
+		return
+	}
+	tw.status = status
+}
+
+func (tw *timeoutWriter) Write(p []byte) (int, error) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	if tw.timedOut {
+		return 0, http.ErrHandlerTimeout
+	}
+	if tw.status == 0 {
+		tw.status = http.StatusOK
+	}
+	return tw.body.Write(p)
+}
@@ -0,0 +1,69 @@
+package httperror
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// TestTimeout tests the per-request deadline of the Timeout middleware.
+func TestTimeout(t *testing.T) {
+	late := make(chan error, 1)
+	slow := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+		time.Sleep(20 * time.Millisecond) // let the middleware respond first
+		_, err := fmt.Fprint(w, "too late")
+		late <- err
+	})
+	fast := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Handler", "fast")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, "created")
+	})
+
+	t.Run("deadline exceeded", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", "/", nil)
+		req.Header.Set("Accept", "text/html")
+		Timeout(10*time.Millisecond)(slow).ServeHTTP(rr, req)
+
+		if rr.Code != http.StatusGatewayTimeout {
+			t.Errorf("expected 504, got %d", rr.Code)
+		}
+		if rr.Header().Get("Content-Type") != "text/html; charset=utf-8" {
+			t.Errorf("expected a negotiated HTML body, got %q", rr.Header().Get("Content-Type"))
+		}
+		if err := <-late; err != http.ErrHandlerTimeout {
+			t.Errorf("expected late writes to fail with ErrHandlerTimeout, got %v", err)
+		}
+	})
+
+	t.Run("client canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		rr := httptest.NewRecorder()
+		Timeout(time.Hour)(slow).ServeHTTP(rr, httptest.NewRequest("GET", "/", nil).WithContext(ctx))
+		<-late
+
+		var body HttpError
+		if err := json.NewDecoder(rr.Body).Decode(&body); err != nil {
+			t.Fatalf("could not decode response body: %v", err)
+		}
+		if rr.Code != http.StatusRequestTimeout || body.Status != http.StatusRequestTimeout {
+			t.Errorf("expected the canceled status, got %d", rr.Code)
+		}
+	})
+
+	t.Run("in time", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		Timeout(time.Second)(fast).ServeHTTP(rr, httptest.NewRequest("GET", "/", nil))
+
+		if rr.Code != http.StatusCreated || rr.Body.String() != "created" || rr.Header().Get("X-Handler") != "fast" {
+			t.Errorf("expected the handler response, got %d %q %v", rr.Code, rr.Body.String(), rr.Header())
+		}
+	})
+}
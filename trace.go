@@ -0,0 +1,63 @@
+package httperror
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+)
+
+// TraceFunc returns the IDs of the trace and span active in ctx, or empty
+// strings if there is none.
+// TraceFunc는 ctx에서 활성화된 트레이스와 스팬의 ID를 반환하며, 없으면 빈 문자열을 반환합니다.
+type TraceFunc func(ctx context.Context) (traceID, spanID string)
+
+// traceFunc holds the registered TraceFunc.
+var traceFunc atomic.Pointer[TraceFunc]
+
+// RegisterTraceFunc registers the function reading the active trace and span
+// from request contexts; a nil fn unregisters it. Importing the httperrorotel
+// module registers one for OpenTelemetry.
+// RegisterTraceFunc는 요청 컨텍스트에서 활성 트레이스와 스팬을 읽는 함수를 등록하며, nil이면 등록을 해제합니다.
+// httperrorotel 모듈을 import하면 OpenTelemetry용 함수가 등록됩니다.
+func RegisterTraceFunc(fn TraceFunc) {
+	if fn == nil {
+		traceFunc.Store(nil)
+		return
+	}
+	traceFunc.Store(&fn)
+}
+
+// WithTraceIDs sets whether the IDs of the trace and span active in the
+// request context, read by the function registered with RegisterTraceFunc,
+// are added to the details ("trace_id" and "span_id"), so users can paste
+// them into support tickets and engineers can jump straight to the trace.
+// They are added by default.
+// WithTraceIDs는 RegisterTraceFunc로 등록된 함수가 요청 컨텍스트에서 읽은 활성 트레이스와 스팬의 ID를
+// 상세 정보("trace_id", "span_id")에 추가할지 설정합니다. 사용자는 이를 지원 요청에 붙여 넣고 엔지니어는
+// 바로 트레이스로 이동할 수 있습니다. 기본으로 추가됩니다.
+func WithTraceIDs(enabled bool) ResponderOption {
+	return func(cfg *responderConfig) { cfg.hideTraceIDs = !enabled }
+}
+
+// withTraceIDs returns a copy of e with the trace and span IDs of the request
+// added to its details, or e itself if there are none.
+func (cfg *responderConfig) withTraceIDs(r *http.Request, e *HttpError) *HttpError {
+	fn := traceFunc.Load()
+	if cfg.hideTraceIDs || fn == nil || r == nil {
+		return e
+	}
+	traceID, spanID := (*fn)(r.Context())
+	if traceID == "" {
+		return e
+	}
+	c := *e
+	c.Details = make(map[string]any, len(e.Details)+2)
+	for k, v := range e.Details {
+		c.Details[k] = v
+	}
+	c.Details["trace_id"] = traceID
+	if spanID != "" {
+		c.Details["span_id"] = spanID
+	}
+	return &c
+}
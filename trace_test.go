@@ -0,0 +1,46 @@
+package httperror
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+)
+
+// traceKey is the context key of the fake trace of TestTraceIDs.
+type traceKey struct{}
+
+// TestTraceIDs tests adding the IDs of the active trace to the details.
+func TestTraceIDs(t *testing.T) {
+	RegisterTraceFunc(func(ctx context.Context) (string, string) {
+		ids, _ := ctx.Value(traceKey{}).([2]string)
+		return ids[0], ids[1]
+	})
+	defer RegisterTraceFunc(nil)
+
+	testCases := []struct {
+		name         string
+		opts         []ResponderOption
+		ids          [2]string
+		expectedBody string
+	}{
+		{"span", nil, [2]string{"4bf92f3577b34da6a3ce929d0e0e4736", "00f067aa0ba902b7"}, `{"status":404,"message":"Not Found","details":{"span_id":"00f067aa0ba902b7","trace_id":"4bf92f3577b34da6a3ce929d0e0e4736"}}`},
+		{"no span", nil, [2]string{}, `{"status":404,"message":"Not Found"}`},
+		{"disabled", []ResponderOption{WithTraceIDs(false)}, [2]string{"4bf92f3577b34da6a3ce929d0e0e4736", "00f067aa0ba902b7"}, `{"status":404,"message":"Not Found"}`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/", nil)
+			req = req.WithContext(context.WithValue(req.Context(), traceKey{}, tc.ids))
+			rr := httptest.NewRecorder()
+			shared := NotFoundError()
+			NewResponder(tc.opts...).HandleError(rr, req, shared)
+
+			if got := rr.Body.String(); got != tc.expectedBody+"\n" {
+				t.Errorf("expected body %s, got %s", tc.expectedBody, got)
+			}
+			if shared.Details != nil {
+				t.Errorf("expected the shared error to be left untouched, got %v", shared.Details)
+			}
+		})
+	}
+}
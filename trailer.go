@@ -0,0 +1,70 @@
+package httperror
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// ErrorTrailer is the trailer carrying the JSON-encoded HttpError of a
+// streaming response that failed after its headers were sent.
+// ErrorTrailer는 헤더 전송 후 실패한 스트리밍 응답의 JSON 인코딩된 HttpError를 담는 트레일러입니다.
+const ErrorTrailer = "X-Stream-Error"
+
+// AnnounceErrorTrailer declares the error trailer in the Trailer header. It
+// must be called before the headers are written, e.g. at the start of a long
+// NDJSON or chunked download, so clients and proxies expect the trailer.
+// AnnounceErrorTrailer는 Trailer 헤더에 오류 트레일러를 선언합니다. 헤더가 작성되기 전에(예: 긴 NDJSON 또는
+// chunked 다운로드를 시작할 때) 호출해야 클라이언트와 프록시가 트레일러를 기대할 수 있습니다.
+func AnnounceErrorTrailer(w http.ResponseWriter) {
+	w.Header().Add("Trailer", ErrorTrailer)
+}
+
+// RespondTrailer reports err in the error trailer of a response whose headers
+// and part of the body were already sent, instead of silently truncating it.
+// The error goes through the same pipeline as Respond (mappers, localization,
+// counters, reporters and hooks) but the response status and body are left
+// untouched. The trailer is sent even if it was not announced.
+// RespondTrailer는 헤더와 본문 일부가 이미 전송된 응답에서 err를 오류 트레일러로 보고하여 응답이 조용히 잘리지 않게 합니다.
+// 오류는 Respond와 같은 파이프라인(매퍼, 현지화, 카운터, 보고기, 훅)을 거치지만 응답 상태 코드와 본문은 변경되지 않습니다.
+// 트레일러가 선언되지 않았더라도 전송됩니다.
+func RespondTrailer(w http.ResponseWriter, r *http.Request, err error) error {
+	return respondWith(w, r, err, writeErrorTrailer)
+}
+
+// writeErrorTrailer is the ErrorHandler of RespondTrailer.
+func writeErrorTrailer(w http.ResponseWriter, r *http.Request, err error) {
+	httpErr := toHttpError(err)
+	e := expandParams(localize(r, httpErr, ""), httpErr.Message)
+	value, encodeErr := e.AppendJSON(nil)
+	if encodeErr != nil {
+		value, _ = New(e.Status, e.Message).AppendJSON(nil)
+	}
+
+	key := http.TrailerPrefix + ErrorTrailer
+	for _, v := range w.Header().Values("Trailer") {
+		for _, name := range strings.Split(v, ",") {
+			if http.CanonicalHeaderKey(strings.TrimSpace(name)) == ErrorTrailer {
+				key = ErrorTrailer
+			}
+		}
+	}
+	w.Header().Set(key, string(value))
+}
+
+// TrailerError returns the error reported in the error trailer of a response,
+// read from resp.Trailer once the body has been read to EOF, or nil if there
+// is none.
+// TrailerError는 응답의 오류 트레일러에 보고된 오류를 반환하며, 없으면 nil을 반환합니다.
+// 트레일러는 본문을 EOF까지 읽은 후 resp.Trailer에서 읽습니다.
+func TrailerError(trailer http.Header) *HttpError {
+	value := trailer.Get(ErrorTrailer)
+	if value == "" {
+		return nil
+	}
+	var e HttpError
+	if err := json.Unmarshal([]byte(value), &e); err != nil {
+		return New(http.StatusInternalServerError, value)
+	}
+	return &e
+}
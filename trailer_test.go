@@ -0,0 +1,60 @@
+package httperror
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestRespondTrailer tests reporting a streaming failure in the error trailer.
+func TestRespondTrailer(t *testing.T) {
+	testCases := []struct {
+		name     string
+		announce bool
+	}{
+		{"announced", true},
+		{"not announced", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var hooked []int
+			AddHook(func(ev ErrorEvent) { hooked = append(hooked, ev.HttpError.Status) })
+			defer ResetHooks()
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tc.announce {
+					AnnounceErrorTrailer(w)
+				}
+				w.Header().Set("Content-Type", "application/x-ndjson")
+				io.WriteString(w, "{\"n\":1}\n")
+				w.(http.Flusher).Flush()
+				RespondTrailer(w, r, BadGatewayError("upstream reset", WithCode("upstream_reset")))
+			}))
+			defer srv.Close()
+
+			resp, err := http.Get(srv.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+			body, _ := io.ReadAll(resp.Body)
+
+			if resp.StatusCode != http.StatusOK || string(body) != "{\"n\":1}\n" {
+				t.Errorf("expected the stream to be left untouched, got %d %q", resp.StatusCode, body)
+			}
+			e := TrailerError(resp.Trailer)
+			if e == nil || e.Status != http.StatusBadGateway || e.Code != "upstream_reset" || e.Message != "upstream reset" {
+				t.Errorf("unexpected trailer error %+v (trailer %v)", e, resp.Trailer)
+			}
+			if len(hooked) != 1 || hooked[0] != http.StatusBadGateway {
+				t.Errorf("expected the error to reach the hooks, got %v", hooked)
+			}
+		})
+	}
+
+	if e := TrailerError(http.Header{}); e != nil {
+		t.Errorf("expected no error without trailer, got %+v", e)
+	}
+}
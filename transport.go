@@ -0,0 +1,36 @@
+package httperror
+
+import "net/http"
+
+// errorTransport is the http.RoundTripper returned by NewTransport.
+type errorTransport struct {
+	next http.RoundTripper
+}
+
+// NewTransport wraps next so that responses with a 4xx or 5xx status are
+// returned as errors built by CheckResponse, which unwrap to an *HttpError
+// carrying the response headers, instead of responses; client code then handles errors with
+// errors.As rather than manual status checks. The error body is consumed and
+// closed. Redirects and other non-error statuses are returned untouched.
+// If next is nil, http.DefaultTransport is used.
+// NewTransport는 4xx 또는 5xx 상태의 응답을 응답 대신, 응답 헤더를 담은 *HttpError로 언래핑되는 CheckResponse의 오류로 반환하도록
+// next를 감쌉니다. 클라이언트 코드는 상태 코드를 직접 확인하는 대신 errors.As로 오류를 처리할 수 있습니다.
+// 오류 본문은 읽은 뒤 닫히며, next가 nil이면 http.DefaultTransport를 사용합니다.
+//
+//	client := &http.Client{Transport: httperror.NewTransport(nil)}
+func NewTransport(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &errorTransport{next: next}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *errorTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil || resp.StatusCode < 400 {
+		return resp, err
+	}
+	defer resp.Body.Close()
+	return nil, CheckResponse(resp)
+}
@@ -0,0 +1,64 @@
+package httperror
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestNewTransport tests that error responses are returned as HttpErrors.
+func TestNewTransport(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ok":
+			io.WriteString(w, "hello")
+		case "/limited":
+			TooManyRequests(w, r, WithCode("slow_down"), WithHeader("Retry-After", "7"))
+		default:
+			http.Error(w, "boom", http.StatusInternalServerError)
+		}
+	}))
+	defer srv.Close()
+	client := &http.Client{Transport: NewTransport(nil)}
+
+	resp, err := client.Get(srv.URL + "/ok")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if string(body) != "hello" {
+		t.Errorf("expected the success response untouched, got %q", body)
+	}
+
+	_, err = client.Get(srv.URL + "/limited")
+	var httpErr *HttpError
+	if !errors.As(err, &httpErr) {
+		t.Fatalf("expected an HttpError, got %v", err)
+	}
+	if httpErr.Status != http.StatusTooManyRequests || httpErr.Code != "slow_down" || httpErr.Header.Get("Retry-After") != "7" {
+		t.Errorf("unexpected error: %+v", httpErr)
+	}
+
+	_, err = client.Get(srv.URL + "/fail")
+	if !errors.As(err, &httpErr) || httpErr.Status != http.StatusInternalServerError || httpErr.Message != "Internal Server Error" {
+		t.Errorf("expected a status-only 500, got %v", err)
+	}
+}
+
+// TestRenderParsedError tests that re-rendering a parsed error does not copy body headers.
+func TestRenderParsedError(t *testing.T) {
+	upstream := httptest.NewRecorder()
+	Respond(upstream, httptest.NewRequest("GET", "/", nil), BadGatewayError("upstream failed with a long message"))
+	resp := upstream.Result()
+	resp.Header.Set("Content-Length", "999")
+	parsed, _ := ParseResponse(resp)
+
+	rr := httptest.NewRecorder()
+	Respond(rr, httptest.NewRequest("GET", "/", nil), parsed)
+	if rr.Header().Get("Content-Length") != "" {
+		t.Errorf("expected upstream Content-Length not to be copied, got %v", rr.Header())
+	}
+}
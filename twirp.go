@@ -0,0 +1,128 @@
+package httperror
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// twirpStatuses maps Twirp error codes to HTTP statuses, as defined by the Twirp spec.
+var twirpStatuses = map[string]int{
+	"canceled":            http.StatusRequestTimeout,
+	"unknown":             http.StatusInternalServerError,
+	"invalid_argument":    http.StatusBadRequest,
+	"malformed":           http.StatusBadRequest,
+	"deadline_exceeded":   http.StatusRequestTimeout,
+	"not_found":           http.StatusNotFound,
+	"bad_route":           http.StatusNotFound,
+	"already_exists":      http.StatusConflict,
+	"permission_denied":   http.StatusForbidden,
+	"unauthenticated":     http.StatusUnauthorized,
+	"resource_exhausted":  http.StatusTooManyRequests,
+	"failed_precondition": http.StatusPreconditionFailed,
+	"aborted":             http.StatusConflict,
+	"out_of_range":        http.StatusBadRequest,
+	"unimplemented":       http.StatusNotImplemented,
+	"internal":            http.StatusInternalServerError,
+	"unavailable":         http.StatusServiceUnavailable,
+	"dataloss":            http.StatusInternalServerError,
+}
+
+// twirpCodes maps HTTP statuses to the most fitting Twirp error code.
+var twirpCodes = map[int]string{
+	http.StatusBadRequest:          "invalid_argument",
+	http.StatusUnauthorized:        "unauthenticated",
+	http.StatusForbidden:           "permission_denied",
+	http.StatusNotFound:            "not_found",
+	http.StatusMethodNotAllowed:    "bad_route",
+	http.StatusRequestTimeout:      "deadline_exceeded",
+	http.StatusConflict:            "already_exists",
+	http.StatusPreconditionFailed:  "failed_precondition",
+	http.StatusTooManyRequests:     "resource_exhausted",
+	http.StatusNotImplemented:      "unimplemented",
+	http.StatusServiceUnavailable:  "unavailable",
+	http.StatusGatewayTimeout:      "deadline_exceeded",
+	http.StatusInternalServerError: "internal",
+}
+
+// TwirpCode returns the Twirp error code corresponding to an HTTP status.
+// Unlisted 4xx statuses map to "invalid_argument" and anything else to "internal".
+// TwirpCode는 HTTP 상태 코드에 대응하는 Twirp 오류 코드를 반환합니다.
+func TwirpCode(status int) string {
+	if code, ok := twirpCodes[status]; ok {
+		return code
+	}
+	if status >= 400 && status < 500 {
+		return "invalid_argument"
+	}
+	return "internal"
+}
+
+// StatusFromTwirpCode returns the HTTP status of a Twirp error code, or 500 for unknown codes.
+// StatusFromTwirpCode는 Twirp 오류 코드의 HTTP 상태 코드를 반환하며, 알 수 없는 코드는 500입니다.
+func StatusFromTwirpCode(code string) int {
+	if status, ok := twirpStatuses[code]; ok {
+		return status
+	}
+	return http.StatusInternalServerError
+}
+
+// TwirpEncoder encodes errors in Twirp's error format ({"code","msg","meta"}),
+// so a Twirp front door and plain HTTP endpoints share one error envelope.
+// If the error's Code is a Twirp code it is used as is; otherwise the code is
+// derived from the status and the error's Code is kept in meta["error_code"].
+// Details are added to meta, formatted as strings. The response is written
+// with the status Twirp defines for the code, so clients reading either agree.
+// TwirpEncoder는 오류를 Twirp 오류 형식({"code","msg","meta"})으로 인코딩하여
+// Twirp 서비스와 일반 HTTP 엔드포인트가 동일한 오류 형식을 사용하게 합니다.
+// 응답은 코드에 대해 Twirp가 정의한 상태 코드로 작성되므로 둘 중 무엇을 읽는 클라이언트든 같은 결과를 얻습니다.
+type TwirpEncoder struct{}
+
+// ContentType implements Encoder.
+func (TwirpEncoder) ContentType() string {
+	return "application/json"
+}
+
+// Encode implements Encoder.
+func (TwirpEncoder) Encode(w io.Writer, e *HttpError) error {
+	body := struct {
+		Code string            `json:"code"`
+		Msg  string            `json:"msg"`
+		Meta map[string]string `json:"meta,omitempty"`
+	}{
+		Code: twirpCodeOf(e),
+		Msg:  e.Message,
+	}
+
+	meta := make(map[string]string, len(e.Details)+1)
+	if _, ok := twirpStatuses[e.Code]; !ok && e.Code != "" {
+		meta["error_code"] = e.Code
+	}
+	for k, v := range e.Details {
+		if s, ok := v.(string); ok {
+			meta[k] = s
+		} else {
+			meta[k] = fmt.Sprint(v)
+		}
+	}
+	if len(meta) > 0 {
+		body.Meta = meta
+	}
+	return json.NewEncoder(w).Encode(body)
+}
+
+// ResponseStatus returns the HTTP status Twirp defines for the error's code.
+// ResponseStatus는 오류 코드에 대해 Twirp가 정의한 HTTP 상태 코드를 반환합니다.
+func (TwirpEncoder) ResponseStatus(e *HttpError) int {
+	return StatusFromTwirpCode(twirpCodeOf(e))
+}
+
+// twirpCodeOf returns the Twirp code of e: its Code if it is a Twirp code,
+// otherwise the code derived from its status.
+func twirpCodeOf(e *HttpError) string {
+	if _, ok := twirpStatuses[e.Code]; ok {
+		return e.Code
+	}
+	return TwirpCode(e.Status)
+}
@@ -0,0 +1,106 @@
+package httperror
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestTwirpCodes tests the mapping between Twirp codes and HTTP statuses.
+func TestTwirpCodes(t *testing.T) {
+	testCases := []struct {
+		status int
+		code   string
+	}{
+		{http.StatusBadRequest, "invalid_argument"},
+		{http.StatusUnauthorized, "unauthenticated"},
+		{http.StatusForbidden, "permission_denied"},
+		{http.StatusNotFound, "not_found"},
+		{http.StatusConflict, "already_exists"},
+		{http.StatusTooManyRequests, "resource_exhausted"},
+		{http.StatusServiceUnavailable, "unavailable"},
+		{http.StatusInternalServerError, "internal"},
+	}
+	for _, tc := range testCases {
+		if got := TwirpCode(tc.status); got != tc.code {
+			t.Errorf("TwirpCode(%d): expected %q, got %q", tc.status, tc.code, got)
+		}
+		if got := StatusFromTwirpCode(tc.code); got != tc.status {
+			t.Errorf("StatusFromTwirpCode(%q): expected %d, got %d", tc.code, tc.status, got)
+		}
+	}
+	if got := TwirpCode(http.StatusTeapot); got != "invalid_argument" {
+		t.Errorf("expected unlisted 4xx to map to invalid_argument, got %q", got)
+	}
+	if got := StatusFromTwirpCode("bogus"); got != http.StatusInternalServerError {
+		t.Errorf("expected unknown code to map to 500, got %d", got)
+	}
+}
+
+// TestTwirpEncoder tests rendering errors in Twirp's error format.
+func TestTwirpEncoder(t *testing.T) {
+	rs := NewResponder()
+	rs.SetEncoder(TwirpEncoder{})
+
+	type twirpBody struct {
+		Code string            `json:"code"`
+		Msg  string            `json:"msg"`
+		Meta map[string]string `json:"meta"`
+	}
+	render := func(err error) (*httptest.ResponseRecorder, twirpBody) {
+		rr := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", "/", nil)
+		req.Header.Set("Accept", "text/html") // negotiation is bypassed
+		rs.HandleError(rr, req, err)
+		var body twirpBody
+		if err := json.NewDecoder(rr.Body).Decode(&body); err != nil {
+			t.Fatalf("could not decode response body: %v", err)
+		}
+		return rr, body
+	}
+
+	rr, body := render(NotFoundError("user missing", WithCode("user_not_found"), WithDetail("id", 42)))
+	if rr.Code != http.StatusNotFound || rr.Header().Get("Content-Type") != "application/json" {
+		t.Errorf("unexpected response: %d %s", rr.Code, rr.Header().Get("Content-Type"))
+	}
+	if body.Code != "not_found" || body.Msg != "user missing" || body.Meta["error_code"] != "user_not_found" || body.Meta["id"] != "42" {
+		t.Errorf("unexpected body: %+v", body)
+	}
+
+	_, body = render(BadRequestError(WithCode("malformed")))
+	if body.Code != "malformed" || body.Meta != nil {
+		t.Errorf("expected Twirp code to be used as is, got %+v", body)
+	}
+
+	statuses := []struct {
+		name           string
+		err            *HttpError
+		expectedCode   string
+		expectedStatus int
+	}{
+		{"unlisted 4xx", UnprocessableEntityError(), "invalid_argument", http.StatusBadRequest},
+		{"twirp code", ConflictError(WithCode("aborted")), "aborted", http.StatusConflict},
+		{"twirp code contradicting status", BadRequestError(WithCode("unavailable")), "unavailable", http.StatusServiceUnavailable},
+		{"gateway timeout", GatewayTimeoutError(), "deadline_exceeded", http.StatusRequestTimeout},
+	}
+	for _, tc := range statuses {
+		rr, body := render(tc.err)
+		if body.Code != tc.expectedCode || rr.Code != tc.expectedStatus {
+			t.Errorf("%s: expected %s %d, got %s %d", tc.name, tc.expectedCode, tc.expectedStatus, body.Code, rr.Code)
+		}
+	}
+}
+
+// TestHTMLEncoderEscapes tests that messages are escaped in HTML responses.
+func TestHTMLEncoderEscapes(t *testing.T) {
+	rr := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/", nil)
+	req.Header.Set("Accept", "text/html")
+	DefaultErrorHandler(rr, req, BadRequestError("<script>alert(1)</script>"))
+
+	expected := `<div class="http-error">&lt;script&gt;alert(1)&lt;/script&gt;</div>`
+	if rr.Body.String() != expected {
+		t.Errorf("expected %q, got %q", expected, rr.Body.String())
+	}
+}
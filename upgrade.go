@@ -0,0 +1,44 @@
+package httperror
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// WithUpgrade sets the headers a 426 Upgrade Required response must carry:
+// Upgrade, listing the protocols the client must switch to, e.g. "TLS/1.3" or
+// "HTTP/2", and Connection: Upgrade. The protocols are also added to the
+// details ("upgrade").
+// WithUpgrade는 426 Upgrade Required 응답에 포함되어야 하는 헤더를 설정합니다. 클라이언트가 전환해야 하는
+// 프로토콜(예: "TLS/1.3", "HTTP/2")을 나열하는 Upgrade와 Connection: Upgrade입니다.
+// 프로토콜은 상세 정보("upgrade")에도 추가됩니다.
+func WithUpgrade(protocols ...string) Option {
+	protocols = slices.Clone(protocols)
+	return optionFunc(func(e *HttpError) {
+		if e.Header == nil {
+			e.Header = make(http.Header)
+		}
+		e.Header.Set("Upgrade", strings.Join(protocols, ", "))
+		e.Header.Set("Connection", "Upgrade")
+		if e.Details == nil {
+			e.Details = make(map[string]any)
+		}
+		e.Details["upgrade"] = protocols
+	})
+}
+
+// UpgradeRequiredTo responds with a 426 Upgrade Required error asking the
+// client to switch to one of the protocols, see WithUpgrade.
+// UpgradeRequiredTo는 클라이언트에게 protocols 중 하나로 전환하도록 요구하는 426 Upgrade Required 오류로 응답합니다.
+// WithUpgrade를 참고하세요.
+func UpgradeRequiredTo(w http.ResponseWriter, r *http.Request, protocols []string, opts ...Option) {
+	Respond(w, r, UpgradeRequiredToError(protocols, opts...))
+}
+
+// UpgradeRequiredToError creates a 426 Upgrade Required HttpError asking the
+// client to switch to one of the protocols, see WithUpgrade.
+// UpgradeRequiredToError는 클라이언트에게 protocols 중 하나로 전환하도록 요구하는 426 Upgrade Required HttpError를 생성합니다.
+func UpgradeRequiredToError(protocols []string, opts ...Option) *HttpError {
+	return newWithOptions(http.StatusUpgradeRequired, append([]Option{WithUpgrade(protocols...)}, opts...))
+}
@@ -0,0 +1,35 @@
+package httperror
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestUpgradeRequiredTo tests that 426 responses carry the Upgrade and Connection headers.
+func TestUpgradeRequiredTo(t *testing.T) {
+	rr := httptest.NewRecorder()
+	UpgradeRequiredTo(rr, httptest.NewRequest("GET", "/", nil), []string{"TLS/1.3", "HTTP/2"})
+
+	if rr.Code != http.StatusUpgradeRequired {
+		t.Errorf("expected status 426, got %d", rr.Code)
+	}
+	if got := rr.Header().Get("Upgrade"); got != "TLS/1.3, HTTP/2" {
+		t.Errorf("expected Upgrade TLS/1.3, HTTP/2, got %q", got)
+	}
+	if got := rr.Header().Get("Connection"); got != "Upgrade" {
+		t.Errorf("expected Connection Upgrade, got %q", got)
+	}
+	expected := `{"status":426,"message":"Upgrade Required","details":{"upgrade":["TLS/1.3","HTTP/2"]}}` + "\n"
+	if got := rr.Body.String(); got != expected {
+		t.Errorf("expected body %s, got %s", expected, got)
+	}
+
+	t.Run("other statuses drop Connection", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		Respond(rr, httptest.NewRequest("GET", "/", nil), BadRequestError(WithUpgrade("HTTP/2")))
+		if got := rr.Header().Get("Connection"); got != "" {
+			t.Errorf("expected no Connection, got %q", got)
+		}
+	})
+}
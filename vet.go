@@ -0,0 +1,74 @@
+package httperror
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"unicode"
+)
+
+//go:generate go run gen_helpers.go
+
+// statusFamily holds the generated helper family of a single status code.
+type statusFamily struct {
+	Status      int
+	Name        string
+	Writer      func(http.ResponseWriter, *http.Request, ...Option)
+	Constructor func(...Option) *HttpError
+	Formatter   func(http.ResponseWriter, *http.Request, string, ...any)
+	Sentinel    *HttpError
+	Handler     func(...Option) http.Handler
+}
+
+func init() {
+	if err := VetNames(); err != nil {
+		panic(err)
+	}
+}
+
+// VetNames checks that every status in the internal table has its full, exported
+// helper family: the writer (NotFound), the constructor (NotFoundError), the
+// f-variant (NotFoundf), the sentinel (ErrNotFound) and the handler factory
+// (NotFoundHandler). It is run at init time and returns all problems found.
+// VetNames는 내부 테이블의 모든 상태 코드가 작성 함수, 생성자, f 변형, 센티널, 핸들러 팩토리로
+// 이루어진 헬퍼 계열 전체를 내보내고 있는지 검사합니다. 초기화 시점에 실행되며 발견된 모든 문제를 반환합니다.
+func VetNames() error {
+	var errs []error
+	seenStatus := make(map[int]string)
+	seenName := make(map[string]bool)
+
+	for _, f := range statusFamilies {
+		if f.Name == "" || !unicode.IsUpper([]rune(f.Name)[0]) {
+			errs = append(errs, fmt.Errorf("httperror: status %d has unexported name %q", f.Status, f.Name))
+		}
+		if prev, ok := seenStatus[f.Status]; ok {
+			errs = append(errs, fmt.Errorf("httperror: status %d is declared by both %s and %s", f.Status, prev, f.Name))
+		}
+		if seenName[f.Name] {
+			errs = append(errs, fmt.Errorf("httperror: name %s is declared more than once", f.Name))
+		}
+		seenStatus[f.Status] = f.Name
+		seenName[f.Name] = true
+
+		if f.Writer == nil {
+			errs = append(errs, fmt.Errorf("httperror: %s is missing its writer", f.Name))
+		}
+		if f.Formatter == nil {
+			errs = append(errs, fmt.Errorf("httperror: %s is missing %sf", f.Name, f.Name))
+		}
+		if f.Handler == nil {
+			errs = append(errs, fmt.Errorf("httperror: %s is missing %sHandler", f.Name, f.Name))
+		}
+		if f.Constructor == nil {
+			errs = append(errs, fmt.Errorf("httperror: %s is missing %sError", f.Name, f.Name))
+		} else if got := f.Constructor(); got.Status != f.Status || got.Message != StatusText(f.Status) {
+			errs = append(errs, fmt.Errorf("httperror: %sError creates %d %q, want %d %q", f.Name, got.Status, got.Message, f.Status, StatusText(f.Status)))
+		}
+		if f.Sentinel == nil {
+			errs = append(errs, fmt.Errorf("httperror: %s is missing Err%s", f.Name, f.Name))
+		} else if f.Sentinel.Status != f.Status || f.Sentinel.Message != StatusText(f.Status) {
+			errs = append(errs, fmt.Errorf("httperror: Err%s is %d %q, want %d %q", f.Name, f.Sentinel.Status, f.Sentinel.Message, f.Status, StatusText(f.Status)))
+		}
+	}
+	return errors.Join(errs...)
+}
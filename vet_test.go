@@ -0,0 +1,49 @@
+package httperror
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// TestVetNames tests that the generated helper families are complete.
+func TestVetNames(t *testing.T) {
+	if err := VetNames(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// TestVetNamesDetectsDrift tests that VetNames reports incomplete families.
+func TestVetNamesDetectsDrift(t *testing.T) {
+	saved := statusFamilies
+	defer func() { statusFamilies = saved }()
+
+	statusFamilies = append([]statusFamily(nil), saved...)
+	statusFamilies[0].Formatter = nil
+	statusFamilies[1].Sentinel = nil
+	statusFamilies[2].Sentinel = &HttpError{Status: statusFamilies[2].Status}
+	statusFamilies = append(statusFamilies, statusFamily{Status: http.StatusNotFound, Name: "lowercase"})
+
+	err := VetNames()
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	for _, want := range []string{"missing BadRequestf", "missing ErrUnauthorized", `ErrPaymentRequired is 402 ""`, "unexported name", "declared by both"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("expected error to mention %q, got: %v", want, err)
+		}
+	}
+}
+
+// TestStatusTableCoverage tests that every 4xx and 5xx status known to net/http has a helper family.
+func TestStatusTableCoverage(t *testing.T) {
+	covered := make(map[int]bool)
+	for _, f := range statusFamilies {
+		covered[f.Status] = true
+	}
+	for code := 400; code < 600; code++ {
+		if http.StatusText(code) != "" && !covered[code] {
+			t.Errorf("status %d (%s) has no generated helpers; add it to gen_helpers.go", code, http.StatusText(code))
+		}
+	}
+}
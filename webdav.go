@@ -0,0 +1,62 @@
+package httperror
+
+import (
+	"encoding/xml"
+	"io"
+	"strconv"
+)
+
+// WebDAVEncoder encodes batch results as a WebDAV <D:multistatus> document
+// (RFC 4918), so WebDAV servers can report per-resource errors through
+// BatchResponse. The ID of each result is its href, falling back to its index;
+// failed results carry their message as the response description.
+//
+//	batch.WriteWith(w, r, httperror.WebDAVEncoder{})
+//
+// WebDAVEncoder는 일괄 처리 결과를 WebDAV <D:multistatus> 문서(RFC 4918)로 인코딩하여
+// WebDAV 서버가 BatchResponse로 리소스별 오류를 보고할 수 있게 합니다.
+type WebDAVEncoder struct{}
+
+// ContentType implements BatchEncoder.
+func (WebDAVEncoder) ContentType() string {
+	return "application/xml; charset=utf-8"
+}
+
+// EncodeBatch implements BatchEncoder.
+func (WebDAVEncoder) EncodeBatch(w io.Writer, results []BatchResult) error {
+	type response struct {
+		Href        string `xml:"D:href"`
+		Status      string `xml:"D:status"`
+		Description string `xml:"D:responsedescription,omitempty"`
+	}
+	doc := struct {
+		XMLName   xml.Name   `xml:"D:multistatus"`
+		Namespace string     `xml:"xmlns:D,attr"`
+		Responses []response `xml:"D:response"`
+	}{Namespace: "DAV:"}
+
+	for _, res := range results {
+		href := res.ID
+		if href == "" {
+			href = strconv.Itoa(res.Index)
+		}
+		resp := response{
+			Href:   href,
+			Status: "HTTP/1.1 " + strconv.Itoa(res.Status) + " " + StatusText(res.Status),
+		}
+		if res.Status >= 400 {
+			resp.Description = res.Message
+		}
+		doc.Responses = append(doc.Responses, resp)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
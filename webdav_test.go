@@ -0,0 +1,31 @@
+package httperror
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestWebDAVEncoder tests rendering batch results as a WebDAV multistatus document.
+func TestWebDAVEncoder(t *testing.T) {
+	var b BatchResponse
+	b.AddStatus("/files/a.txt", http.StatusOK)
+	b.Add("/files/b.txt", ForbiddenError("locked by another user"))
+	b.Add("", ConflictError())
+
+	rr := httptest.NewRecorder()
+	b.WriteWith(rr, httptest.NewRequest("PROPPATCH", "/files", nil), WebDAVEncoder{})
+
+	if rr.Code != http.StatusMultiStatus || rr.Header().Get("Content-Type") != "application/xml; charset=utf-8" {
+		t.Errorf("unexpected response: %d %q", rr.Code, rr.Header().Get("Content-Type"))
+	}
+	expected := `<?xml version="1.0" encoding="UTF-8"?>
+<D:multistatus xmlns:D="DAV:">` +
+		`<D:response><D:href>/files/a.txt</D:href><D:status>HTTP/1.1 200 OK</D:status></D:response>` +
+		`<D:response><D:href>/files/b.txt</D:href><D:status>HTTP/1.1 403 Forbidden</D:status><D:responsedescription>locked by another user</D:responsedescription></D:response>` +
+		`<D:response><D:href>2</D:href><D:status>HTTP/1.1 409 Conflict</D:status><D:responsedescription>Conflict</D:responsedescription></D:response>` +
+		"</D:multistatus>\n"
+	if got := rr.Body.String(); got != expected {
+		t.Errorf("unexpected body:\n%s\nwant:\n%s", got, expected)
+	}
+}
@@ -0,0 +1,79 @@
+package httperror
+
+import (
+	"encoding/binary"
+	"net/http"
+	"unicode/utf8"
+)
+
+// WebSocket close codes (RFC 6455 and the IANA registry) HttpErrors map to.
+// HttpError가 매핑되는 WebSocket 종료 코드(RFC 6455 및 IANA 레지스트리)입니다.
+const (
+	CloseNormalClosure   = 1000
+	CloseGoingAway       = 1001
+	CloseUnsupportedData = 1003
+	ClosePolicyViolation = 1008
+	CloseMessageTooBig   = 1009
+	CloseInternalError   = 1011
+	CloseTryAgainLater   = 1013
+	CloseBadGateway      = 1014
+)
+
+// maxCloseReason is the maximum length in bytes of the reason of a close
+// frame, whose payload is limited to 125 bytes including the 2-byte code.
+const maxCloseReason = 123
+
+// WebSocketCloseCode returns the WebSocket close code corresponding to an HTTP
+// status, so upgrade handlers and socket servers reuse the same error taxonomy:
+// 413 maps to Message Too Big, 415 to Unsupported Data, 429 and 503 to Try
+// Again Later, 502 to Bad Gateway, 499 to Going Away, other 4xx statuses to
+// Policy Violation, other 5xx statuses to Internal Error and anything below
+// 400 to Normal Closure.
+// WebSocketCloseCode는 HTTP 상태 코드에 대응하는 WebSocket 종료 코드를 반환하여 업그레이드 핸들러와
+// 소켓 서버가 같은 오류 분류를 사용하게 합니다.
+func WebSocketCloseCode(status int) int {
+	switch status {
+	case http.StatusRequestEntityTooLarge:
+		return CloseMessageTooBig
+	case http.StatusUnsupportedMediaType:
+		return CloseUnsupportedData
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return CloseTryAgainLater
+	case http.StatusBadGateway:
+		return CloseBadGateway
+	case StatusClientClosedRequest:
+		return CloseGoingAway
+	}
+	switch {
+	case status >= 500:
+		return CloseInternalError
+	case status >= 400:
+		return ClosePolicyViolation
+	default:
+		return CloseNormalClosure
+	}
+}
+
+// WebSocketClosePayload returns the payload of the close frame for err: the
+// close code of the status err resolves to, in network byte order, followed by
+// the error message truncated to fit the 125-byte limit of control frames
+// without splitting a UTF-8 sequence.
+// WebSocketClosePayload는 err에 대한 종료 프레임의 페이로드를 반환합니다. err가 변환되는 상태 코드의 종료 코드
+// (네트워크 바이트 순서) 뒤에, 제어 프레임의 125바이트 제한에 맞게 UTF-8 문자를 나누지 않고 자른 오류 메시지가 이어집니다.
+func WebSocketClosePayload(err error) []byte {
+	e := toHttpError(err)
+	reason := e.Message
+	if len(reason) > maxCloseReason {
+		reason = reason[:maxCloseReason]
+		for len(reason) > 0 {
+			if r, size := utf8.DecodeLastRuneInString(reason); r != utf8.RuneError || size > 1 {
+				break
+			}
+			reason = reason[:len(reason)-1]
+		}
+	}
+
+	payload := make([]byte, 2, 2+len(reason))
+	binary.BigEndian.PutUint16(payload, uint16(WebSocketCloseCode(e.Status)))
+	return append(payload, reason...)
+}
@@ -0,0 +1,66 @@
+package httperror
+
+import (
+	"context"
+	"encoding/binary"
+	"net/http"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+// TestWebSocketCloseCode tests mapping statuses to close codes.
+func TestWebSocketCloseCode(t *testing.T) {
+	testCases := []struct {
+		status int
+		code   int
+	}{
+		{http.StatusOK, CloseNormalClosure},
+		{http.StatusBadRequest, ClosePolicyViolation},
+		{http.StatusForbidden, ClosePolicyViolation},
+		{http.StatusRequestEntityTooLarge, CloseMessageTooBig},
+		{http.StatusUnsupportedMediaType, CloseUnsupportedData},
+		{http.StatusTooManyRequests, CloseTryAgainLater},
+		{StatusClientClosedRequest, CloseGoingAway},
+		{http.StatusInternalServerError, CloseInternalError},
+		{http.StatusBadGateway, CloseBadGateway},
+		{http.StatusServiceUnavailable, CloseTryAgainLater},
+		{http.StatusGatewayTimeout, CloseInternalError},
+	}
+	for _, tc := range testCases {
+		if got := WebSocketCloseCode(tc.status); got != tc.code {
+			t.Errorf("WebSocketCloseCode(%d): expected %d, got %d", tc.status, tc.code, got)
+		}
+	}
+}
+
+// TestWebSocketClosePayload tests the close frame payload.
+func TestWebSocketClosePayload(t *testing.T) {
+	testCases := []struct {
+		name   string
+		err    error
+		code   int
+		reason string
+	}{
+		{"http error", ForbiddenError("token revoked"), ClosePolicyViolation, "token revoked"},
+		{"mapped error", context.DeadlineExceeded, CloseInternalError, "Gateway Timeout"},
+		{"long reason", TooManyRequestsError(strings.Repeat("a", 200)), CloseTryAgainLater, strings.Repeat("a", maxCloseReason)},
+		{"multibyte reason", BadRequestError("a" + strings.Repeat("한", 50)), ClosePolicyViolation, "a" + strings.Repeat("한", 40)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			payload := WebSocketClosePayload(tc.err)
+			if len(payload) > 125 {
+				t.Errorf("expected at most 125 bytes, got %d", len(payload))
+			}
+			if code := int(binary.BigEndian.Uint16(payload)); code != tc.code {
+				t.Errorf("expected code %d, got %d", tc.code, code)
+			}
+			reason := string(payload[2:])
+			if reason != tc.reason || !utf8.ValidString(reason) {
+				t.Errorf("expected reason %q, got %q", tc.reason, reason)
+			}
+		})
+	}
+}